@@ -5,12 +5,14 @@ import (
 	"bufio"
 	"flag"
 	"fmt"
+	"io"
 	"os"
 	"strings"
 
 	"github.com/yuru-sha/gorogue/internal/config"
 	"github.com/yuru-sha/gorogue/internal/core"
 	"github.com/yuru-sha/gorogue/internal/core/cli"
+	"github.com/yuru-sha/gorogue/internal/core/event"
 	"github.com/yuru-sha/gorogue/internal/game/actor"
 	"github.com/yuru-sha/gorogue/internal/game/dungeon"
 	"github.com/yuru-sha/gorogue/internal/game/item"
@@ -24,6 +26,21 @@ var (
 )
 
 func main() {
+	// サブコマンド
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "scores":
+			runSubcommand(runScoresCommand, os.Args[2:])
+			return
+		case "stats":
+			runSubcommand(runStatsCommand, os.Args[2:])
+			return
+		case "migrate":
+			runSubcommand(runMigrateCommand, os.Args[2:])
+			return
+		}
+	}
+
 	flag.Parse()
 
 	if *helpFlag {
@@ -74,6 +91,11 @@ func main() {
 	cliMode := cli.NewCLIMode(level, player)
 	cliMode.IsActive = true
 
+	// 単体のCLIにはセーブ中のゲームがないので、イベントはログにだけ残す
+	events := event.NewBus()
+	events.SubscribeAll(event.LogEvent)
+	cliMode.Session.SetEventBus(events)
+
 	if *interactive {
 		runInteractiveMode(cliMode)
 	} else {
@@ -81,11 +103,31 @@ func main() {
 	}
 }
 
+// runSubcommand runs a subcommand with logging set up and exits on failure
+func runSubcommand(run func(args []string, out io.Writer) error, args []string) {
+	if err := logger.Setup(); err != nil {
+		panic(err)
+	}
+	defer logger.Cleanup()
+
+	if err := run(args, os.Stdout); err != nil {
+		if err == flag.ErrHelp {
+			return
+		}
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		logger.Cleanup()
+		os.Exit(1)
+	}
+}
+
 func showHelp() {
 	fmt.Println("GoRogue CLI - Terminal-based roguelike game")
 	fmt.Println()
 	fmt.Println("Usage:")
 	fmt.Println("  gorogue-cli [options]")
+	fmt.Println("  gorogue-cli scores [-json] [-victories] [-player name] [-version v] [-limit n] [-stats]")
+	fmt.Println("  gorogue-cli stats [-csv] [-json] [-top n]")
+	fmt.Println("  gorogue-cli migrate [-slot n] [-apply]")
 	fmt.Println()
 	fmt.Println("Options:")
 	fmt.Println("  -debug         Enable debug mode")
@@ -96,6 +138,10 @@ func showHelp() {
 	fmt.Println("  gorogue-cli                    # Start interactive CLI")
 	fmt.Println("  gorogue-cli -debug             # Start with debug mode")
 	fmt.Println("  echo 'status' | gorogue-cli -interactive=false  # Batch mode")
+	fmt.Println("  gorogue-cli scores -victories      # Show winning games")
+	fmt.Println("  gorogue-cli scores -json -limit 0  # Dump all scores as JSON")
+	fmt.Println("  gorogue-cli stats -csv > stats.csv # Export lifetime statistics")
+	fmt.Println("  gorogue-cli migrate                # Show the migrations old saves need")
 	fmt.Println()
 	fmt.Println("Interactive Commands:")
 	fmt.Println("  help           Show all available commands")
@@ -104,6 +150,7 @@ func showHelp() {
 	fmt.Println("  gold <amount>  Add gold")
 	fmt.Println("  create <type>  Create item")
 	fmt.Println("  teleport <x> <y>  Teleport player")
+	fmt.Println("  morgue [dir]   Write a character dump (default ~/.gorogue/morgue)")
 	fmt.Println("  quit, exit     Exit CLI")
 	fmt.Println()
 	fmt.Println("For full command list, run 'help' in interactive mode.")
@@ -0,0 +1,63 @@
+// GoRogue CLI - migrate サブコマンド
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/yuru-sha/gorogue/internal/game/save"
+)
+
+// runMigrateCommand previews the save migrations and applies them with -apply
+func runMigrateCommand(args []string, out io.Writer) error {
+	fs := flag.NewFlagSet("migrate", flag.ContinueOnError)
+	fs.SetOutput(out)
+	slot := fs.Int("slot", -1, "Save slot to check (-1 for all slots and the auto-save)")
+	apply := fs.Bool("apply", false, "Rewrite the saves in the current version instead of a dry run")
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	sm := save.NewSaveManager()
+	if err := sm.Initialize(); err != nil {
+		return err
+	}
+
+	slots := []int{*slot}
+	if *slot < 0 {
+		slots = nil
+		for s := 0; s < save.MaxSaveSlots; s++ {
+			slots = append(slots, s)
+		}
+		slots = append(slots, save.AutoSaveSlot)
+	}
+
+	found := false
+	for _, s := range slots {
+		if !sm.FileExists(s) {
+			continue
+		}
+		found = true
+
+		report, err := sm.PreviewMigration(s)
+		if err != nil {
+			fmt.Fprintf(out, "Slot %d: %v\n", s, err)
+			continue
+		}
+		fmt.Fprintf(out, "Slot %d: %s\n", s, report.String())
+
+		if *apply && report.NeedsMigration() {
+			if err := sm.RepairSave(s); err != nil {
+				return fmt.Errorf("failed to migrate slot %d: %w", s, err)
+			}
+			fmt.Fprintf(out, "Slot %d: migrated to %s\n", s, save.SaveVersion)
+		}
+	}
+
+	if !found {
+		fmt.Fprintln(out, "No save files found")
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+// GoRogue CLI - scores サブコマンド
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/yuru-sha/gorogue/internal/game/score"
+)
+
+// runScoresCommand prints the leaderboard as a table or JSON
+func runScoresCommand(args []string, out io.Writer) error {
+	fs := flag.NewFlagSet("scores", flag.ContinueOnError)
+	fs.SetOutput(out)
+	jsonOutput := fs.Bool("json", false, "Print scores as JSON")
+	victories := fs.Bool("victories", false, "Show victories only")
+	player := fs.String("player", "", "Show scores of players whose name contains this text")
+	version := fs.String("version", "", "Show scores recorded by this version")
+	limit := fs.Int("limit", 10, "Maximum number of scores to show (0 for all)")
+	stats := fs.Bool("stats", false, "Include score statistics")
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	filter := score.ScoreFilter{
+		VictoryOnly: *victories,
+		PlayerName:  *player,
+		Version:     *version,
+		Limit:       *limit,
+	}
+
+	sm := score.NewScoreManager()
+	if err := sm.Initialize(); err != nil {
+		return fmt.Errorf("failed to initialize score manager: %w", err)
+	}
+
+	entries, err := sm.QueryScores(filter)
+	if err != nil {
+		return fmt.Errorf("failed to query scores: %w", err)
+	}
+
+	var scoreStats *score.ScoreStats
+	if *stats {
+		if scoreStats, err = sm.GetScoreStats(); err != nil {
+			return fmt.Errorf("failed to read score stats: %w", err)
+		}
+	}
+
+	if *jsonOutput {
+		return printScoresJSON(out, filter, entries, scoreStats)
+	}
+	printScoresTable(out, filter, entries, scoreStats)
+	return nil
+}
+
+// printScoresJSON writes the query result as indented JSON
+func printScoresJSON(out io.Writer, filter score.ScoreFilter, entries []score.RankedEntry, stats *score.ScoreStats) error {
+	result := struct {
+		Filter  score.ScoreFilter   `json:"filter"`
+		Entries []score.RankedEntry `json:"entries"`
+		Stats   *score.ScoreStats   `json:"stats,omitempty"`
+	}{
+		Filter:  filter,
+		Entries: entries,
+		Stats:   stats,
+	}
+
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(result); err != nil {
+		return fmt.Errorf("failed to encode scores: %w", err)
+	}
+	return nil
+}
+
+// printScoresTable writes the query result as a text table
+func printScoresTable(out io.Writer, filter score.ScoreFilter, entries []score.RankedEntry, stats *score.ScoreStats) {
+	fmt.Fprintf(out, "GoRogue High Scores (filter: %s)\n\n", filter.String())
+
+	if len(entries) == 0 {
+		fmt.Fprintln(out, "No scores yet")
+	} else {
+		tw := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, "RANK\tNAME\tSCORE\tLVL\tFLOOR\tTURNS\tDATE\tRESULT")
+		for _, ranked := range entries {
+			entry := ranked.Entry
+			fmt.Fprintf(tw, "%d\t%s\t%d\t%d\t%d\t%d\t%s\t%s\n",
+				ranked.Rank, entry.PlayerName, entry.Score, entry.Level, entry.DeepestFloor,
+				entry.TurnCount, entry.Timestamp.Format("2006-01-02"), entry.ResultText())
+		}
+		tw.Flush()
+	}
+
+	if stats != nil {
+		fmt.Fprintln(out)
+		fmt.Fprintf(out, "Games: %d  Victories: %d  Highest: %d  Average: %d  Deepest floor: %d\n",
+			stats.TotalEntries, stats.VictoryCount, stats.HighestScore, stats.AverageScore, stats.DeepestFloor)
+	}
+}
@@ -0,0 +1,84 @@
+// GoRogue CLI - stats サブコマンド
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/yuru-sha/gorogue/internal/game/save"
+	"github.com/yuru-sha/gorogue/internal/game/score"
+)
+
+// runStatsCommand prints the lifetime statistics as text, CSV or JSON
+func runStatsCommand(args []string, out io.Writer) error {
+	fs := flag.NewFlagSet("stats", flag.ContinueOnError)
+	fs.SetOutput(out)
+	csvOutput := fs.Bool("csv", false, "Print statistics as CSV")
+	jsonOutput := fs.Bool("json", false, "Print statistics as JSON")
+	top := fs.Int("top", 10, "Number of entries in each ranking (0 for all)")
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	lm := save.NewLifetimeStatsManager()
+	stats, err := lm.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load lifetime stats: %w", err)
+	}
+
+	switch {
+	case *csvOutput:
+		return stats.WriteCSV(out)
+	case *jsonOutput:
+		encoder := json.NewEncoder(out)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(stats); err != nil {
+			return fmt.Errorf("failed to encode lifetime stats: %w", err)
+		}
+		return nil
+	}
+
+	printStats(out, stats, *top)
+	return nil
+}
+
+// printStats writes a human readable summary
+func printStats(out io.Writer, stats *save.LifetimeStats, top int) {
+	fmt.Fprintln(out, "GoRogue Lifetime Statistics")
+	fmt.Fprintln(out)
+
+	if stats.TotalGames == 0 {
+		fmt.Fprintln(out, "No finished games yet")
+		return
+	}
+
+	fmt.Fprintf(out, "Games played:     %d\n", stats.TotalGames)
+	fmt.Fprintf(out, "Victories:        %d (%.0f%%)\n", stats.Victories, stats.WinRate()*100)
+	fmt.Fprintf(out, "Deaths:           %d\n", stats.Deaths)
+	fmt.Fprintf(out, "Deepest floor:    %d\n", stats.DeepestFloor)
+	fmt.Fprintf(out, "Highest level:    %d\n", stats.HighestLevel)
+	fmt.Fprintf(out, "Monsters killed:  %d\n", stats.MonstersKilled)
+	fmt.Fprintf(out, "Gold collected:   %d\n", stats.GoldCollected)
+	fmt.Fprintf(out, "Turns per floor:  %.1f\n", stats.AverageTurnsPerFloor())
+	fmt.Fprintf(out, "Total play time:  %s\n", score.FormatPlayTime(stats.TotalPlayTime))
+
+	printRanking(out, "Deaths by cause", save.DeathCauseNames(stats.TopDeathCauses(top)))
+	printRanking(out, "Kills by monster", stats.TopMonsters(top))
+	printRanking(out, "Favorite items", stats.FavoriteItems(top))
+}
+
+// printRanking writes a titled list of counts
+func printRanking(out io.Writer, title string, entries []save.NamedCount) {
+	fmt.Fprintln(out)
+	fmt.Fprintf(out, "%s:\n", title)
+	if len(entries) == 0 {
+		fmt.Fprintln(out, "  -")
+		return
+	}
+	for _, entry := range entries {
+		fmt.Fprintf(out, "  %-24s %d\n", entry.Name, entry.Count)
+	}
+}
@@ -17,6 +17,10 @@ const (
 	DefaultLogLevel        = "INFO"
 	DefaultSaveDirectory   = "saves"
 	DefaultAutoSaveEnabled = true
+	DefaultLanguage        = "en"
+	DefaultKeymapPreset    = "vi"
+	DefaultKeymapFile      = "keymap.json"
+	DefaultSaveFormat      = "json"
 )
 
 // 環境変数のキー名
@@ -25,6 +29,10 @@ const (
 	EnvLogLevel        = "LOG_LEVEL"
 	EnvSaveDirectory   = "SAVE_DIRECTORY"
 	EnvAutoSaveEnabled = "AUTO_SAVE_ENABLED"
+	EnvLanguage        = "LANGUAGE"
+	EnvKeymapPreset    = "KEYMAP_PRESET"
+	EnvKeymapFile      = "KEYMAP_FILE"
+	EnvSaveFormat      = "SAVE_FORMAT"
 )
 
 // 初期化時に.envファイルを読み込む
@@ -117,6 +125,26 @@ func GetAutoSaveEnabled() bool {
 	return GetBool(EnvAutoSaveEnabled, DefaultAutoSaveEnabled)
 }
 
+// GetLanguage は表示言語の設定（ja または en）を取得する
+func GetLanguage() string {
+	return GetString(EnvLanguage, DefaultLanguage)
+}
+
+// GetKeymapPreset はキーマップのプリセット名（rogue, vi, numpad, nethack）を取得する
+func GetKeymapPreset() string {
+	return GetString(EnvKeymapPreset, DefaultKeymapPreset)
+}
+
+// GetKeymapFile はユーザーのキーマップファイルのパスを取得する（なければプリセットのみ）
+func GetKeymapFile() string {
+	return GetString(EnvKeymapFile, DefaultKeymapFile)
+}
+
+// GetSaveFormat は新しく書くセーブファイルの形式（json, gzip, binary）を取得する
+func GetSaveFormat() string {
+	return GetString(EnvSaveFormat, DefaultSaveFormat)
+}
+
 
 
 
@@ -126,6 +154,10 @@ type Config struct {
 	LogLevel        string `json:"log_level"`
 	SaveDirectory   string `json:"save_directory"`
 	AutoSaveEnabled bool   `json:"auto_save_enabled"`
+	Language        string `json:"language"`
+	KeymapPreset    string `json:"keymap_preset"`
+	KeymapFile      string `json:"keymap_file"`
+	SaveFormat      string `json:"save_format"`
 }
 
 // GetConfig は現在の設定を構造体として取得する
@@ -135,6 +167,10 @@ func GetConfig() *Config {
 		LogLevel:        GetLogLevel(),
 		SaveDirectory:   GetSaveDirectory(),
 		AutoSaveEnabled: GetAutoSaveEnabled(),
+		Language:        GetLanguage(),
+		KeymapPreset:    GetKeymapPreset(),
+		KeymapFile:      GetKeymapFile(),
+		SaveFormat:      GetSaveFormat(),
 	}
 }
 
@@ -161,4 +197,8 @@ func PrintConfig() {
 	log.Printf("  LogLevel: %s", config.LogLevel)
 	log.Printf("  SaveDirectory: %s", config.SaveDirectory)
 	log.Printf("  AutoSaveEnabled: %v", config.AutoSaveEnabled)
+	log.Printf("  Language: %s", config.Language)
+	log.Printf("  KeymapPreset: %s", config.KeymapPreset)
+	log.Printf("  KeymapFile: %s", config.KeymapFile)
+	log.Printf("  SaveFormat: %s", config.SaveFormat)
 }
\ No newline at end of file
@@ -143,6 +143,10 @@ func TestConfigAccessors(t *testing.T) {
 	os.Unsetenv(EnvLogLevel)
 	os.Unsetenv(EnvSaveDirectory)
 	os.Unsetenv(EnvAutoSaveEnabled)
+	os.Unsetenv(EnvLanguage)
+	os.Unsetenv(EnvKeymapPreset)
+	os.Unsetenv(EnvKeymapFile)
+	os.Unsetenv(EnvSaveFormat)
 
 	// Test defaults
 	if GetDebugMode() != DefaultDebugMode {
@@ -157,12 +161,28 @@ func TestConfigAccessors(t *testing.T) {
 	if GetAutoSaveEnabled() != DefaultAutoSaveEnabled {
 		t.Errorf("GetAutoSaveEnabled() = %v, expected %v", GetAutoSaveEnabled(), DefaultAutoSaveEnabled)
 	}
+	if GetLanguage() != DefaultLanguage {
+		t.Errorf("GetLanguage() = %q, expected %q", GetLanguage(), DefaultLanguage)
+	}
+	if GetKeymapPreset() != DefaultKeymapPreset {
+		t.Errorf("GetKeymapPreset() = %q, expected %q", GetKeymapPreset(), DefaultKeymapPreset)
+	}
+	if GetKeymapFile() != DefaultKeymapFile {
+		t.Errorf("GetKeymapFile() = %q, expected %q", GetKeymapFile(), DefaultKeymapFile)
+	}
+	if GetSaveFormat() != DefaultSaveFormat {
+		t.Errorf("GetSaveFormat() = %q, expected %q", GetSaveFormat(), DefaultSaveFormat)
+	}
 
 	// Test with environment variables
 	os.Setenv(EnvDebugMode, "true")
 	os.Setenv(EnvLogLevel, "ERROR")
 	os.Setenv(EnvSaveDirectory, "custom_saves")
 	os.Setenv(EnvAutoSaveEnabled, "false")
+	os.Setenv(EnvLanguage, "ja")
+	os.Setenv(EnvKeymapPreset, "numpad")
+	os.Setenv(EnvKeymapFile, "custom_keys.json")
+	os.Setenv(EnvSaveFormat, "binary")
 
 	if GetDebugMode() != true {
 		t.Errorf("GetDebugMode() = %v, expected true", GetDebugMode())
@@ -176,12 +196,28 @@ func TestConfigAccessors(t *testing.T) {
 	if GetAutoSaveEnabled() != false {
 		t.Errorf("GetAutoSaveEnabled() = %v, expected false", GetAutoSaveEnabled())
 	}
+	if GetLanguage() != "ja" {
+		t.Errorf("GetLanguage() = %q, expected ja", GetLanguage())
+	}
+	if GetKeymapPreset() != "numpad" {
+		t.Errorf("GetKeymapPreset() = %q, expected numpad", GetKeymapPreset())
+	}
+	if GetKeymapFile() != "custom_keys.json" {
+		t.Errorf("GetKeymapFile() = %q, expected custom_keys.json", GetKeymapFile())
+	}
+	if GetSaveFormat() != "binary" {
+		t.Errorf("GetSaveFormat() = %q, expected binary", GetSaveFormat())
+	}
 
 	// Cleanup
 	os.Unsetenv(EnvDebugMode)
 	os.Unsetenv(EnvLogLevel)
 	os.Unsetenv(EnvSaveDirectory)
 	os.Unsetenv(EnvAutoSaveEnabled)
+	os.Unsetenv(EnvLanguage)
+	os.Unsetenv(EnvKeymapPreset)
+	os.Unsetenv(EnvKeymapFile)
+	os.Unsetenv(EnvSaveFormat)
 }
 
 func TestGetConfig(t *testing.T) {
@@ -261,6 +297,9 @@ func TestEnvironmentVariableKeys(t *testing.T) {
 		EnvLogLevel,
 		EnvSaveDirectory,
 		EnvAutoSaveEnabled,
+		EnvLanguage,
+		EnvKeymapPreset,
+		EnvKeymapFile,
 	}
 
 	for _, key := range expectedKeys {
@@ -278,6 +317,12 @@ func TestDefaultValues(t *testing.T) {
 	if DefaultSaveDirectory == "" {
 		t.Error("DefaultSaveDirectory is empty")
 	}
+	if DefaultLanguage == "" {
+		t.Error("DefaultLanguage is empty")
+	}
+	if DefaultKeymapPreset == "" {
+		t.Error("DefaultKeymapPreset is empty")
+	}
 }
 
 // Benchmark tests
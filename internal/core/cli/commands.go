@@ -5,9 +5,11 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/yuru-sha/gorogue/internal/core/session"
 	"github.com/yuru-sha/gorogue/internal/game/actor"
 	"github.com/yuru-sha/gorogue/internal/game/dungeon"
 	"github.com/yuru-sha/gorogue/internal/game/item"
+	"github.com/yuru-sha/gorogue/internal/game/morgue"
 	"github.com/yuru-sha/gorogue/internal/utils/logger"
 )
 
@@ -23,6 +25,15 @@ type CLIMode struct {
 	Level    *dungeon.Level
 	Player   *actor.Player
 	Commands map[string]*Command
+
+	// MorgueReport builds the character dump for the morgue command (nil: player and level only)
+	MorgueReport func() *morgue.Report
+
+	// Session runs the game actions (move, attack, items, stairs) with the same rules as the game screen
+	Session *session.Session
+
+	// OnCheat is called when a command changes the game outside the rules (nil: ignored)
+	OnCheat func()
 }
 
 // Command represents a CLI command
@@ -31,6 +42,7 @@ type Command struct {
 	Description string
 	Usage       string
 	Handler     func(args []string) string
+	Cheat       bool // 使うとウィザードモードのゲームとして記録される
 }
 
 // NewCLIMode creates a new CLI mode instance
@@ -40,6 +52,7 @@ func NewCLIMode(level *dungeon.Level, player *actor.Player) *CLIMode {
 		Level:    level,
 		Player:   player,
 		Commands: make(map[string]*Command),
+		Session:  session.NewWithLevel(player, level),
 	}
 
 	cli.registerCommands()
@@ -67,48 +80,56 @@ func (c *CLIMode) registerCommands() {
 			Description: "Heal player",
 			Usage:       "heal [amount|full]",
 			Handler:     c.healCommand,
+			Cheat:       true,
 		},
 		{
 			Name:        "gold",
 			Description: "Add gold to player",
 			Usage:       "gold <amount>",
 			Handler:     c.goldCommand,
+			Cheat:       true,
 		},
 		{
 			Name:        "exp",
 			Description: "Add experience to player",
 			Usage:       "exp <amount>",
 			Handler:     c.expCommand,
+			Cheat:       true,
 		},
 		{
 			Name:        "teleport",
 			Description: "Teleport player to coordinates",
 			Usage:       "teleport <x> <y>",
 			Handler:     c.teleportCommand,
+			Cheat:       true,
 		},
 		{
 			Name:        "create",
 			Description: "Create item at position",
 			Usage:       "create <item_type> [x] [y]",
 			Handler:     c.createCommand,
+			Cheat:       true,
 		},
 		{
 			Name:        "kill",
 			Description: "Kill monsters",
 			Usage:       "kill [all|<x> <y>]",
 			Handler:     c.killCommand,
+			Cheat:       true,
 		},
 		{
 			Name:        "level",
 			Description: "Change dungeon level",
 			Usage:       "level <floor>",
 			Handler:     c.levelCommand,
+			Cheat:       true,
 		},
 		{
 			Name:        "identify",
 			Description: "Identify all items",
 			Usage:       "identify [all|<item_type>]",
 			Handler:     c.identifyCommand,
+			Cheat:       true,
 		},
 		{
 			Name:        "map",
@@ -134,17 +155,25 @@ func (c *CLIMode) registerCommands() {
 			Usage:       "load [filename]",
 			Handler:     c.loadCommand,
 		},
+		{
+			Name:        "morgue",
+			Description: "Write a character dump",
+			Usage:       "morgue [directory]",
+			Handler:     c.morgueCommand,
+		},
 		{
 			Name:        "set",
 			Description: "Set player attributes",
 			Usage:       "set <attribute> <value>",
 			Handler:     c.setCommand,
+			Cheat:       true,
 		},
 		{
 			Name:        "spawn",
 			Description: "Spawn monsters",
 			Usage:       "spawn <monster_type> [x] [y] [count]",
 			Handler:     c.spawnCommand,
+			Cheat:       true,
 		},
 		{
 			Name:        "debug",
@@ -175,12 +204,22 @@ func (c *CLIMode) ExecuteCommand(input string) string {
 
 	if cmd, exists := c.Commands[commandName]; exists {
 		logger.Info("CLI command executed", "command", commandName, "args", args)
+		if cmd.Cheat {
+			c.cheat()
+		}
 		return cmd.Handler(args)
 	}
 
 	return fmt.Sprintf("Unknown command: %s. Type 'help' for available commands.", commandName)
 }
 
+// cheat reports that the game was changed outside the rules
+func (c *CLIMode) cheat() {
+	if c.OnCheat != nil {
+		c.OnCheat()
+	}
+}
+
 // Toggle toggles CLI mode on/off
 func (c *CLIMode) Toggle() {
 	c.IsActive = !c.IsActive
@@ -443,6 +482,7 @@ func (c *CLIMode) inventoryCommand(args []string) string {
 	}
 
 	if args[0] == "clear" {
+		c.cheat()
 		count := c.Player.Inventory.Size()
 		c.Player.Inventory.Items = make([]*item.Item, 0)
 		return fmt.Sprintf("Cleared %d items from inventory", count)
@@ -473,6 +513,27 @@ func (c *CLIMode) loadCommand(args []string) string {
 	return fmt.Sprintf("Game loaded from %s (TODO: implement)", filename)
 }
 
+// morgueCommand writes a morgue file for the current character
+func (c *CLIMode) morgueCommand(args []string) string {
+	dir := morgue.GetMorgueDir()
+	if len(args) > 0 {
+		dir = args[0]
+	}
+
+	var report *morgue.Report
+	if c.MorgueReport != nil {
+		report = c.MorgueReport()
+	} else {
+		report = &morgue.Report{Player: c.Player, Level: c.Level, Floor: c.Level.FloorNumber}
+	}
+
+	path, err := morgue.Write(dir, report)
+	if err != nil {
+		return fmt.Sprintf("Failed to write morgue file: %v", err)
+	}
+	return fmt.Sprintf("Morgue file written to %s", path)
+}
+
 // setCommand sets player attributes
 func (c *CLIMode) setCommand(args []string) string {
 	if len(args) < 2 {
@@ -4,6 +4,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/yuru-sha/gorogue/internal/core/event"
 	"github.com/yuru-sha/gorogue/internal/game/actor"
 	"github.com/yuru-sha/gorogue/internal/game/dungeon"
 	"github.com/yuru-sha/gorogue/internal/utils/logger"
@@ -213,6 +214,31 @@ func TestCLIModeHealCommand(t *testing.T) {
 	}
 }
 
+func TestCLIModeReportsCheats(t *testing.T) {
+	player := actor.NewPlayer(5, 5)
+	cli := NewCLIMode(&dungeon.Level{}, player)
+	cli.IsActive = true
+
+	cheats := 0
+	cli.OnCheat = func() { cheats++ }
+
+	// 見るだけのコマンドは裏技にならない
+	for _, input := range []string{"status", "inventory", "map info", "help"} {
+		cli.ExecuteCommand(input)
+	}
+	if cheats != 0 {
+		t.Errorf("Expected no cheats from read-only commands, got %d", cheats)
+	}
+
+	for _, input := range []string{"heal 5", "gold 100", "inventory clear"} {
+		before := cheats
+		cli.ExecuteCommand(input)
+		if cheats != before+1 {
+			t.Errorf("Expected %q to be reported as a cheat", input)
+		}
+	}
+}
+
 func TestCLIModeGoldCommand(t *testing.T) {
 	player := actor.NewPlayer(5, 5)
 	originalGold := player.Gold
@@ -270,3 +296,42 @@ func TestCLIModeInvalidArguments(t *testing.T) {
 		})
 	}
 }
+
+func TestCLIModePublishesEvents(t *testing.T) {
+	player := actor.NewPlayer(5, 5)
+	level := &dungeon.Level{
+		Width:    20,
+		Height:   20,
+		Monsters: []*actor.Monster{actor.NewMonster(6, 5, 'K')},
+	}
+	cli := NewCLIMode(level, player)
+	cli.IsActive = true
+	bus := event.NewBus()
+	cli.Session.SetEventBus(bus)
+
+	var kinds []event.Kind
+	bus.SubscribeAll(func(ev event.Event) {
+		kinds = append(kinds, ev.Kind())
+	})
+
+	// 倒れるまで攻撃する
+	for i := 0; i < 20 && level.Monsters[0].IsAlive(); i++ {
+		cli.ExecuteCommand("attack 6 5")
+	}
+
+	if len(kinds) == 0 || kinds[0] != event.KindAttack {
+		t.Fatalf("Expected attack events, got %v", kinds)
+	}
+	killed := false
+	for _, kind := range kinds {
+		if kind == event.KindMonsterKilled {
+			killed = true
+		}
+	}
+	if !killed {
+		t.Errorf("Expected the kill to be published, got %v", kinds)
+	}
+	if player.Gold == 0 {
+		t.Error("Killing a monster via CLI should grant gold")
+	}
+}
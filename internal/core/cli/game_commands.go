@@ -5,9 +5,10 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/yuru-sha/gorogue/internal/core/event"
+	"github.com/yuru-sha/gorogue/internal/core/session"
+	"github.com/yuru-sha/gorogue/internal/game/actor"
 	"github.com/yuru-sha/gorogue/internal/game/item"
-	"github.com/yuru-sha/gorogue/internal/game/magic"
-	"github.com/yuru-sha/gorogue/internal/utils/logger"
 )
 
 const invalidItemLetterMsg = "Invalid item letter. Use a-z."
@@ -21,6 +22,12 @@ func (c *CLIMode) registerGameCommands() {
 			Usage:       "move <direction>",
 			Handler:     c.moveCommand,
 		},
+		{
+			Name:        "run",
+			Description: "Run in direction until something interesting happens",
+			Usage:       "run <direction>",
+			Handler:     c.runCommand,
+		},
 		{
 			Name:        "pickup",
 			Description: "Pick up item at current position",
@@ -51,6 +58,12 @@ func (c *CLIMode) registerGameCommands() {
 			Usage:       "use <item_letter>",
 			Handler:     c.useCommand,
 		},
+		{
+			Name:        "call",
+			Description: "Name an unidentified item kind",
+			Usage:       "call <item_letter> [label]",
+			Handler:     c.callCommand,
+		},
 		{
 			Name:        "attack",
 			Description: "Attack monster at position",
@@ -71,8 +84,8 @@ func (c *CLIMode) registerGameCommands() {
 		},
 		{
 			Name:        "search",
-			Description: "Search for hidden doors/traps",
-			Usage:       "search",
+			Description: "Search for hidden doors/traps for turns",
+			Usage:       "search [turns]",
 			Handler:     c.searchCommand,
 		},
 		{
@@ -93,6 +106,12 @@ func (c *CLIMode) registerGameCommands() {
 			Usage:       "stairs <up|down>",
 			Handler:     c.stairsCommand,
 		},
+		{
+			Name:        "travel",
+			Description: "Travel to a known position or staircase",
+			Usage:       "travel <x> <y> | travel <up|down>",
+			Handler:     c.travelCommand,
+		},
 		{
 			Name:        "auto",
 			Description: "Auto-explore or auto-pickup",
@@ -118,108 +137,86 @@ func (c *CLIMode) moveCommand(args []string) string {
 		return "Usage: move <direction>\nDirections: n, s, e, w, ne, nw, se, sw, up, down"
 	}
 
-	var dx, dy int
-	direction := strings.ToLower(args[0])
-
-	switch direction {
-	case "n", "north", "up", "k":
-		dx, dy = 0, -1
-	case "s", "south", "down", "j":
-		dx, dy = 0, 1
-	case "e", "east", "right", "l":
-		dx, dy = 1, 0
-	case "w", "west", "left", "h":
-		dx, dy = -1, 0
-	case "ne", "northeast", "u":
-		dx, dy = 1, -1
-	case "nw", "northwest", "y":
-		dx, dy = -1, -1
-	case "se", "southeast", "m":
-		dx, dy = 1, 1
-	case "sw", "southwest", "b":
-		dx, dy = -1, 1
-	default:
-		return fmt.Sprintf("Unknown direction: %s", direction)
+	dx, dy, ok := parseDirection(args[0])
+	if !ok {
+		return fmt.Sprintf("Unknown direction: %s", args[0])
 	}
 
-	oldX, oldY := c.Player.Position.X, c.Player.Position.Y
-	newX, newY := oldX+dx, oldY+dy
+	newX, newY := c.Player.Position.X+dx, c.Player.Position.Y+dy
 
 	// Check bounds
 	if newX < 0 || newX >= c.Level.Width || newY < 0 || newY >= c.Level.Height {
 		return "Cannot move out of bounds"
 	}
 
-	// Check for walls (simplified)
-	tile := c.Level.GetTile(newX, newY)
-	if tile != nil && !tile.Walkable() {
+	// 移動先にモンスターがいればセッションが攻撃に切り替える
+	result := c.perform(session.Move(dx, dy))
+	if !result.Done {
 		return "Cannot move there - blocked"
 	}
+	return c.describe(result)
+}
 
-	// Check for monsters
-	monster := c.Level.GetMonsterAt(newX, newY)
-	if monster != nil && monster.IsAlive() {
-		// Attack instead of move
-		damage := c.Player.CalculateDamage(monster.Defense)
-		monster.TakeDamage(damage)
-
-		if monster.IsAlive() {
-			return fmt.Sprintf("Attacked %s for %d damage! (%d HP remaining)",
-				monster.Type.Name, damage, monster.HP)
-		} else {
-			exp := monster.MaxHP + monster.Attack
-			c.Player.GainExp(exp)
-			return fmt.Sprintf("Killed %s! Gained %d experience.", monster.Type.Name, exp)
-		}
+// runCommand runs in a direction until something interesting happens
+func (c *CLIMode) runCommand(args []string) string {
+	if len(args) == 0 {
+		return "Usage: run <direction>\nDirections: n, s, e, w, ne, nw, se, sw"
 	}
 
-	// Move player
-	c.Player.Position.X = newX
-	c.Player.Position.Y = newY
+	dx, dy, ok := parseDirection(args[0])
+	if !ok {
+		return fmt.Sprintf("Unknown direction: %s", args[0])
+	}
 
-	logger.Debug("Player moved via CLI", "from", fmt.Sprintf("(%d,%d)", oldX, oldY),
-		"to", fmt.Sprintf("(%d,%d)", newX, newY))
+	result := c.Session.Run(dx, dy)
+	c.Level = c.Session.Level()
+	return c.summarizeRun(fmt.Sprintf("Ran %d steps to (%d, %d).", result.Steps, c.Player.Position.X, c.Player.Position.Y), result)
+}
 
-	return fmt.Sprintf("Moved %s to (%d, %d)", direction, newX, newY)
+// parseDirection converts a direction name or vi-key to a step
+func parseDirection(direction string) (dx, dy int, ok bool) {
+	switch strings.ToLower(direction) {
+	case "n", "north", "up", "k":
+		return 0, -1, true
+	case "s", "south", "down", "j":
+		return 0, 1, true
+	case "e", "east", "right", "l":
+		return 1, 0, true
+	case "w", "west", "left", "h":
+		return -1, 0, true
+	case "ne", "northeast", "u":
+		return 1, -1, true
+	case "nw", "northwest", "y":
+		return -1, -1, true
+	case "se", "southeast", "m":
+		return 1, 1, true
+	case "sw", "southwest", "b":
+		return -1, 1, true
+	default:
+		return 0, 0, false
+	}
 }
 
 // pickupCommand picks up items
 func (c *CLIMode) pickupCommand(args []string) string {
-	currentPos := c.Player.Position
-	itemsAtPos := make([]*item.Item, 0)
-
-	// Find items at current position
-	for _, itm := range c.Level.Items {
-		if itm.Position.X == currentPos.X && itm.Position.Y == currentPos.Y {
-			itemsAtPos = append(itemsAtPos, itm)
-		}
-	}
-
-	if len(itemsAtPos) == 0 {
+	if c.Level.GetItemAt(c.Player.Position.X, c.Player.Position.Y) == nil {
 		return "No items here to pick up."
 	}
 
 	if len(args) > 0 && args[0] == "all" {
 		// Pick up all items
 		pickedUp := 0
-		for _, itm := range itemsAtPos {
-			if c.Player.Inventory.AddItem(itm) {
-				c.Level.RemoveItem(itm)
-				pickedUp++
+		for c.Level.GetItemAt(c.Player.Position.X, c.Player.Position.Y) != nil {
+			if !c.perform(session.PickUp()).Done {
+				break
 			}
+			pickedUp++
 		}
 		return fmt.Sprintf("Picked up %d items.", pickedUp)
 	}
 
 	// Pick up first item
-	itm := itemsAtPos[0]
-	if c.Player.Inventory.AddItem(itm) {
-		c.Level.RemoveItem(itm)
-		displayName := c.Player.IdentifyMgr.GetDisplayName(itm)
-		return fmt.Sprintf("Picked up %s.", displayName)
-	}
-
-	return "Inventory is full!"
+	return c.describe(c.perform(session.PickUp()))
 }
 
 // dropCommand drops items
@@ -228,29 +225,12 @@ func (c *CLIMode) dropCommand(args []string) string {
 		return "Usage: drop <item_letter>\nExample: drop a"
 	}
 
-	letter := args[0]
-	if len(letter) != 1 || letter[0] < 'a' || letter[0] > 'z' {
-		return invalidItemLetterMsg
-	}
-
-	index := int(letter[0] - 'a')
-	itm := c.Player.Inventory.GetItem(index)
+	index, itm, errMsg := c.inventoryItem(args[0])
 	if itm == nil {
-		return fmt.Sprintf("No item at slot %s.", letter)
+		return errMsg
 	}
 
-	// Set item position to player position
-	itm.Position.X = c.Player.Position.X
-	itm.Position.Y = c.Player.Position.Y
-
-	// Add to level items
-	c.Level.Items = append(c.Level.Items, itm)
-
-	// Remove from inventory
-	c.Player.Inventory.RemoveItem(index)
-
-	displayName := c.Player.IdentifyMgr.GetDisplayName(itm)
-	return fmt.Sprintf("Dropped %s.", displayName)
+	return c.describe(c.perform(session.Drop(index)))
 }
 
 // equipCommand equips items
@@ -259,36 +239,15 @@ func (c *CLIMode) equipCommand(args []string) string {
 		return "Usage: equip <item_letter>\nExample: equip a"
 	}
 
-	letter := args[0]
-	if len(letter) != 1 || letter[0] < 'a' || letter[0] > 'z' {
-		return invalidItemLetterMsg
-	}
-
-	index := int(letter[0] - 'a')
-	itm := c.Player.Inventory.GetItem(index)
+	index, itm, errMsg := c.inventoryItem(args[0])
 	if itm == nil {
-		return fmt.Sprintf("No item at slot %s.", letter)
+		return errMsg
 	}
-
-	// Check if item can be equipped
-	canEquip := false
-	switch itm.Type {
-	case item.ItemWeapon, item.ItemArmor, item.ItemRing:
-		canEquip = true
-	}
-
-	if !canEquip {
+	if !session.CanEquip(itm) {
 		return "That item cannot be equipped."
 	}
 
-	// Try to equip
-	if c.Player.Equipment.EquipItem(itm) {
-		c.Player.Inventory.RemoveItem(index)
-		displayName := c.Player.IdentifyMgr.GetDisplayName(itm)
-		return fmt.Sprintf("Equipped %s.", displayName)
-	}
-
-	return "Cannot equip that item (slot occupied?)."
+	return c.describe(c.perform(session.Equip(index)))
 }
 
 // unequipCommand unequips items
@@ -297,41 +256,64 @@ func (c *CLIMode) unequipCommand(args []string) string {
 		return "Usage: unequip <slot>\nSlots: weapon, armor, ring-left, ring-right"
 	}
 
-	slot := strings.ToLower(args[0])
-	var slotName string
-
-	switch slot {
+	var slot string
+	switch strings.ToLower(args[0]) {
 	case "weapon", "w":
-		slotName = "weapon"
+		slot = session.SlotWeapon
 	case "armor", "a":
-		slotName = "armor"
+		slot = session.SlotArmor
 	case "ring-left", "left", "l":
-		slotName = "ring_left"
+		slot = session.SlotRingLeft
 	case "ring-right", "right", "r":
-		slotName = "ring_right"
+		slot = session.SlotRingRight
 	default:
 		return "Unknown slot. Use: weapon, armor, ring-left, ring-right"
 	}
 
-	itm := c.Player.Equipment.UnequipItem(slotName)
+	return c.describe(c.perform(session.Unequip(slot)))
+}
+
+// useCommand uses items
+func (c *CLIMode) useCommand(args []string) string {
+	if len(args) == 0 {
+		return "Usage: use <item_letter>\nExample: use a"
+	}
+
+	index, itm, errMsg := c.inventoryItem(args[0])
 	if itm == nil {
-		return fmt.Sprintf("No item equipped in %s slot.", slot)
+		return errMsg
+	}
+
+	switch itm.Type {
+	case item.ItemPotion:
+		return c.describe(c.perform(session.Quaff(index)))
+	case item.ItemScroll:
+		return c.describe(c.perform(session.Read(index)))
+	case item.ItemFood:
+		return c.describe(c.perform(session.Eat(index)))
+	default:
+		return "That item cannot be used."
 	}
+}
 
-	if c.Player.Inventory.AddItem(itm) {
-		displayName := c.Player.IdentifyMgr.GetDisplayName(itm)
-		return fmt.Sprintf("Unequipped %s.", displayName)
+// inventoryItem resolves an item letter to its inventory index
+func (c *CLIMode) inventoryItem(letter string) (int, *item.Item, string) {
+	if len(letter) != 1 || letter[0] < 'a' || letter[0] > 'z' {
+		return 0, nil, invalidItemLetterMsg
 	}
 
-	// Inventory full, re-equip item
-	c.Player.Equipment.EquipItem(itm)
-	return "Inventory is full! Cannot unequip."
+	index := int(letter[0] - 'a')
+	itm := c.Player.Inventory.GetItem(index)
+	if itm == nil {
+		return index, nil, fmt.Sprintf("No item at slot %s.", letter)
+	}
+	return index, itm, ""
 }
 
-// useCommand uses items
-func (c *CLIMode) useCommand(args []string) string {
+// callCommand assigns a player label to an unidentified item kind
+func (c *CLIMode) callCommand(args []string) string {
 	if len(args) == 0 {
-		return "Usage: use <item_letter>\nExample: use a"
+		return "Usage: call <item_letter> [label]\nExample: call a heal?"
 	}
 
 	letter := args[0]
@@ -345,32 +327,16 @@ func (c *CLIMode) useCommand(args []string) string {
 		return fmt.Sprintf("No item at slot %s.", letter)
 	}
 
-	var result *magic.EffectResult
-
-	switch itm.Type {
-	case item.ItemPotion:
-		result = magic.UsePotion(itm.Name, c.Player)
-	case item.ItemScroll:
-		result = magic.UseScroll(itm.Name, c.Player, c.Level)
-	case item.ItemFood:
-		c.Player.Hunger = 100
-		result = &magic.EffectResult{
-			Message:    "You eat the food and feel satisfied.",
-			Success:    true,
-			Identified: true,
-		}
-	default:
-		return "That item cannot be used."
+	if !c.Player.IdentifyMgr.CanCall(itm) {
+		return "You don't need to call that."
 	}
 
-	if result.Identified {
-		c.Player.IdentifyMgr.IdentifyByUse(itm)
+	label := strings.Join(args[1:], " ")
+	c.Player.IdentifyMgr.CallItem(itm, label)
+	if label == "" {
+		return fmt.Sprintf("Forgot the name. It is now %s.", c.Player.IdentifyMgr.GetDisplayName(itm))
 	}
-
-	// Remove item from inventory
-	c.Player.Inventory.RemoveItem(index)
-
-	return result.Message
+	return fmt.Sprintf("You now know it as %s.", c.Player.IdentifyMgr.GetDisplayName(itm))
 }
 
 // attackCommand attacks monsters
@@ -391,17 +357,13 @@ func (c *CLIMode) attackCommand(args []string) string {
 		return fmt.Sprintf("No monster at (%d, %d).", x, y)
 	}
 
-	damage := c.Player.CalculateDamage(monster.Defense)
-	monster.TakeDamage(damage)
-
-	if monster.IsAlive() {
-		return fmt.Sprintf("Attacked %s for %d damage! (%d HP remaining)",
-			monster.Type.Name, damage, monster.HP)
-	} else {
-		exp := monster.MaxHP + monster.Attack
-		c.Player.GainExp(exp)
-		return fmt.Sprintf("Killed %s! Gained %d experience.", monster.Type.Name, exp)
+	// 近接攻撃なので隣接しているモンスターだけを攻撃できる
+	dx, dy := x-c.Player.Position.X, y-c.Player.Position.Y
+	if dx < -1 || dx > 1 || dy < -1 || dy > 1 {
+		return fmt.Sprintf("%s at (%d, %d) is out of reach.", monster.Type.Name, x, y)
 	}
+
+	return c.describe(c.perform(session.Move(dx, dy)))
 }
 
 // lookCommand examines positions or items
@@ -427,74 +389,41 @@ func (c *CLIMode) lookCommand(args []string) string {
 }
 
 // describeTile describes what's at a tile
+// 画面の調べるモードと同じセッションの説明を使う
 func (c *CLIMode) describeTile(x, y int) string {
-	if x < 0 || x >= c.Level.Width || y < 0 || y >= c.Level.Height {
+	desc, ok := c.Session.Describe(x, y)
+	if !ok {
 		return "Out of bounds."
 	}
+	return strings.Join(desc.Lines(), "\n")
+}
 
-	description := fmt.Sprintf("Position (%d, %d):\n", x, y)
-
-	// Tile info
-	tile := c.Level.GetTile(x, y)
-	if tile != nil {
-		description += fmt.Sprintf("Terrain: %s\n", tile.Type.String())
-	}
-
-	// Monster info
-	monster := c.Level.GetMonsterAt(x, y)
-	if monster != nil && monster.IsAlive() {
-		description += fmt.Sprintf("Monster: %s (HP: %d/%d)\n",
-			monster.Type.Name, monster.HP, monster.MaxHP)
-	}
-
-	// Item info
-	itemsHere := make([]*item.Item, 0)
-	for _, itm := range c.Level.Items {
-		if itm.Position.X == x && itm.Position.Y == y {
-			itemsHere = append(itemsHere, itm)
-		}
-	}
-
-	if len(itemsHere) > 0 {
-		description += "Items: "
-		for i, itm := range itemsHere {
-			if i > 0 {
-				description += ", "
-			}
-			displayName := c.Player.IdentifyMgr.GetDisplayName(itm)
-			description += displayName
-		}
-		description += "\n"
-	}
+// restCommand rests for turns until something interesting happens
+func (c *CLIMode) restCommand(args []string) string {
+	startHP := c.Player.HP
+	result := c.repeat(session.Wait(), args)
+	return c.summarizeRun(fmt.Sprintf("Rested for %d turns. (HP %d -> %d)", result.Steps, startHP, c.Player.HP), result)
+}
 
-	return strings.TrimSpace(description)
+// searchCommand searches for hidden things for turns
+func (c *CLIMode) searchCommand(args []string) string {
+	result := c.repeat(session.Search(), args)
+	return c.summarizeRun(fmt.Sprintf("Searched for %d turns.", result.Steps), result)
 }
 
-// restCommand rests for turns
-func (c *CLIMode) restCommand(args []string) string {
-	turns := 1
+// repeat performs an action the number of times given in args (once by default)
+// モンスターが見えたりHPが減ったりしたらセッションが途中で止める
+func (c *CLIMode) repeat(action session.Action, args []string) session.RunResult {
+	count := 1
 	if len(args) > 0 {
-		if t, err := strconv.Atoi(args[0]); err == nil && t > 0 {
-			turns = t
+		if n, err := strconv.Atoi(args[0]); err == nil && n > 0 {
+			count = n
 		}
 	}
 
-	if turns > 100 {
-		turns = 100 // Safety limit
-	}
-
-	healAmount := turns / 5 // Heal slowly while resting
-	if healAmount > 0 {
-		c.Player.Heal(healAmount)
-	}
-
-	return fmt.Sprintf("Rested for %d turns. (Healed %d HP)", turns, healAmount)
-}
-
-// searchCommand searches for hidden things
-func (c *CLIMode) searchCommand(args []string) string {
-	// TODO: Implement search functionality
-	return "You search carefully but find nothing hidden."
+	result := c.Session.Repeat(action, count)
+	c.Level = c.Session.Level()
+	return result
 }
 
 // openCommand opens doors
@@ -527,9 +456,9 @@ func (c *CLIMode) stairsCommand(args []string) string {
 
 	switch direction {
 	case "up", "u":
-		return "Climbed up the stairs. (TODO: implement level changing)"
+		return c.describe(c.perform(session.Ascend()))
 	case "down", "d":
-		return "Descended down the stairs. (TODO: implement level changing)"
+		return c.describe(c.perform(session.Descend()))
 	default:
 		return "Usage: stairs <up|down>"
 	}
@@ -545,7 +474,7 @@ func (c *CLIMode) autoCommand(args []string) string {
 
 	switch action {
 	case "explore":
-		return "Auto-explore started. (TODO: implement auto-explore)"
+		return c.autoExplore()
 	case "pickup":
 		return c.pickupCommand([]string{"all"})
 	default:
@@ -553,6 +482,62 @@ func (c *CLIMode) autoCommand(args []string) string {
 	}
 }
 
+// travelCommand walks to a known position or staircase
+func (c *CLIMode) travelCommand(args []string) string {
+	var result session.RunResult
+	switch {
+	case len(args) == 1 && (args[0] == "up" || args[0] == "<"):
+		result = c.Session.TravelToStairs(false)
+	case len(args) == 1 && (args[0] == "down" || args[0] == ">"):
+		result = c.Session.TravelToStairs(true)
+	case len(args) >= 2:
+		x, err1 := strconv.Atoi(args[0])
+		y, err2 := strconv.Atoi(args[1])
+		if err1 != nil || err2 != nil {
+			return "Invalid coordinates."
+		}
+		result = c.Session.Travel(x, y)
+	default:
+		return "Usage: travel <x> <y> | travel <up|down>"
+	}
+	c.Level = c.Session.Level()
+
+	return c.summarizeRun(fmt.Sprintf("Travelled %d steps.", result.Steps), result)
+}
+
+// autoExplore explores until something interesting happens
+func (c *CLIMode) autoExplore() string {
+	result := c.Session.AutoExplore()
+	c.Level = c.Session.Level()
+
+	return c.summarizeRun(fmt.Sprintf("Explored for %d steps.", result.Steps), result)
+}
+
+// summarizeRun formats an auto-explore or travel run
+func (c *CLIMode) summarizeRun(header string, result session.RunResult) string {
+	summary := fmt.Sprintf("%s %s", header, result.Reason)
+
+	// 一歩ごとの移動と繰り返し出るメッセージは省いて、戦闘やアイテムなどの出来事だけを表示する
+	notable := make([]event.Event, 0)
+	seen := make(map[string]bool)
+	for _, ev := range result.Events {
+		if ev.Kind() == event.KindPlayerMoved {
+			continue
+		}
+		if msg, ok := ev.(event.MessageEvent); ok {
+			if seen[msg.Text] {
+				continue
+			}
+			seen[msg.Text] = true
+		}
+		notable = append(notable, ev)
+	}
+	if details := c.describe(session.Result{Events: notable}); details != "Nothing happens." {
+		summary += "\n" + details
+	}
+	return summary
+}
+
 // gameCommand handles game control
 func (c *CLIMode) gameCommand(args []string) string {
 	if len(args) == 0 {
@@ -582,3 +567,57 @@ func (c *CLIMode) gameCommand(args []string) string {
 		return "Usage: game <new|save|load|quit>"
 	}
 }
+
+// perform runs an action on the game session and follows floor changes
+func (c *CLIMode) perform(action session.Action) session.Result {
+	result := c.Session.Do(action)
+	c.Level = c.Session.Level()
+	return result
+}
+
+// describe formats the events of an action as CLI output
+func (c *CLIMode) describe(result session.Result) string {
+	lines := make([]string, 0, len(result.Events))
+	for _, ev := range result.Events {
+		switch e := ev.(type) {
+		case event.PlayerMovedEvent:
+			lines = append(lines, fmt.Sprintf("Moved to (%d, %d)", e.To.X, e.To.Y))
+		case event.AttackEvent:
+			lines = append(lines, fmt.Sprintf("Attacked %s for %d damage! (%d HP remaining)",
+				e.Monster.Type.Name, e.Damage, e.Monster.HP))
+		case event.MonsterKilledEvent:
+			lines = append(lines, fmt.Sprintf("Killed %s! Gained %d experience and %d gold.",
+				e.Monster.Type.Name, e.Exp, e.Gold))
+		case event.StatusChangedEvent:
+			if e.Status == event.StatusLevelUp {
+				lines = append(lines, fmt.Sprintf("Welcome to level %d!", e.Level))
+			}
+		case event.PlayerDamagedEvent:
+			lines = append(lines, fmt.Sprintf("You took %d damage. (HP: %d/%d)", e.Damage, c.Player.HP, c.Player.MaxHP))
+		case event.ItemPickedUpEvent:
+			lines = append(lines, fmt.Sprintf("Picked up %s.", c.Player.IdentifyMgr.GetDisplayName(e.Item)))
+		case event.ItemDroppedEvent:
+			lines = append(lines, fmt.Sprintf("Dropped %s.", c.Player.IdentifyMgr.GetDisplayName(e.Item)))
+		case event.ItemUsedEvent:
+			lines = append(lines, e.Message)
+		case event.FloorChangedEvent:
+			if e.Down {
+				lines = append(lines, fmt.Sprintf("Descended to floor %d.", e.Floor))
+			} else {
+				lines = append(lines, fmt.Sprintf("Climbed up to floor %d.", e.Floor))
+			}
+		case event.PlayerDiedEvent:
+			lines = append(lines, fmt.Sprintf("You died! (killed by %s)", actor.DeathCauseName(e.KilledBy)))
+		case event.MessageEvent:
+			lines = append(lines, e.Text)
+		}
+	}
+
+	if result.Snapshot.Escaped {
+		lines = append(lines, "You escaped with the Amulet of Yendor!")
+	}
+	if len(lines) == 0 {
+		return "Nothing happens."
+	}
+	return strings.Join(lines, "\n")
+}
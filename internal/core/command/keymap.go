@@ -0,0 +1,488 @@
+package command
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/anaseto/gruid"
+)
+
+// Built-in keymap presets
+const (
+	PresetRogue   = "rogue"   // Rogue 5.4 に近い配置
+	PresetVi      = "vi"      // PyRogue 標準の vi キー
+	PresetNumpad  = "numpad"  // テンキーで移動する
+	PresetNetHack = "nethack" // NetHack 風
+	DefaultPreset = PresetVi
+)
+
+// Presets lists the built-in keymap names
+var Presets = []string{PresetRogue, PresetVi, PresetNumpad, PresetNetHack}
+
+// Help categories of the actions
+const (
+	CategoryMovement   = "movement"
+	CategoryActions    = "actions"
+	CategoryNavigation = "navigation"
+	CategorySystem     = "system"
+)
+
+// Categories lists the help categories in display order
+var Categories = []string{CategoryMovement, CategoryActions, CategoryNavigation, CategorySystem}
+
+// action is a command that can be bound to keys by name
+type action struct {
+	name     string
+	category string
+	cmd      Command
+}
+
+// actions lists every bindable command in help order
+// 名前はキーマップファイルで使うので変えないこと
+var actions = []action{
+	{"move_west", CategoryMovement, Command{Type: CmdMoveWest, Direction: Direction{X: -1, Y: 0}}},
+	{"move_south", CategoryMovement, Command{Type: CmdMoveSouth, Direction: Direction{X: 0, Y: 1}}},
+	{"move_north", CategoryMovement, Command{Type: CmdMoveNorth, Direction: Direction{X: 0, Y: -1}}},
+	{"move_east", CategoryMovement, Command{Type: CmdMoveEast, Direction: Direction{X: 1, Y: 0}}},
+	{"move_north_west", CategoryMovement, Command{Type: CmdMoveNorthWest, Direction: Direction{X: -1, Y: -1}}},
+	{"move_north_east", CategoryMovement, Command{Type: CmdMoveNorthEast, Direction: Direction{X: 1, Y: -1}}},
+	{"move_south_west", CategoryMovement, Command{Type: CmdMoveSouthWest, Direction: Direction{X: -1, Y: 1}}},
+	{"move_south_east", CategoryMovement, Command{Type: CmdMoveSouthEast, Direction: Direction{X: 1, Y: 1}}},
+	{"run_west", CategoryMovement, Command{Type: CmdMoveWest, Direction: Direction{X: -1, Y: 0}, Run: true}},
+	{"run_south", CategoryMovement, Command{Type: CmdMoveSouth, Direction: Direction{X: 0, Y: 1}, Run: true}},
+	{"run_north", CategoryMovement, Command{Type: CmdMoveNorth, Direction: Direction{X: 0, Y: -1}, Run: true}},
+	{"run_east", CategoryMovement, Command{Type: CmdMoveEast, Direction: Direction{X: 1, Y: 0}, Run: true}},
+	{"run_north_west", CategoryMovement, Command{Type: CmdMoveNorthWest, Direction: Direction{X: -1, Y: -1}, Run: true}},
+	{"run_north_east", CategoryMovement, Command{Type: CmdMoveNorthEast, Direction: Direction{X: 1, Y: -1}, Run: true}},
+	{"run_south_west", CategoryMovement, Command{Type: CmdMoveSouthWest, Direction: Direction{X: -1, Y: 1}, Run: true}},
+	{"run_south_east", CategoryMovement, Command{Type: CmdMoveSouthEast, Direction: Direction{X: 1, Y: 1}, Run: true}},
+	{"wait", CategoryMovement, Command{Type: CmdWait}},
+
+	{"inventory", CategoryActions, Command{Type: CmdInventory}},
+	{"pick_up", CategoryActions, Command{Type: CmdPickUp}},
+	{"drop", CategoryActions, Command{Type: CmdDrop}},
+	{"use", CategoryActions, Command{Type: CmdUse}},
+	{"quaff", CategoryActions, Command{Type: CmdQuaff}},
+	{"read", CategoryActions, Command{Type: CmdRead}},
+	{"wield", CategoryActions, Command{Type: CmdWield}},
+	{"take_off", CategoryActions, Command{Type: CmdTakeOff}},
+	{"equip", CategoryActions, Command{Type: CmdEquip}},
+	{"unequip", CategoryActions, Command{Type: CmdUnequip}},
+	{"search", CategoryActions, Command{Type: CmdSearch}},
+	{"open", CategoryActions, Command{Type: CmdOpen}},
+	{"close", CategoryActions, Command{Type: CmdClose}},
+	{"fight", CategoryActions, Command{Type: CmdFight}},
+	{"disarm", CategoryActions, Command{Type: CmdDisarm}},
+	{"look", CategoryActions, Command{Type: CmdLook}},
+	{"call", CategoryActions, Command{Type: CmdCall}},
+	{"count", CategoryActions, Command{Type: CmdCount}},
+	{"repeat", CategoryActions, Command{Type: CmdRepeat}},
+
+	{"upstairs", CategoryNavigation, Command{Type: CmdGoUpstairs}},
+	{"downstairs", CategoryNavigation, Command{Type: CmdGoDownstairs}},
+	{"explore", CategoryNavigation, Command{Type: CmdExplore}},
+	{"travel", CategoryNavigation, Command{Type: CmdTravel}},
+
+	{"messages", CategorySystem, Command{Type: CmdMessages}},
+	{"redraw", CategorySystem, Command{Type: CmdRedraw}},
+	{"toggle_fov", CategorySystem, Command{Type: CmdToggleFOV}},
+	{"help", CategorySystem, Command{Type: CmdHelp}},
+	{"save", CategorySystem, Command{Type: CmdSave}},
+	{"load", CategorySystem, Command{Type: CmdLoad}},
+	{"quit", CategorySystem, Command{Type: CmdQuit}},
+	{"escape", CategorySystem, Command{Type: CmdEscape}},
+	{"wizard", CategorySystem, Command{Type: CmdWizard}},
+	{"cli", CategorySystem, Command{Type: CmdCLI}},
+}
+
+// requiredActions must keep at least one key, or the player could get stuck
+var requiredActions = []string{"escape", "help", "quit"}
+
+// bindings maps action names to the keys bound to them
+type bindings map[string][]string
+
+// commonBindings are shared by every preset; presets override what they need
+// 移動・走行キーは各プリセットで定義する
+var commonBindings = bindings{
+	"wait":       {".", "Space"},
+	"inventory":  {"i"},
+	"pick_up":    {",", "g"},
+	"search":     {"s"},
+	"open":       {"o"},
+	"close":      {"c"},
+	"call":       {"C"},
+	"repeat":     {"a"},
+	"upstairs":   {"<"},
+	"downstairs": {">"},
+	"explore":    {"X"},
+	"travel":     {"_"},
+	"messages":   {"Ctrl+P", "Ctrl+R"},
+	"redraw":     {"Ctrl+L"},
+	"toggle_fov": {"Tab"},
+	"help":       {"?"},
+	"save":       {"S", "Ctrl+S"},
+	"load":       {"O"},
+	"quit":       {"Q"},
+	"escape":     {"Escape"},
+	"wizard":     {"Ctrl+W"},
+	"cli":        {":"},
+}
+
+// viMovement binds hjklyubn and the arrow keys; shifted letters run
+var viMovement = bindings{
+	"move_west":       {"h", "ArrowLeft", "Left"},
+	"move_south":      {"j", "ArrowDown", "Down"},
+	"move_north":      {"k", "ArrowUp", "Up"},
+	"move_east":       {"l", "ArrowRight", "Right"},
+	"move_north_west": {"y"},
+	"move_north_east": {"u"},
+	"move_south_west": {"b"},
+	"move_south_east": {"n"},
+	"run_west":        {"H"},
+	"run_south":       {"J"},
+	"run_north":       {"K"},
+	"run_east":        {"L"},
+	"run_north_west":  {"Y"},
+	"run_north_east":  {"U"},
+	"run_south_west":  {"B"},
+	"run_south_east":  {"N"},
+}
+
+// presetBindings holds the changes each preset makes to the common bindings
+var presetBindings = map[string][]bindings{
+	// PyRogue 標準。u は北東への移動なので、使うは z
+	PresetVi: {viMovement, {
+		"drop":    {"d"},
+		"use":     {"z"},
+		"quaff":   {"q"},
+		"read":    {"r"},
+		"wield":   {"w"},
+		"equip":   {"e"},
+		"unequip": {"t"},
+		"fight":   {"f"},
+		"disarm":  {"D"},
+		"look":    {"x", "/"},
+	}},
+	// Rogue 5.4: W/T で着脱、P/R で指輪、c で名付け（扉を閉じるキーはない）
+	PresetRogue: {viMovement, {
+		"drop":     {"d"},
+		"use":      {"e"},
+		"quaff":    {"q"},
+		"read":     {"r"},
+		"wield":    {"w"},
+		"equip":    {"W", "P"},
+		"take_off": {"T", "R"},
+		"fight":    {"f"},
+		"disarm":   {"^"},
+		"look":     {"/"},
+		"call":     {"c"},
+		"close":    nil,
+	}},
+	// テンキーで移動し、回数は n の後に数字を打つ（NetHack の number_pad と同じ）
+	PresetNumpad: {{
+		"move_west":       {"4", "ArrowLeft", "Left"},
+		"move_south":      {"2", "ArrowDown", "Down"},
+		"move_north":      {"8", "ArrowUp", "Up"},
+		"move_east":       {"6", "ArrowRight", "Right"},
+		"move_north_west": {"7"},
+		"move_north_east": {"9"},
+		"move_south_west": {"1"},
+		"move_south_east": {"3"},
+		"run_west":        {"H"},
+		"run_south":       {"J"},
+		"run_north":       {"K"},
+		"run_east":        {"L"},
+		"run_north_west":  {"Y"},
+		"run_north_east":  {"U"},
+		"run_south_west":  {"B"},
+		"run_south_east":  {"N"},
+		"wait":            {"5", ".", "Space"},
+		"count":           {"n"},
+		"drop":            {"d"},
+		"use":             {"u", "z"},
+		"quaff":           {"q"},
+		"read":            {"r"},
+		"wield":           {"w"},
+		"equip":           {"e"},
+		"unequip":         {"t"},
+		"fight":           {"f"},
+		"disarm":          {"D"},
+		"look":            {"x", "/"},
+	}},
+	// NetHack 風: ; で遠くを見る、# で拡張コマンド、^A で繰り返し
+	PresetNetHack: {viMovement, {
+		"drop":     {"d"},
+		"use":      {"a"},
+		"quaff":    {"q"},
+		"read":     {"r"},
+		"wield":    {"w"},
+		"equip":    {"W", "P"},
+		"take_off": {"T", "R"},
+		"fight":    {"F"},
+		"look":     {";"},
+		"repeat":   {"Ctrl+A"},
+		"cli":      {"#"},
+	}},
+}
+
+// keyNames maps the names accepted in keymap files to gruid keys
+var keyNames = map[string]gruid.Key{
+	"space":      " ",
+	"tab":        gruid.KeyTab,
+	"enter":      gruid.KeyEnter,
+	"escape":     gruid.KeyEscape,
+	"esc":        gruid.KeyEscape,
+	"backspace":  gruid.KeyBackspace,
+	"delete":     gruid.KeyDelete,
+	"arrowleft":  gruid.KeyArrowLeft,
+	"arrowright": gruid.KeyArrowRight,
+	"arrowup":    gruid.KeyArrowUp,
+	"arrowdown":  gruid.KeyArrowDown,
+	"home":       gruid.KeyHome,
+	"end":        gruid.KeyEnd,
+	"pageup":     gruid.KeyPageUp,
+	"pagedown":   gruid.KeyPageDown,
+	"insert":     gruid.KeyInsert,
+}
+
+// ParseKey converts a key name from a keymap file to a gruid key
+// 1 文字はそのまま、"Space" や "Ctrl+P"（"^P"）のような名前も受け付ける
+func ParseKey(name string) (gruid.Key, error) {
+	if len([]rune(name)) == 1 {
+		return gruid.Key(name), nil
+	}
+	if key, ok := keyNames[strings.ToLower(name)]; ok {
+		return key, nil
+	}
+	letter := ""
+	switch {
+	case strings.HasPrefix(strings.ToLower(name), "ctrl+"):
+		letter = name[len("ctrl+"):]
+	case strings.HasPrefix(name, "^"):
+		letter = name[1:]
+	}
+	if len(letter) == 1 && (letter[0] >= 'a' && letter[0] <= 'z' || letter[0] >= 'A' && letter[0] <= 'Z') {
+		return gruid.Key("^" + strings.ToUpper(letter)), nil
+	}
+	// "Left" などテンキーのキー名はそのまま使う
+	if name == "Left" || name == "Right" || name == "Up" || name == "Down" {
+		return gruid.Key(name), nil
+	}
+	return "", fmt.Errorf("unknown key %q", name)
+}
+
+// KeyLabel returns a short name of the key for the help screen
+func KeyLabel(key gruid.Key) string {
+	k := string(key)
+	switch {
+	case key == " ":
+		return "Space"
+	case key == gruid.KeyEscape:
+		return "Esc"
+	case strings.HasPrefix(k, "Arrow"):
+		return k[len("Arrow"):]
+	case len(k) == 2 && k[0] == '^':
+		return "Ctrl+" + k[1:]
+	}
+	return k
+}
+
+// Keymap maps keys to commands; build it with Preset or LoadKeymap
+type Keymap struct {
+	name string
+	keys map[gruid.Key]Command
+	// 各アクションのキー（定義順）
+	bound map[string][]gruid.Key
+}
+
+// HelpEntry is one line of the generated help screen
+type HelpEntry struct {
+	Action string   // action name, e.g. "quaff"
+	Keys   []string // key labels, e.g. ["q"]
+}
+
+// keymapFile is the JSON layout of a user keymap file
+type keymapFile struct {
+	Preset   string              `json:"preset"`
+	Bindings map[string][]string `json:"bindings"`
+}
+
+// Preset returns a built-in keymap by name
+func Preset(name string) (*Keymap, error) {
+	b, err := presetSource(name)
+	if err != nil {
+		return nil, err
+	}
+	return buildKeymap(name, b)
+}
+
+// DefaultKeymap returns the default preset
+func DefaultKeymap() *Keymap {
+	km, err := Preset(DefaultPreset)
+	if err != nil {
+		// プリセットはテストで検証しているので起こらない
+		panic(err)
+	}
+	return km
+}
+
+// LoadKeymap loads the keymap from a user file on top of a preset
+// ファイルがなければプリセットをそのまま使う。ファイルの "preset" は引数より優先する
+func LoadKeymap(path, preset string) (*Keymap, error) {
+	if path == "" {
+		return Preset(preset)
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return Preset(preset)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read keymap file: %w", err)
+	}
+
+	var file keymapFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse keymap file %s: %w", path, err)
+	}
+	if file.Preset != "" {
+		preset = file.Preset
+	}
+	b, err := presetSource(preset)
+	if err != nil {
+		return nil, err
+	}
+	// ファイルに書いたアクションはプリセットのキーを置き換える（空なら解除）
+	for name, keys := range file.Bindings {
+		b[name] = keys
+	}
+
+	km, err := buildKeymap(preset+"+"+path, b)
+	if err != nil {
+		return nil, fmt.Errorf("invalid keymap file %s: %w", path, err)
+	}
+	return km, nil
+}
+
+// presetSource returns a copy of the preset's bindings
+func presetSource(name string) (bindings, error) {
+	layers, ok := presetBindings[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown keymap preset %q (available: %s)", name, strings.Join(Presets, ", "))
+	}
+	b := make(bindings)
+	for name, keys := range commonBindings {
+		b[name] = keys
+	}
+	for _, layer := range layers {
+		for name, keys := range layer {
+			b[name] = keys
+		}
+	}
+	return b, nil
+}
+
+// buildKeymap resolves key names and reports conflicts
+func buildKeymap(name string, b bindings) (*Keymap, error) {
+	byName := make(map[string]action, len(actions))
+	for _, a := range actions {
+		byName[a.name] = a
+	}
+
+	var problems []string
+	for actionName := range b {
+		if _, ok := byName[actionName]; !ok {
+			problems = append(problems, fmt.Sprintf("unknown command %q", actionName))
+		}
+	}
+
+	km := &Keymap{
+		name:  name,
+		keys:  make(map[gruid.Key]Command),
+		bound: make(map[string][]gruid.Key),
+	}
+	owner := make(map[gruid.Key]string)
+	for _, a := range actions {
+		for _, keyName := range b[a.name] {
+			key, err := ParseKey(keyName)
+			if err != nil {
+				problems = append(problems, fmt.Sprintf("%s: %v", a.name, err))
+				continue
+			}
+			if other, taken := owner[key]; taken {
+				if other != a.name {
+					problems = append(problems, fmt.Sprintf("key %q is bound to both %s and %s", KeyLabel(key), other, a.name))
+				}
+				continue
+			}
+			owner[key] = a.name
+			km.keys[key] = a.cmd
+			km.bound[a.name] = append(km.bound[a.name], key)
+		}
+	}
+	for _, required := range requiredActions {
+		if len(km.bound[required]) == 0 {
+			problems = append(problems, fmt.Sprintf("command %s must have a key", required))
+		}
+	}
+
+	if len(problems) > 0 {
+		sort.Strings(problems)
+		return nil, fmt.Errorf("keymap %s: %s", name, strings.Join(problems, "; "))
+	}
+	return km, nil
+}
+
+// Name returns the preset (and file) the keymap was built from
+func (km *Keymap) Name() string {
+	return km.name
+}
+
+// Lookup returns the command bound to the key
+func (km *Keymap) Lookup(key gruid.Key) (Command, bool) {
+	cmd, ok := km.keys[key]
+	return cmd, ok
+}
+
+// Keys returns the keys bound to an action name
+func (km *Keymap) Keys(actionName string) []gruid.Key {
+	return km.bound[actionName]
+}
+
+// HelpEntries returns the bound actions of a category in help order
+func (km *Keymap) HelpEntries(category string) []HelpEntry {
+	var entries []HelpEntry
+	for _, a := range actions {
+		if a.category != category || len(km.bound[a.name]) == 0 {
+			continue
+		}
+		entry := HelpEntry{Action: a.name}
+		seen := make(map[string]bool)
+		for _, key := range km.bound[a.name] {
+			// ArrowLeft とテンキーの Left は同じ表示になる
+			if label := KeyLabel(key); !seen[label] {
+				seen[label] = true
+				entry.Keys = append(entry.Keys, label)
+			}
+		}
+		entries = append(entries, entry)
+	}
+	if category == CategoryActions && len(km.bound["count"]) == 0 && km.DigitsCount() {
+		entries = append(entries, HelpEntry{Action: "count", Keys: []string{"0-9"}})
+	}
+	return entries
+}
+
+// DigitsCount reports whether plain digits start a count
+// 数字にコマンドを割り当てたキーマップでは回数キー（count）を先に押す
+func (km *Keymap) DigitsCount() bool {
+	for digit := '0'; digit <= '9'; digit++ {
+		if _, bound := km.keys[gruid.Key(string(digit))]; bound {
+			return false
+		}
+	}
+	return true
+}
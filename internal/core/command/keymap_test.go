@@ -0,0 +1,218 @@
+package command
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/anaseto/gruid"
+)
+
+// writeKeymapFile writes a keymap file into a temporary directory
+func writeKeymapFile(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "keymap.json")
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestPresets_NoConflicts(t *testing.T) {
+	for _, name := range Presets {
+		km, err := Preset(name)
+		if err != nil {
+			t.Errorf("Preset %s: %v", name, err)
+			continue
+		}
+		for _, required := range requiredActions {
+			if len(km.Keys(required)) == 0 {
+				t.Errorf("Preset %s: %s has no key", name, required)
+			}
+		}
+		// 8方向すべてに移動できること
+		for _, a := range actions {
+			if a.cmd.Type.IsMovement() && !a.cmd.Run && len(km.Keys(a.name)) == 0 {
+				t.Errorf("Preset %s: %s has no key", name, a.name)
+			}
+		}
+	}
+}
+
+func TestPreset_Unknown(t *testing.T) {
+	if _, err := Preset("emacs"); err == nil {
+		t.Error("Expected an error for an unknown preset")
+	}
+}
+
+func TestPreset_ViFixesCollisions(t *testing.T) {
+	km, err := Preset(PresetVi)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		key      gruid.Key
+		expected Type
+	}{
+		{"u", CmdMoveNorthEast},
+		{"z", CmdUse},
+		{"d", CmdDrop},
+		{"D", CmdDisarm},
+		{"q", CmdQuaff},
+		{"r", CmdRead},
+		{"^R", CmdMessages},
+	}
+	for _, tt := range tests {
+		cmd, ok := km.Lookup(tt.key)
+		if !ok || cmd.Type != tt.expected {
+			t.Errorf("Key %s: expected %v, got %v (bound=%v)", tt.key, tt.expected, cmd.Type, ok)
+		}
+	}
+}
+
+func TestLoadKeymap_MissingFileUsesPreset(t *testing.T) {
+	km, err := LoadKeymap(filepath.Join(t.TempDir(), "none.json"), PresetNetHack)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if km.Name() != PresetNetHack {
+		t.Errorf("Expected the nethack preset, got %s", km.Name())
+	}
+	if cmd, _ := km.Lookup(";"); cmd.Type != CmdLook {
+		t.Errorf("Expected ; to look in the nethack preset, got %v", cmd.Type)
+	}
+}
+
+func TestLoadKeymap_FileOverridesPreset(t *testing.T) {
+	path := writeKeymapFile(t, `{
+		"preset": "rogue",
+		"bindings": {
+			"disarm": ["Ctrl+D"],
+			"explore": [],
+			"close": ["Ctrl+C"]
+		}
+	}`)
+
+	km, err := LoadKeymap(path, PresetVi)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cmd, _ := km.Lookup("^D"); cmd.Type != CmdDisarm {
+		t.Errorf("Expected Ctrl+D to disarm, got %v", cmd.Type)
+	}
+	if _, ok := km.Lookup("^"); ok {
+		t.Error("The preset key for disarm should be replaced")
+	}
+	if _, ok := km.Lookup("X"); ok {
+		t.Error("An empty list should unbind the command")
+	}
+	// ファイルの preset が引数より優先される
+	if cmd, _ := km.Lookup("c"); cmd.Type != CmdCall {
+		t.Errorf("Expected c to call with the rogue preset, got %v", cmd.Type)
+	}
+}
+
+func TestLoadKeymap_Conflicts(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{"same key twice", `{"bindings": {"quaff": ["h"]}}`, `key "h" is bound to both move_west and quaff`},
+		{"unknown command", `{"bindings": {"fly": ["F"]}}`, `unknown command "fly"`},
+		{"unknown key", `{"bindings": {"quaff": ["Hyper+Q"]}}`, `unknown key "Hyper+Q"`},
+		{"required command", `{"bindings": {"escape": []}}`, "command escape must have a key"},
+		{"unknown preset", `{"preset": "emacs"}`, `unknown keymap preset "emacs"`},
+		{"bad json", `{"bindings": `, "failed to parse keymap file"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := LoadKeymap(writeKeymapFile(t, tt.content), PresetVi)
+			if err == nil {
+				t.Fatal("Expected an error")
+			}
+			if !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Expected error containing %q, got %q", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestParseKey(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected gruid.Key
+	}{
+		{"q", "q"},
+		{"Space", " "},
+		{"Esc", gruid.KeyEscape},
+		{"Tab", gruid.KeyTab},
+		{"ArrowLeft", gruid.KeyArrowLeft},
+		{"Ctrl+p", "^P"},
+		{"^W", "^W"},
+		{"Left", "Left"},
+	}
+	for _, tt := range tests {
+		key, err := ParseKey(tt.name)
+		if err != nil || key != tt.expected {
+			t.Errorf("ParseKey(%q) = %q, %v, expected %q", tt.name, key, err, tt.expected)
+		}
+	}
+}
+
+func TestKeymap_HelpEntries(t *testing.T) {
+	km := DefaultKeymap()
+
+	movement := km.HelpEntries(CategoryMovement)
+	if len(movement) == 0 || movement[0].Action != "move_west" {
+		t.Fatalf("Expected move_west first, got %v", movement)
+	}
+	// ArrowLeft とテンキーの Left は1つにまとめる
+	if got := strings.Join(movement[0].Keys, " "); got != "h Left" {
+		t.Errorf("Expected keys \"h Left\", got %q", got)
+	}
+
+	found := false
+	for _, entry := range km.HelpEntries(CategoryActions) {
+		if entry.Action == "count" {
+			found = strings.Join(entry.Keys, " ") == "0-9"
+		}
+		if entry.Action == "take_off" {
+			t.Error("Unbound commands should not be listed")
+		}
+	}
+	if !found {
+		t.Error("Expected digits to be listed as the count prefix")
+	}
+}
+
+func TestParser_NumpadCount(t *testing.T) {
+	km, err := Preset(PresetNumpad)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parser := NewParser()
+	parser.SetKeymap(km)
+
+	if cmd := parser.ParseKeyDown(gruid.MsgKeyDown{Key: "4"}); cmd.Type != CmdMoveWest {
+		t.Errorf("Expected 4 to move west, got %v", cmd.Type)
+	}
+
+	// n の後の数字は回数になる
+	for _, key := range []gruid.Key{"n", "1", "2"} {
+		if cmd := parser.ParseKeyDown(gruid.MsgKeyDown{Key: key}); cmd.Type != CmdCount {
+			t.Fatalf("Expected %s to be part of a count, got %v", key, cmd.Type)
+		}
+	}
+	cmd := parser.ParseKeyDown(gruid.MsgKeyDown{Key: "s"})
+	if cmd.Type != CmdSearch || cmd.Count != 12 {
+		t.Errorf("Expected search 12 times, got %v x%d", cmd.Type, cmd.Count)
+	}
+
+	if cmd := parser.ParseKeyDown(gruid.MsgKeyDown{Key: "8"}); cmd.Type != CmdMoveNorth {
+		t.Errorf("Digits should move again after the count is used, got %v", cmd.Type)
+	}
+}
@@ -22,6 +22,7 @@ func TestParser_BasicMovement(t *testing.T) {
 
 		// Diagonal movement
 		{"y", CmdMoveNorthWest, Direction{X: -1, Y: -1}},
+		{"u", CmdMoveNorthEast, Direction{X: 1, Y: -1}},
 		{"b", CmdMoveSouthWest, Direction{X: -1, Y: 1}},
 		{"n", CmdMoveSouthEast, Direction{X: 1, Y: 1}},
 
@@ -45,6 +46,49 @@ func TestParser_BasicMovement(t *testing.T) {
 	}
 }
 
+func TestParser_RunMovement(t *testing.T) {
+	parser := NewParser()
+
+	tests := []struct {
+		key       gruid.Key
+		expected  Type
+		direction Direction
+	}{
+		{"H", CmdMoveWest, Direction{X: -1, Y: 0}},
+		{"J", CmdMoveSouth, Direction{X: 0, Y: 1}},
+		{"K", CmdMoveNorth, Direction{X: 0, Y: -1}},
+		{"L", CmdMoveEast, Direction{X: 1, Y: 0}},
+		{"Y", CmdMoveNorthWest, Direction{X: -1, Y: -1}},
+		{"U", CmdMoveNorthEast, Direction{X: 1, Y: -1}},
+		{"B", CmdMoveSouthWest, Direction{X: -1, Y: 1}},
+		{"N", CmdMoveSouthEast, Direction{X: 1, Y: 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.key), func(t *testing.T) {
+			cmd := parser.Parse(tt.key)
+			if cmd.Type != tt.expected || cmd.Direction != tt.direction {
+				t.Errorf("Expected %v %v, got %v %v", tt.expected, tt.direction, cmd.Type, cmd.Direction)
+			}
+			if !cmd.Run {
+				t.Error("Shifted movement keys should run")
+			}
+		})
+	}
+
+	if parser.Parse("h").Run {
+		t.Error("Lowercase movement keys should not run")
+	}
+
+	cmd := parser.ParseKeyDown(gruid.MsgKeyDown{Key: gruid.KeyArrowLeft, Mod: gruid.ModShift})
+	if cmd.Type != CmdMoveWest || !cmd.Run {
+		t.Errorf("Shift+Left should run west, got %v (run=%v)", cmd.Type, cmd.Run)
+	}
+	if cmd := parser.ParseKeyDown(gruid.MsgKeyDown{Key: gruid.KeyArrowLeft}); cmd.Run {
+		t.Error("Left without shift should not run")
+	}
+}
+
 func TestParser_ActionCommands(t *testing.T) {
 	parser := NewParser()
 
@@ -56,10 +100,13 @@ func TestParser_ActionCommands(t *testing.T) {
 		{"i", CmdInventory},
 		{"g", CmdPickUp},
 		{",", CmdPickUp},
-		{"u", CmdUse},
 		{"e", CmdEquip},
-		{"r", CmdUnequip},
-		{"d", CmdDisarm},
+		{"t", CmdUnequip},
+		{"d", CmdDrop},
+		{"D", CmdDisarm},
+		{"q", CmdQuaff},
+		{"r", CmdRead},
+		{"w", CmdWield},
 		{"o", CmdOpen},
 		{"c", CmdClose},
 		{"s", CmdSearch},
@@ -67,6 +114,9 @@ func TestParser_ActionCommands(t *testing.T) {
 		{"f", CmdFight},
 		{"x", CmdLook},
 		{gruid.KeyTab, CmdToggleFOV},
+		{"C", CmdCall},
+		{"X", CmdExplore},
+		{"_", CmdTravel},
 
 		// Movement-related
 		{" ", CmdWait},
@@ -78,7 +128,11 @@ func TestParser_ActionCommands(t *testing.T) {
 
 		// System
 		{"Q", CmdQuit},
-		{"S", CmdQuit},
+		{"S", CmdSave},
+		{"^S", CmdSave},
+		{"O", CmdLoad},
+		{"^L", CmdRedraw},
+		{"^P", CmdMessages},
 		{"?", CmdHelp},
 		{gruid.KeyEscape, CmdEscape},
 	}
@@ -102,27 +156,23 @@ func TestParser_UnknownCommand(t *testing.T) {
 	}
 }
 
-func TestParser_GetKeyBindings(t *testing.T) {
+func TestParser_SetKeymap(t *testing.T) {
 	parser := NewParser()
-	bindings := parser.GetKeyBindings()
+	parser.ParseKeyDown(gruid.MsgKeyDown{Key: "2"})
 
-	// Check that we have the expected number of bindings
-	if len(bindings) == 0 {
-		t.Error("Expected key bindings, got empty map")
+	numpad, err := Preset(PresetNumpad)
+	if err != nil {
+		t.Fatal(err)
 	}
-
-	// Check for some essential bindings
-	essential := []string{
-		"h,j,k,l",
-		"i",
-		"Q",
-		"?",
+	parser.SetKeymap(numpad)
+	if parser.PendingCount() != 0 {
+		t.Error("Changing the keymap should drop the pending count")
 	}
-
-	for _, key := range essential {
-		if _, exists := bindings[key]; !exists {
-			t.Errorf("Missing essential key binding: %s", key)
-		}
+	if cmd := parser.Parse("4"); cmd.Type != CmdMoveWest {
+		t.Errorf("Expected 4 to move west with the numpad keymap, got %v", cmd.Type)
+	}
+	if parser.Keymap() != numpad {
+		t.Error("Keymap should return the keymap that was set")
 	}
 }
 
@@ -136,6 +186,9 @@ func TestCommandType_String(t *testing.T) {
 		{CmdPickUp, "Pick Up"},
 		{CmdQuit, "Quit"},
 		{CmdHelp, "Help"},
+		{CmdSave, "Save"},
+		{CmdLoad, "Load"},
+		{CmdRedraw, "Redraw"},
 		{CmdUnknown, "Unknown"},
 	}
 
@@ -164,3 +217,78 @@ func TestParser_GetCommandForKey(t *testing.T) {
 		t.Errorf("Expected CmdUnknown, got %v", cmdType)
 	}
 }
+
+func TestParser_CountPrefix(t *testing.T) {
+	parser := NewParser()
+
+	for _, key := range []gruid.Key{"2", "0"} {
+		if cmd := parser.ParseKeyDown(gruid.MsgKeyDown{Key: key}); cmd.Type != CmdCount {
+			t.Fatalf("Expected digit %s to be a count, got %v", key, cmd.Type)
+		}
+	}
+	if parser.PendingCount() != 20 {
+		t.Errorf("Expected pending count 20, got %d", parser.PendingCount())
+	}
+
+	cmd := parser.ParseKeyDown(gruid.MsgKeyDown{Key: "s"})
+	if cmd.Type != CmdSearch || cmd.Count != 20 {
+		t.Errorf("Expected search 20 times, got %v x%d", cmd.Type, cmd.Count)
+	}
+	if parser.PendingCount() != 0 {
+		t.Error("The count should be used up by the command")
+	}
+
+	// 先頭の 0 は回数にならない
+	if cmd := parser.ParseKeyDown(gruid.MsgKeyDown{Key: "0"}); cmd.Type == CmdCount {
+		t.Error("A leading zero should not start a count")
+	}
+}
+
+func TestParser_RepeatLastCommand(t *testing.T) {
+	parser := NewParser()
+
+	if cmd := parser.ParseKeyDown(gruid.MsgKeyDown{Key: "a"}); cmd.Type != CmdRepeat {
+		t.Errorf("Expected nothing to repeat, got %v", cmd.Type)
+	}
+
+	parser.ParseKeyDown(gruid.MsgKeyDown{Key: "5"})
+	parser.ParseKeyDown(gruid.MsgKeyDown{Key: "s"})
+	parser.ParseKeyDown(gruid.MsgKeyDown{Key: "?"}) // システムコマンドは繰り返しの対象にならない
+
+	cmd := parser.ParseKeyDown(gruid.MsgKeyDown{Key: "a"})
+	if cmd.Type != CmdSearch || cmd.Count != 5 {
+		t.Errorf("Expected to repeat search x5, got %v x%d", cmd.Type, cmd.Count)
+	}
+
+	parser.ParseKeyDown(gruid.MsgKeyDown{Key: "3"})
+	cmd = parser.ParseKeyDown(gruid.MsgKeyDown{Key: "a"})
+	if cmd.Type != CmdSearch || cmd.Count != 3 {
+		t.Errorf("A new count should override the repeated one, got %v x%d", cmd.Type, cmd.Count)
+	}
+}
+
+func TestParser_CtrlKeys(t *testing.T) {
+	parser := NewParser()
+
+	tests := []struct {
+		key      gruid.Key
+		expected Type
+	}{
+		{"p", CmdMessages},
+		{"s", CmdSave},
+		{"l", CmdRedraw},
+		{"w", CmdWizard},
+	}
+
+	for _, tt := range tests {
+		cmd := parser.ParseKeyDown(gruid.MsgKeyDown{Key: tt.key, Mod: gruid.ModCtrl})
+		if cmd.Type != tt.expected {
+			t.Errorf("Ctrl+%s: expected command type %v, got %v", tt.key, tt.expected, cmd.Type)
+		}
+	}
+
+	// Ctrl なしの p は別のコマンドのまま
+	if cmd := parser.ParseKeyDown(gruid.MsgKeyDown{Key: "p"}); cmd.Type == CmdMessages {
+		t.Error("p without Ctrl should not show the message history")
+	}
+}
@@ -15,33 +15,44 @@ const (
 	CmdMoveSouthWest
 	CmdMoveSouthEast
 
-	// Action commands
-	CmdLook      // Look around (l or x)
+	// Action commands (keys shown are the default vi preset, see keymap.go)
+	CmdLook      // Look around (x or /)
 	CmdInventory // Show inventory (i)
 	CmdPickUp    // Pick up item (,)
 	CmdDrop      // Drop item (d)
-	CmdUse       // Use/Apply item (a)
+	CmdUse       // Use/Apply item (z)
 	CmdQuaff     // Quaff potion (q)
 	CmdRead      // Read scroll (r)
 	CmdWield     // Wield/wear item (w)
-	CmdTakeOff   // Take off item (t)
+	CmdTakeOff   // Take off item (T in the rogue preset)
 	CmdWait      // Wait/Rest (.)
 	CmdSearch    // Search (s)
 	CmdOpen      // Open door (o)
 	CmdClose     // Close door (c)
 	CmdFight     // Fight/Attack (f)
-	CmdDisarm    // Disarm trap (d)
+	CmdDisarm    // Disarm trap (D)
 	CmdEquip     // Equip item (e)
-	CmdUnequip   // Unequip item (r)
+	CmdUnequip   // Unequip item (t)
 	CmdToggleFOV // Toggle field of view (Tab)
+	CmdCall      // Call/name an item kind (C)
+	CmdExplore   // Auto-explore (X)
+	CmdTravel    // Travel to a location (_)
+	CmdMessages  // Show previous messages (^P)
 
 	// Stair commands
 	CmdGoUpstairs   // Go up stairs (<)
 	CmdGoDownstairs // Go down stairs (>)
 
+	// Prefix commands
+	CmdCount  // Count prefix (0-9, or n then digits in the numpad preset)
+	CmdRepeat // Repeat the last command (a)
+
 	// System commands
 	CmdQuit    // Quit game (Q)
 	CmdHelp    // Show help (?)
+	CmdSave    // Open save screen (S)
+	CmdLoad    // Open load screen (O)
+	CmdRedraw  // Redraw the screen (^L)
 	CmdEscape  // Cancel/Back (ESC)
 	CmdWizard  // Toggle wizard mode (^W)
 	CmdCLI     // Enter CLI mode (:)
@@ -53,6 +64,8 @@ type Command struct {
 	Type      Type
 	Key       string
 	Direction Direction // For movement commands
+	Run       bool      // Run until something interesting happens (shifted movement keys)
+	Count     int       // Count typed before the command (0 when none)
 }
 
 // Direction represents movement direction
@@ -60,6 +73,28 @@ type Direction struct {
 	X, Y int
 }
 
+// IsMovement reports whether the command type moves the player one step
+func (t Type) IsMovement() bool {
+	switch t {
+	case CmdMoveWest, CmdMoveEast, CmdMoveNorth, CmdMoveSouth,
+		CmdMoveNorthWest, CmdMoveNorthEast, CmdMoveSouthWest, CmdMoveSouthEast:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsRepeatable reports whether the repeat key may replay the command type
+// システムコマンドや回数指定そのものは繰り返さない
+func (t Type) IsRepeatable() bool {
+	switch t {
+	case CmdCount, CmdRepeat, CmdMessages, CmdQuit, CmdHelp, CmdSave, CmdLoad, CmdRedraw, CmdEscape, CmdWizard, CmdCLI, CmdUnknown:
+		return false
+	default:
+		return true
+	}
+}
+
 // String returns the string representation of a command type
 func (t Type) String() string {
 	switch t {
@@ -115,14 +150,32 @@ func (t Type) String() string {
 		return "Unequip"
 	case CmdToggleFOV:
 		return "Toggle FOV"
+	case CmdCall:
+		return "Call"
+	case CmdExplore:
+		return "Auto-explore"
+	case CmdTravel:
+		return "Travel"
+	case CmdMessages:
+		return "Message History"
 	case CmdGoUpstairs:
 		return "Go Upstairs"
 	case CmdGoDownstairs:
 		return "Go Downstairs"
+	case CmdCount:
+		return "Count"
+	case CmdRepeat:
+		return "Repeat"
 	case CmdQuit:
 		return "Quit"
 	case CmdHelp:
 		return "Help"
+	case CmdSave:
+		return "Save"
+	case CmdLoad:
+		return "Load"
+	case CmdRedraw:
+		return "Redraw"
 	case CmdEscape:
 		return "Cancel"
 	case CmdWizard:
@@ -1,10 +1,19 @@
 package core
 
 import (
+	"time"
+
 	"github.com/anaseto/gruid"
+	"github.com/yuru-sha/gorogue/internal/config"
+	"github.com/yuru-sha/gorogue/internal/core/command"
+	"github.com/yuru-sha/gorogue/internal/core/event"
 	"github.com/yuru-sha/gorogue/internal/core/state"
 	"github.com/yuru-sha/gorogue/internal/game/actor"
 	"github.com/yuru-sha/gorogue/internal/game/dungeon"
+	"github.com/yuru-sha/gorogue/internal/game/morgue"
+	"github.com/yuru-sha/gorogue/internal/game/save"
+	"github.com/yuru-sha/gorogue/internal/game/score"
+	"github.com/yuru-sha/gorogue/internal/i18n"
 	uiscreen "github.com/yuru-sha/gorogue/internal/ui/screen"
 	"github.com/yuru-sha/gorogue/internal/utils/logger"
 )
@@ -12,55 +21,97 @@ import (
 const (
 	screenWidth  = 80
 	screenHeight = 50
+
+	// defaultCharName is used when the name is left blank on the creation screen
+	defaultCharName = "Rogue"
 )
 
 // Engine represents the game engine and implements gruid.Model interface
 type Engine struct {
-	grid           gruid.Grid
-	stateManager   *state.StateManager
-	dungeonManager *dungeon.DungeonManager
-	player         *actor.Player
-	gameScreen     *uiscreen.GameScreen
-	menuScreen     *uiscreen.MenuScreen
-	helpScreen     *uiscreen.HelpScreen
-	msgs           []gruid.Msg
+	grid            gruid.Grid
+	stateManager    *state.StateManager
+	dungeonManager  *dungeon.DungeonManager
+	player          *actor.Player
+	gameScreen      *uiscreen.GameScreen
+	menuScreen      *uiscreen.MenuScreen
+	helpScreen      *uiscreen.HelpScreen
+	saveLoadScreen  *uiscreen.SaveLoadScreen
+	gameOverScreen  *uiscreen.GameOverScreen
+	saveIntegration *save.SaveGameIntegration
+	lifetimeStats   *save.LifetimeStatsManager
+	bestiary        *save.Bestiary
+	msgs            []gruid.Msg
 }
 
 // NewEngine creates and initializes a new game engine
+// ワールドはタイトルメニューで New Game / Continue / Load が選ばれたときに用意する
 func NewEngine() *Engine {
+	// 表示言語の設定（未対応の値なら既定の言語のまま）
+	if lang, ok := i18n.ParseLanguage(config.GetLanguage()); ok {
+		i18n.SetLanguage(lang)
+	} else {
+		logger.Warn("Unsupported language, using default", "language", config.GetLanguage(), "default", i18n.DefaultLanguage)
+	}
+
 	// グリッドの初期化
 	grid := gruid.NewGrid(screenWidth, screenHeight)
 
-	// プレイヤーの生成（仮位置、後でダンジョンマネージャーが適切な位置に配置）
-	player := actor.NewPlayer(0, 0)
-	logger.Debug("Created player",
-		"x", player.Position.X,
-		"y", player.Position.Y,
-	)
+	// セーブシステムの初期化
+	saveIntegration := save.NewSaveGameIntegration()
+	if err := saveIntegration.Initialize(); err != nil {
+		logger.Warn("Failed to initialize save system", "error", err)
+	}
+	if err := saveIntegration.SetSaveCodec(config.GetSaveFormat()); err != nil {
+		logger.Warn("Unsupported save format, using default", "format", config.GetSaveFormat(), "error", err)
+	}
 
-	// ダンジョンマネージャーの生成
-	dungeonManager := dungeon.NewDungeonManager(player)
+	// スコアファイルの初期化（初回起動時は空のファイルを作成）
+	scoreManager := score.NewScoreManager()
+	if err := scoreManager.Initialize(); err != nil {
+		logger.Warn("Failed to initialize score manager", "error", err)
+	}
 
-	// プレイヤーを最初の部屋の中央に配置
-	level := dungeonManager.GetCurrentLevel()
-	if len(level.Rooms) > 0 {
-		firstRoom := level.Rooms[0]
-		player.Position.X = firstRoom.X + firstRoom.Width/2
-		player.Position.Y = firstRoom.Y + firstRoom.Height/2
-		logger.Debug("Placed player in first room",
-			"x", player.Position.X,
-			"y", player.Position.Y,
-			"room_x", firstRoom.X,
-			"room_y", firstRoom.Y,
-		)
+	// 通算統計（ファイルはゲーム終了時に作成される）
+	lifetimeStats := save.NewLifetimeStatsManager()
+
+	// モンスター図鑑（プレイをまたいで引き継ぐ）
+	bestiary := save.NewBestiary()
+	if err := bestiary.Load(); err != nil {
+		logger.Warn("Failed to load bestiary", "error", err)
+	}
+
+	// キーマップ（ファイルに誤りがあればプリセット、それも駄目なら既定のキーで続ける）
+	keymap, err := command.LoadKeymap(config.GetKeymapFile(), config.GetKeymapPreset())
+	if err != nil {
+		logger.Error("Failed to load keymap", "error", err)
+		if keymap, err = command.Preset(config.GetKeymapPreset()); err != nil {
+			keymap = command.DefaultKeymap()
+		}
 	}
+	logger.Debug("Loaded keymap", "name", keymap.Name())
 
 	// 画面の生成
-	gameScreen := uiscreen.NewGameScreen(screenWidth, screenHeight, player)
-	gameScreen.SetLevel(level)                   // ダンジョンレベルを設定
-	gameScreen.SetDungeonManager(dungeonManager) // ダンジョンマネージャーを設定
+	gameScreen := uiscreen.NewGameScreen(screenWidth, screenHeight, nil)
 	menuScreen := uiscreen.NewMenuScreen(screenWidth, screenHeight)
 	helpScreen := uiscreen.NewHelpScreen(screenWidth, screenHeight)
+	saveLoadScreen := uiscreen.NewSaveLoadScreen(screenWidth, screenHeight, saveIntegration)
+	scoreScreen := uiscreen.NewScoreScreen(screenWidth, screenHeight, scoreManager)
+	optionsScreen := uiscreen.NewOptionsScreen(screenWidth, screenHeight, saveIntegration)
+	gameOverScreen := uiscreen.NewGameOverScreen(screenWidth, screenHeight, scoreManager)
+	statisticsScreen := uiscreen.NewStatisticsScreen(screenWidth, screenHeight, lifetimeStats)
+	achievementsScreen := uiscreen.NewAchievementsScreen(screenWidth, screenHeight, saveIntegration.GetAchievementTracker())
+	bestiaryScreen := uiscreen.NewBestiaryScreen(screenWidth, screenHeight, bestiary)
+	creationScreen := uiscreen.NewCreationScreen(screenWidth, screenHeight, defaultCharName)
+	gameScreen.SetSaveLoadScreen(saveLoadScreen)
+	gameScreen.SetBestiary(bestiary)
+	gameScreen.SetKeymap(keymap)
+	helpScreen.SetKeymap(keymap)
+
+	// ゲームイベントは SDL UI と CLI の両方から同じバスに発行される
+	events := event.NewBus()
+	gameScreen.SetEventBus(events)
+	menuScreen.SetSaveIntegration(saveIntegration)
+	menuScreen.SetSaveLoadScreen(saveLoadScreen)
 	logger.Debug("Created screens")
 
 	// ステートマネージャーの初期化
@@ -68,24 +119,205 @@ func NewEngine() *Engine {
 	stateManager.RegisterState(state.StateMenu, menuScreen)
 	stateManager.RegisterState(state.StateGame, gameScreen)
 	stateManager.RegisterState(state.StateHelp, helpScreen)
+	stateManager.RegisterState(state.StateSaveLoad, saveLoadScreen)
+	stateManager.RegisterState(state.StateScores, scoreScreen)
+	stateManager.RegisterState(state.StateOptions, optionsScreen)
+	stateManager.RegisterState(state.StateGameOver, gameOverScreen)
+	stateManager.RegisterState(state.StateVictory, gameOverScreen)
+	stateManager.RegisterState(state.StateStatistics, statisticsScreen)
+	stateManager.RegisterState(state.StateAchievements, achievementsScreen)
+	stateManager.RegisterState(state.StateBestiary, bestiaryScreen)
+	stateManager.RegisterState(state.StateCreation, creationScreen)
 
-	// ゲーム状態で開始
-	stateManager.SetState(state.StateGame)
+	// タイトルメニューで開始
+	stateManager.SetState(state.StateMenu)
 
 	engine := &Engine{
-		grid:           grid,
-		stateManager:   stateManager,
-		dungeonManager: dungeonManager,
-		player:         player,
-		gameScreen:     gameScreen,
-		menuScreen:     menuScreen,
-		helpScreen:     helpScreen,
-		msgs:           make([]gruid.Msg, 0),
+		grid:            grid,
+		stateManager:    stateManager,
+		gameScreen:      gameScreen,
+		menuScreen:      menuScreen,
+		helpScreen:      helpScreen,
+		saveLoadScreen:  saveLoadScreen,
+		gameOverScreen:  gameOverScreen,
+		saveIntegration: saveIntegration,
+		lifetimeStats:   lifetimeStats,
+		bestiary:        bestiary,
+		msgs:            make([]gruid.Msg, 0),
 	}
 
+	menuScreen.SetOnNewGame(creationScreen.Reset)
+	creationScreen.SetOnCreate(engine.startNewGame)
+	menuScreen.SetOnContinue(func() { engine.onGameLoaded(save.AutoSaveSlot) })
+	saveLoadScreen.SetOnSave(engine.onGameSaved)
+	saveLoadScreen.SetOnLoad(engine.onGameLoaded)
+	saveLoadScreen.SetOnDelete(func(int) { menuScreen.RefreshSaves() })
+	gameScreen.SetOnVictory(engine.onVictory)
+	gameScreen.SetOnCheat(saveIntegration.MarkWizardGame)
+	engine.subscribeEvents(events)
+	saveIntegration.SetOnAutoSave(engine.saveBestiary) // 図鑑は自動セーブと同じ機会に保存する
+	saveIntegration.SetOnAchievementUnlocked(func(achievement save.Achievement) {
+		events.Publish(event.MessageEvent{Text: i18n.T("game.achievement_unlocked", achievement.DisplayName())})
+	})
+	gameScreen.SetMorgueReport(func() *morgue.Report { return engine.buildMorgueReport("", false, 0) })
+
 	return engine
 }
 
+// startNewGame generates a fresh world for the created character and hands it to the game screen
+func (e *Engine) startNewGame(charName string, class actor.Class) {
+	if err := e.saveIntegration.CreateNewGame(charName, class, time.Now().UnixNano()); err != nil {
+		logger.Error("Failed to create new game", "error", err)
+		return
+	}
+
+	e.player, e.dungeonManager = e.saveIntegration.GetGameState()
+	e.gameScreen.StartNewGame(charName, e.player, e.dungeonManager)
+
+	logger.Debug("Started new game",
+		"char_name", charName,
+		"class", class,
+		"x", e.player.Position.X,
+		"y", e.player.Position.Y,
+	)
+}
+
+// onGameSaved reports a successful save on the game screen
+func (e *Engine) onGameSaved(slot int) {
+	e.saveBestiary()
+	e.menuScreen.RefreshSaves()
+	e.gameScreen.AddMessage(i18n.T("game.saved_to_slot", slot+1))
+}
+
+// onGameLoaded replaces the running world with the one restored from a save
+func (e *Engine) onGameLoaded(slot int) {
+	player, dungeonManager := e.saveIntegration.GetGameState()
+	if player == nil || dungeonManager == nil {
+		logger.Warn("Loaded game state is incomplete", "slot", slot)
+		return
+	}
+
+	e.player = player
+	e.dungeonManager = dungeonManager
+	e.gameScreen.ReplaceWorld(player, dungeonManager)
+	if slot == save.AutoSaveSlot {
+		e.gameScreen.AddMessage(i18n.T("game.welcome_back"))
+	} else {
+		e.gameScreen.AddMessage(i18n.T("game.loaded_from_slot", slot+1))
+	}
+
+	logger.Info("Replaced running game with loaded save",
+		"slot", slot,
+		"floor", dungeonManager.GetCurrentFloor(),
+	)
+}
+
+// onPlayerDeath records the finished game and prepares the tombstone
+func (e *Engine) onPlayerDeath(reason string) {
+	floor := e.dungeonManager.GetCurrentFloor()
+	e.saveIntegration.OnPlayerDeath(reason)
+
+	// 死亡したキャラクターは再開できないようにオートセーブを消す
+	if e.saveIntegration.HasAutoSave() {
+		if err := e.saveIntegration.GetAutoSaveManager().DeleteAutoSave(); err != nil {
+			logger.Warn("Failed to delete auto-save after death", "error", err)
+		}
+	}
+
+	stats := e.saveIntegration.GetGameStats().GetStats()
+	if stats.DeepestFloor < floor {
+		stats.DeepestFloor = floor
+	}
+
+	gameInfo := e.saveIntegration.GetGameInfo()
+	gameInfo.PlayTime = e.saveIntegration.GetGameStats().GetPlayTime()
+
+	e.recordLifetimeStats(stats, gameInfo, false, reason)
+	e.saveBestiary()
+	result := e.gameOverScreen.RecordDeath(e.player, stats, gameInfo, reason, floor)
+	result.MorgueFile = e.writeMorgue(reason, false, result.Breakdown.TotalScore)
+	logger.Info("Game over",
+		"killed_by", reason,
+		"floor", floor,
+		"score", result.Breakdown.TotalScore,
+		"rank", result.Rank,
+	)
+}
+
+// onVictory records the won game and prepares the victory screen
+func (e *Engine) onVictory() {
+	e.saveIntegration.OnPlayerVictory()
+
+	stats := e.saveIntegration.GetGameStats().GetStats()
+	gameInfo := e.saveIntegration.GetGameInfo()
+	gameInfo.PlayTime = e.saveIntegration.GetGameStats().GetPlayTime()
+
+	e.recordLifetimeStats(stats, gameInfo, true, "")
+	e.saveBestiary()
+	result := e.gameOverScreen.RecordVictory(e.player, stats, gameInfo)
+	result.MorgueFile = e.writeMorgue("", true, result.Breakdown.TotalScore)
+	logger.Info("Victory",
+		"score", result.Breakdown.TotalScore,
+		"rank", result.Rank,
+	)
+}
+
+// saveBestiary writes what was learned about monsters
+func (e *Engine) saveBestiary() {
+	if err := e.bestiary.Save(); err != nil {
+		logger.Warn("Failed to save bestiary", "error", err)
+	}
+}
+
+// recordLifetimeStats adds the finished game to the lifetime statistics
+func (e *Engine) recordLifetimeStats(stats save.Stats, gameInfo save.GameInfo, isVictory bool, killedBy string) {
+	if stats.HighestLevel < e.player.Level {
+		stats.HighestLevel = e.player.Level
+	}
+	if _, err := e.lifetimeStats.RecordGame(stats, gameInfo, isVictory, killedBy); err != nil {
+		logger.Error("Failed to record lifetime stats", "error", err)
+	}
+}
+
+// buildMorgueReport collects the current game state for a character dump
+func (e *Engine) buildMorgueReport(killedBy string, isVictory bool, finalScore int) *morgue.Report {
+	gameInfo := e.saveIntegration.GetGameInfo()
+	gameInfo.PlayTime = e.saveIntegration.GetGameStats().GetPlayTime()
+	gameInfo.TurnCount = e.saveIntegration.GetGameStats().GetTurnCount()
+
+	report := &morgue.Report{
+		CharName:  gameInfo.CharName,
+		Player:    e.player,
+		Stats:     e.saveIntegration.GetGameStats(),
+		GameInfo:  gameInfo,
+		Messages:  e.gameScreen.GetMessageHistory(),
+		KilledBy:  killedBy,
+		IsVictory: isVictory,
+		Score:     finalScore,
+		Time:      time.Now(),
+	}
+	if e.dungeonManager != nil {
+		report.Level = e.dungeonManager.GetCurrentLevel()
+		report.Floor = e.dungeonManager.GetCurrentFloor()
+	}
+	return report
+}
+
+// writeMorgue writes the morgue file for a finished game and returns its path
+func (e *Engine) writeMorgue(killedBy string, isVictory bool, finalScore int) string {
+	path, err := morgue.Write(morgue.GetMorgueDir(), e.buildMorgueReport(killedBy, isVictory, finalScore))
+	if err != nil {
+		logger.Error("Failed to write morgue file", "error", err)
+		return ""
+	}
+	return path
+}
+
+// GetSaveIntegration returns the save system bound to the running game
+func (e *Engine) GetSaveIntegration() *save.SaveGameIntegration {
+	return e.saveIntegration
+}
+
 // Update implements gruid.Model.Update
 func (e *Engine) Update(msg gruid.Msg) gruid.Effect {
 	e.msgs = append(e.msgs, msg)
@@ -96,7 +328,21 @@ func (e *Engine) Update(msg gruid.Msg) gruid.Effect {
 		return nil
 	case gruid.MsgKeyDown:
 		// キー入力の処理
-		return e.stateManager.HandleInput(msg)
+		previous := e.stateManager.GetCurrentState()
+		if effect := e.stateManager.HandleInput(msg); effect != nil {
+			return effect
+		}
+		if current := e.stateManager.GetCurrentState(); current == state.StateMenu && previous != state.StateMenu {
+			// ゲーム中のオートセーブや死亡でセーブの有無が変わっている
+			e.menuScreen.RefreshSaves()
+		}
+		if e.gameScreen.TakeRedrawRequest() {
+			// MsgScreen を受け取ると gruid は変化のないセルも含めて描き直す
+			return gruid.Cmd(func() gruid.Msg {
+				return gruid.MsgScreen{Width: e.grid.Size().X, Height: e.grid.Size().Y}
+			})
+		}
+		return nil
 	case gruid.MsgQuit:
 		// 終了処理
 		return gruid.End()
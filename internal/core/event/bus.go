@@ -0,0 +1,41 @@
+package event
+
+// Handler receives published events
+type Handler func(ev Event)
+
+// Bus dispatches game events to subscribers
+// ゲームループは単一スレッドなので、ハンドラは Publish の中で登録順に同期実行する
+type Bus struct {
+	handlers    map[Kind][]Handler
+	allHandlers []Handler
+}
+
+// NewBus creates an empty event bus
+func NewBus() *Bus {
+	return &Bus{
+		handlers: make(map[Kind][]Handler),
+	}
+}
+
+// Subscribe registers a handler for one kind of event
+func (b *Bus) Subscribe(kind Kind, handler Handler) {
+	b.handlers[kind] = append(b.handlers[kind], handler)
+}
+
+// SubscribeAll registers a handler for every event
+func (b *Bus) SubscribeAll(handler Handler) {
+	b.allHandlers = append(b.allHandlers, handler)
+}
+
+// Publish delivers an event to its subscribers (a nil bus drops the event)
+func (b *Bus) Publish(ev Event) {
+	if b == nil || ev == nil {
+		return
+	}
+	for _, handler := range b.handlers[ev.Kind()] {
+		handler(ev)
+	}
+	for _, handler := range b.allHandlers {
+		handler(ev)
+	}
+}
@@ -0,0 +1,34 @@
+package event
+
+import (
+	"testing"
+)
+
+func TestBusPublish(t *testing.T) {
+	bus := NewBus()
+
+	var kills, all []Kind
+	bus.Subscribe(KindMonsterKilled, func(ev Event) {
+		kills = append(kills, ev.Kind())
+	})
+	bus.SubscribeAll(func(ev Event) {
+		all = append(all, ev.Kind())
+	})
+
+	bus.Publish(AttackEvent{Damage: 3})
+	bus.Publish(MonsterKilledEvent{Exp: 10, Gold: 5})
+
+	if len(kills) != 1 || kills[0] != KindMonsterKilled {
+		t.Errorf("Expected one monster_killed event, got %v", kills)
+	}
+	if len(all) != 2 || all[0] != KindAttack || all[1] != KindMonsterKilled {
+		t.Errorf("Expected attack and monster_killed in order, got %v", all)
+	}
+}
+
+func TestNilBusDropsEvents(t *testing.T) {
+	var bus *Bus
+
+	// nil のバスへの発行はパニックしない
+	bus.Publish(TurnEndedEvent{})
+}
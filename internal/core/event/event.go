@@ -0,0 +1,174 @@
+// Package event ゲームイベントの型定義とイベントバス
+// SDL UI と CLI の両方が同じイベントを発行し、統計・オートセーブ・メッセージログ・実績・ログ出力が購読する
+package event
+
+import (
+	"github.com/yuru-sha/gorogue/internal/core/entity"
+	"github.com/yuru-sha/gorogue/internal/game/actor"
+	"github.com/yuru-sha/gorogue/internal/game/item"
+	"github.com/yuru-sha/gorogue/internal/utils/logger"
+)
+
+// Kind identifies the type of a game event
+type Kind int
+
+const (
+	KindAttack Kind = iota
+	KindMonsterKilled
+	KindPlayerDamaged
+	KindItemPickedUp
+	KindItemUsed
+	KindItemDropped
+	KindItemEquipped
+	KindPlayerMoved
+	KindFloorChanged
+	KindStatusChanged
+	KindPlayerDied
+	KindTurnEnded
+	KindMessage
+)
+
+// String returns the event kind name used in logs
+func (k Kind) String() string {
+	switch k {
+	case KindAttack:
+		return "attack"
+	case KindMonsterKilled:
+		return "monster_killed"
+	case KindPlayerDamaged:
+		return "player_damaged"
+	case KindItemPickedUp:
+		return "item_picked_up"
+	case KindItemUsed:
+		return "item_used"
+	case KindItemDropped:
+		return "item_dropped"
+	case KindItemEquipped:
+		return "item_equipped"
+	case KindPlayerMoved:
+		return "player_moved"
+	case KindFloorChanged:
+		return "floor_changed"
+	case KindStatusChanged:
+		return "status_changed"
+	case KindPlayerDied:
+		return "player_died"
+	case KindTurnEnded:
+		return "turn_ended"
+	case KindMessage:
+		return "message"
+	default:
+		return "unknown"
+	}
+}
+
+// Event is a game event published on the bus
+type Event interface {
+	Kind() Kind
+}
+
+// AttackEvent is published when the player hits a monster
+type AttackEvent struct {
+	Monster *actor.Monster
+	Damage  int
+}
+
+// MonsterKilledEvent is published when the player kills a monster
+type MonsterKilledEvent struct {
+	Monster *actor.Monster
+	Exp     int
+	Gold    int
+}
+
+// PlayerDamagedEvent is published when the player loses HP
+type PlayerDamagedEvent struct {
+	Source  string
+	Damage  int
+	Monster *actor.Monster      // 攻撃したモンスター（モンスター以外が原因なら nil）
+	Effect  actor.SpecialEffect // 攻撃で起きた特殊効果
+}
+
+// ItemPickedUpEvent is published when the player picks up an item (gold included)
+type ItemPickedUpEvent struct {
+	Item *item.Item
+}
+
+// ItemUsedEvent is published when the player uses an item
+type ItemUsedEvent struct {
+	Item    *item.Item
+	Message string // 効果のメッセージ
+}
+
+// ItemDroppedEvent is published when the player drops an item
+type ItemDroppedEvent struct {
+	Item *item.Item
+}
+
+// ItemEquippedEvent is published when the player equips an item from the pack
+type ItemEquippedEvent struct {
+	Item *item.Item
+}
+
+// PlayerMovedEvent is published when the player moves one step
+type PlayerMovedEvent struct {
+	From entity.Position
+	To   entity.Position
+}
+
+// FloorChangedEvent is published when the player takes the stairs
+type FloorChangedEvent struct {
+	Floor int
+	Down  bool
+}
+
+// Status identifies a change of the player's status
+type Status int
+
+const (
+	StatusLevelUp Status = iota
+)
+
+// StatusChangedEvent is published when the player's status changes
+type StatusChangedEvent struct {
+	Status Status
+	Level  int // StatusLevelUp の新しいレベル
+}
+
+// PlayerDiedEvent is published when the player dies
+type PlayerDiedEvent struct {
+	KilledBy string
+	Floor    int
+}
+
+// TurnEndedEvent is published after the monsters have acted
+type TurnEndedEvent struct{}
+
+// MessageEvent is a plain message for the message log
+type MessageEvent struct {
+	Text string
+}
+
+// Kind implementations
+func (AttackEvent) Kind() Kind        { return KindAttack }
+func (MonsterKilledEvent) Kind() Kind { return KindMonsterKilled }
+func (PlayerDamagedEvent) Kind() Kind { return KindPlayerDamaged }
+func (ItemPickedUpEvent) Kind() Kind  { return KindItemPickedUp }
+func (ItemUsedEvent) Kind() Kind      { return KindItemUsed }
+func (ItemDroppedEvent) Kind() Kind   { return KindItemDropped }
+func (ItemEquippedEvent) Kind() Kind  { return KindItemEquipped }
+func (PlayerMovedEvent) Kind() Kind   { return KindPlayerMoved }
+func (FloorChangedEvent) Kind() Kind  { return KindFloorChanged }
+func (StatusChangedEvent) Kind() Kind { return KindStatusChanged }
+func (PlayerDiedEvent) Kind() Kind    { return KindPlayerDied }
+func (TurnEndedEvent) Kind() Kind     { return KindTurnEnded }
+func (MessageEvent) Kind() Kind       { return KindMessage }
+
+// LogEvent writes an event to the debug log (subscribe with SubscribeAll)
+func LogEvent(ev Event) {
+	// 移動とターン終了は毎ターン発生するので出力しない
+	switch ev.Kind() {
+	case KindPlayerMoved, KindTurnEnded:
+		return
+	}
+	logger.Debug("Game event", "kind", ev.Kind().String(), "event", ev)
+}
@@ -0,0 +1,68 @@
+package core
+
+import (
+	"github.com/yuru-sha/gorogue/internal/core/event"
+	"github.com/yuru-sha/gorogue/internal/game/item"
+)
+
+// subscribeEvents connects statistics, autosave, achievements, the bestiary and logging to the event bus
+// メッセージログはゲーム画面が SetEventBus で購読する
+func (e *Engine) subscribeEvents(bus *event.Bus) {
+	bus.Subscribe(event.KindPlayerDied, func(ev event.Event) {
+		e.onPlayerDeath(ev.(event.PlayerDiedEvent).KilledBy)
+	})
+	bus.SubscribeAll(e.recordEvent)
+	bus.SubscribeAll(event.LogEvent)
+}
+
+// recordEvent forwards a game event to the game statistics
+// オートセーブと実績の判定は SaveGameIntegration が統計の更新に合わせて行う
+func (e *Engine) recordEvent(ev event.Event) {
+	sgi := e.saveIntegration
+
+	switch ev := ev.(type) {
+	case event.AttackEvent:
+		sgi.OnDamageDealt(ev.Damage)
+		e.bestiary.OnMonsterAttacked(ev.Monster)
+	case event.MonsterKilledEvent:
+		sgi.OnMonsterKilled(ev.Monster)
+		sgi.OnGoldCollected(ev.Gold)
+		e.bestiary.OnMonsterKilled(ev.Monster)
+	case event.PlayerDamagedEvent:
+		sgi.OnDamageTaken(ev.Damage)
+		if ev.Monster != nil {
+			e.bestiary.OnPlayerHit(ev.Monster, ev.Damage, ev.Effect)
+		}
+	case event.ItemPickedUpEvent:
+		switch ev.Item.Type {
+		case item.ItemGold:
+			sgi.OnGoldCollected(ev.Item.Value)
+		case item.ItemAmulet:
+			sgi.OnItemFound(ev.Item.Name)
+			sgi.OnAmuletFound()
+		default:
+			sgi.OnItemFound(ev.Item.Name)
+		}
+	case event.ItemUsedEvent:
+		switch ev.Item.Type {
+		case item.ItemPotion:
+			sgi.OnPotionQuaffed(ev.Item.Name)
+		case item.ItemScroll:
+			sgi.OnScrollRead(ev.Item.Name)
+		default:
+			sgi.OnItemUsed(ev.Item.Name)
+		}
+	case event.ItemEquippedEvent:
+		if ev.Item.Type == item.ItemArmor {
+			sgi.OnArmorWorn()
+		}
+	case event.FloorChangedEvent:
+		sgi.OnFloorChange(ev.Floor)
+	case event.StatusChangedEvent:
+		if ev.Status == event.StatusLevelUp {
+			sgi.OnLevelUp(ev.Level)
+		}
+	case event.TurnEndedEvent:
+		sgi.OnTurnEnd()
+	}
+}
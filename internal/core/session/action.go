@@ -0,0 +1,135 @@
+package session
+
+// ActionType identifies a player action
+type ActionType int
+
+const (
+	ActionMove    ActionType = iota // DX, DY の方向へ移動（モンスターがいれば攻撃）
+	ActionWait                      // その場で休む
+	ActionSearch                    // 周囲を調べる
+	ActionPickUp                    // 足元のアイテムを拾う
+	ActionDrop                      // Index のアイテムを置く
+	ActionQuaff                     // Index の薬を飲む
+	ActionRead                      // Index の巻物を読む
+	ActionEat                       // Index の食料を食べる
+	ActionEquip                     // Index のアイテムを装備する
+	ActionUnequip                   // Slot の装備を外す
+	ActionAscend                    // 上り階段を使う（魔除けを持っていれば脱出）
+	ActionDescend                   // 下り階段を使う
+	ActionOpen                      // DX, DY の方向の扉を開ける
+)
+
+// String returns the action name
+func (t ActionType) String() string {
+	switch t {
+	case ActionMove:
+		return "move"
+	case ActionWait:
+		return "wait"
+	case ActionSearch:
+		return "search"
+	case ActionPickUp:
+		return "pickup"
+	case ActionDrop:
+		return "drop"
+	case ActionQuaff:
+		return "quaff"
+	case ActionRead:
+		return "read"
+	case ActionEat:
+		return "eat"
+	case ActionEquip:
+		return "equip"
+	case ActionUnequip:
+		return "unequip"
+	case ActionAscend:
+		return "ascend"
+	case ActionDescend:
+		return "descend"
+	case ActionOpen:
+		return "open"
+	default:
+		return "unknown"
+	}
+}
+
+// Equipment slots accepted by ActionUnequip
+const (
+	SlotWeapon    = "weapon"
+	SlotArmor     = "armor"
+	SlotRingLeft  = "ring_left"
+	SlotRingRight = "ring_right"
+)
+
+// Action is an abstract player action
+type Action struct {
+	Type   ActionType
+	DX, DY int    // ActionMove, ActionOpen
+	Index  int    // インベントリの位置（0 = a）
+	Slot   string // ActionUnequip
+}
+
+// Move returns a move (or melee attack) action
+func Move(dx, dy int) Action {
+	return Action{Type: ActionMove, DX: dx, DY: dy}
+}
+
+// Wait returns a rest action
+func Wait() Action {
+	return Action{Type: ActionWait}
+}
+
+// Search returns a search action
+func Search() Action {
+	return Action{Type: ActionSearch}
+}
+
+// PickUp returns a pick up action
+func PickUp() Action {
+	return Action{Type: ActionPickUp}
+}
+
+// Drop returns an action dropping the inventory item at index
+func Drop(index int) Action {
+	return Action{Type: ActionDrop, Index: index}
+}
+
+// Quaff returns an action drinking the potion at index
+func Quaff(index int) Action {
+	return Action{Type: ActionQuaff, Index: index}
+}
+
+// Read returns an action reading the scroll at index
+func Read(index int) Action {
+	return Action{Type: ActionRead, Index: index}
+}
+
+// Eat returns an action eating the food at index
+func Eat(index int) Action {
+	return Action{Type: ActionEat, Index: index}
+}
+
+// Equip returns an action equipping the inventory item at index
+func Equip(index int) Action {
+	return Action{Type: ActionEquip, Index: index}
+}
+
+// Unequip returns an action taking off the item in slot
+func Unequip(slot string) Action {
+	return Action{Type: ActionUnequip, Slot: slot}
+}
+
+// Ascend returns an action climbing the up stairs
+func Ascend() Action {
+	return Action{Type: ActionAscend}
+}
+
+// Descend returns an action taking the down stairs
+func Descend() Action {
+	return Action{Type: ActionDescend}
+}
+
+// Open returns an action opening the door in direction dx, dy
+func Open(dx, dy int) Action {
+	return Action{Type: ActionOpen, DX: dx, DY: dy}
+}
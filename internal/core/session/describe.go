@@ -0,0 +1,149 @@
+package session
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/yuru-sha/gorogue/internal/game/actor"
+	"github.com/yuru-sha/gorogue/internal/game/dungeon"
+	"github.com/yuru-sha/gorogue/internal/i18n"
+)
+
+// Description is what the player can tell about a map position
+// 画面の調べるモードと CLI の look コマンドが同じ説明を使う
+type Description struct {
+	X, Y    int
+	Terrain string
+	Player  bool                // プレイヤー自身がいる
+	Monster *MonsterDescription // いなければ nil
+	Items   []string
+}
+
+// MonsterDescription describes a monster at a position
+type MonsterDescription struct {
+	Symbol rune
+	Name   string
+	Health string // unhurt, lightly wounded, wounded, badly wounded, almost dead
+	State  string // sleeping, wandering, hunting, fleeing
+}
+
+// Describe describes a map position; ok is false outside the level
+func (s *Session) Describe(x, y int) (desc Description, ok bool) {
+	if !s.level.IsInBounds(x, y) {
+		return Description{}, false
+	}
+
+	desc = Description{X: x, Y: y, Terrain: i18n.T("terrain.unknown"), Items: make([]string, 0)}
+	if tile := s.level.GetTile(x, y); tile != nil {
+		desc.Terrain = tile.Type.String()
+	}
+	desc.Player = s.player.Position.X == x && s.player.Position.Y == y
+
+	if monster := s.level.GetMonsterAt(x, y); monster != nil && monster.IsAlive() {
+		desc.Monster = &MonsterDescription{
+			Symbol: monster.Type.Symbol,
+			Name:   monster.Type.DisplayName(),
+			Health: monsterHealth(monster),
+			State:  monsterState(monster),
+		}
+	}
+
+	for _, itm := range s.level.Items {
+		if itm.Position.X == x && itm.Position.Y == y {
+			desc.Items = append(desc.Items, s.player.IdentifyMgr.GetDisplayName(itm))
+		}
+	}
+	return desc, true
+}
+
+// Lines returns the description as text lines
+func (d Description) Lines() []string {
+	lines := []string{fmt.Sprintf("(%d, %d) %s", d.X, d.Y, d.Terrain)}
+	if d.Player {
+		lines = append(lines, i18n.T("look.you_are_here"))
+	}
+	if d.Monster != nil {
+		lines = append(lines, i18n.T("look.monster", d.Monster.Name, d.Monster.Health, d.Monster.State))
+	}
+	for _, name := range d.Items {
+		lines = append(lines, i18n.T("look.item", name))
+	}
+	return lines
+}
+
+// LookTargets returns the positions of monsters and items, nearest first
+// 調べるモードでカーソルを順に飛ばす先
+func (s *Session) LookTargets() []dungeon.Position {
+	seen := make(map[dungeon.Position]bool)
+	targets := make([]dungeon.Position, 0)
+	add := func(x, y int) {
+		pos := dungeon.Position{X: x, Y: y}
+		if !seen[pos] {
+			seen[pos] = true
+			targets = append(targets, pos)
+		}
+	}
+
+	for _, monster := range s.level.Monsters {
+		if monster.IsAlive() {
+			add(monster.Position.X, monster.Position.Y)
+		}
+	}
+	for _, itm := range s.level.Items {
+		add(itm.Position.X, itm.Position.Y)
+	}
+
+	px, py := s.player.Position.X, s.player.Position.Y
+	sort.SliceStable(targets, func(i, j int) bool {
+		return distance(targets[i], px, py) < distance(targets[j], px, py)
+	})
+	return targets
+}
+
+// distance returns the number of king moves between a position and (x, y)
+func distance(pos dungeon.Position, x, y int) int {
+	return max(abs(pos.X-x), abs(pos.Y-y))
+}
+
+// abs returns the absolute value of n
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+// monsterHealth describes how wounded a monster looks
+func monsterHealth(monster *actor.Monster) string {
+	if monster.MaxHP <= 0 || monster.HP >= monster.MaxHP {
+		return i18n.T("look.unhurt")
+	}
+	switch percent := monster.HP * 100 / monster.MaxHP; {
+	case percent >= 75:
+		return i18n.T("look.lightly_wounded")
+	case percent >= 40:
+		return i18n.T("look.wounded")
+	case percent >= 15:
+		return i18n.T("look.badly_wounded")
+	default:
+		return i18n.T("look.almost_dead")
+	}
+}
+
+// monsterState describes what a monster is doing
+// 待機中のモンスターはまだプレイヤーに気付いていないので眠っているものとして扱う
+func monsterState(monster *actor.Monster) string {
+	if !monster.IsActive {
+		return i18n.T("look.sleeping")
+	}
+	switch monster.AIState {
+	case actor.StateChase, actor.StateAttack, actor.StateSearch:
+		return i18n.T("look.hunting")
+	case actor.StateFlee:
+		return i18n.T("look.fleeing")
+	case actor.StatePatrol:
+		return i18n.T("look.wandering")
+	default:
+		return i18n.T("look.sleeping")
+	}
+}
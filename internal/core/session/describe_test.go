@@ -0,0 +1,91 @@
+package session
+
+import (
+	"testing"
+
+	"github.com/yuru-sha/gorogue/internal/game/actor"
+	"github.com/yuru-sha/gorogue/internal/game/dungeon"
+	"github.com/yuru-sha/gorogue/internal/game/item"
+)
+
+func TestDescribeMonsterAndItems(t *testing.T) {
+	player := actor.NewPlayer(2, 2)
+	level := newTestLevel(20, 10)
+	monster := actor.NewMonster(6, 4, 'K')
+	monster.HP = monster.MaxHP / 2
+	monster.AIState = actor.StateFlee
+	level.Monsters = append(level.Monsters, monster)
+	level.AddItem(item.NewItem(0, 0, item.ItemFood, "Food", 0), 6, 4)
+	s := NewWithLevel(player, level)
+
+	desc, ok := s.Describe(6, 4)
+	if !ok {
+		t.Fatal("Expected a description inside the level")
+	}
+	if desc.Terrain != "floor" {
+		t.Errorf("Expected floor terrain, got %q", desc.Terrain)
+	}
+	if desc.Monster == nil {
+		t.Fatal("Expected the monster to be described")
+	}
+	if desc.Monster.Health != "wounded" || desc.Monster.State != "fleeing" {
+		t.Errorf("Expected a wounded fleeing monster, got %s, %s", desc.Monster.Health, desc.Monster.State)
+	}
+	if len(desc.Items) != 1 {
+		t.Errorf("Expected one item, got %v", desc.Items)
+	}
+	if len(desc.Lines()) != 3 {
+		t.Errorf("Expected terrain, monster and item lines, got %v", desc.Lines())
+	}
+}
+
+func TestDescribeMonsterStates(t *testing.T) {
+	monster := actor.NewMonster(1, 1, 'K')
+	tests := []struct {
+		state    actor.AIState
+		expected string
+	}{
+		{actor.StateIdle, "sleeping"},
+		{actor.StatePatrol, "wandering"},
+		{actor.StateChase, "hunting"},
+		{actor.StateSearch, "hunting"},
+		{actor.StateFlee, "fleeing"},
+	}
+	for _, tt := range tests {
+		monster.AIState = tt.state
+		if got := monsterState(monster); got != tt.expected {
+			t.Errorf("State %d: expected %s, got %s", tt.state, tt.expected, got)
+		}
+	}
+}
+
+func TestDescribeOutOfBounds(t *testing.T) {
+	s := NewWithLevel(actor.NewPlayer(2, 2), newTestLevel(20, 10))
+	if _, ok := s.Describe(20, 3); ok {
+		t.Error("Positions outside the level should not be described")
+	}
+
+	desc, _ := s.Describe(2, 2)
+	if !desc.Player {
+		t.Error("Expected the player's position to be marked")
+	}
+}
+
+func TestLookTargetsNearestFirst(t *testing.T) {
+	player := actor.NewPlayer(2, 2)
+	level := newTestLevel(20, 10)
+	level.Monsters = append(level.Monsters, actor.NewMonster(15, 5, 'K'))
+	level.AddItem(item.NewItem(0, 0, item.ItemFood, "Food", 0), 4, 3)
+	s := NewWithLevel(player, level)
+
+	targets := s.LookTargets()
+	expected := []dungeon.Position{{X: 4, Y: 3}, {X: 15, Y: 5}}
+	if len(targets) != len(expected) {
+		t.Fatalf("Expected %d targets, got %v", len(expected), targets)
+	}
+	for i := range expected {
+		if targets[i] != expected[i] {
+			t.Errorf("Target %d: expected %v, got %v", i, expected[i], targets[i])
+		}
+	}
+}
@@ -0,0 +1,238 @@
+package session
+
+import (
+	"github.com/yuru-sha/gorogue/internal/core/event"
+	"github.com/yuru-sha/gorogue/internal/game/actor"
+	"github.com/yuru-sha/gorogue/internal/game/dungeon"
+	"github.com/yuru-sha/gorogue/internal/i18n"
+	"github.com/yuru-sha/gorogue/internal/utils/logger"
+)
+
+// maxRunSteps limits a single auto-explore, travel, run or repeated action
+const maxRunSteps = 500
+
+// StopReason tells why auto-explore, travel, running or a repeated action stopped
+type StopReason int
+
+const (
+	StopExplored    StopReason = iota // 到達できる場所はすべて探索済み
+	StopMonster                       // モンスターが視界に入った
+	StopDamaged                       // HPが減った
+	StopItem                          // アイテムの場所に着いた
+	StopBlocked                       // 移動できなかった
+	StopLimit                         // 歩数の上限に達した
+	StopDead                          // 死亡した
+	StopArrived                       // 目的地に着いた
+	StopNoPath                        // 目的地への道を知らない
+	StopJunction                      // 通路の分かれ道や行き止まりに着いた
+	StopDoor                          // 扉や部屋の入口に着いた
+	StopStairs                        // 階段に着いた
+	StopFinished                      // 指定回数の繰り返しを終えた
+	StopInterrupted                   // 繰り返し中に気になる出来事があった
+)
+
+// String returns the stop message
+func (r StopReason) String() string {
+	switch r {
+	case StopExplored:
+		return i18n.T("stop.explored")
+	case StopMonster:
+		return i18n.T("stop.monster")
+	case StopDamaged:
+		return i18n.T("stop.damaged")
+	case StopItem:
+		return i18n.T("stop.item")
+	case StopBlocked:
+		return i18n.T("stop.blocked")
+	case StopLimit:
+		return i18n.T("stop.limit")
+	case StopDead:
+		return i18n.T("stop.dead")
+	case StopArrived:
+		return i18n.T("stop.arrived")
+	case StopNoPath:
+		return i18n.T("stop.no_path")
+	case StopJunction:
+		return i18n.T("stop.junction")
+	case StopDoor:
+		return i18n.T("stop.door")
+	case StopStairs:
+		return i18n.T("stop.stairs")
+	case StopFinished:
+		return i18n.T("stop.finished")
+	case StopInterrupted:
+		return i18n.T("stop.interrupted")
+	default:
+		return i18n.T("stop.other")
+	}
+}
+
+// RunResult is the outcome of an auto-explore, travel, run or repeated action
+type RunResult struct {
+	Steps    int
+	Reason   StopReason
+	Events   []event.Event
+	Snapshot Snapshot
+}
+
+// AutoExplore walks towards the nearest unexplored place until something interesting happens
+// 探索済みマップ上で最も近い未探索の境界かアイテムへ向かい、途中のアイテムを拾い、閉じた扉を開ける
+func (s *Session) AutoExplore() RunResult {
+	return s.run(nil, true, func() (dungeon.Position, StopReason) {
+		path := s.explorePath()
+		if len(path) == 0 {
+			return dungeon.Position{}, StopExplored
+		}
+		return path[0], StopLimit
+	})
+}
+
+// run takes the steps chosen by next until something interesting happens
+// next は次の一歩と、進めない場合の停止理由（StopLimit 以外）を返す
+// known に含まれるモンスターは既に見えているものとして停止しない
+func (s *Session) run(known map[*actor.Monster]bool, stopAtItems bool, next func() (dungeon.Position, StopReason)) RunResult {
+	events := make([]event.Event, 0)
+	result := RunResult{Reason: StopLimit}
+
+	for result.Steps < maxRunSteps {
+		if s.newMonsterInView(known) {
+			result.Reason = StopMonster
+			break
+		}
+
+		step, reason := next()
+		if reason != StopLimit {
+			result.Reason = reason
+			break
+		}
+
+		hp := s.player.HP
+		dx, dy := step.X-s.player.Position.X, step.Y-s.player.Position.Y
+		action := Move(dx, dy)
+		if tile := s.level.GetTile(step.X, step.Y); tile != nil && tile.Type == dungeon.TileDoor {
+			action = Open(dx, dy)
+		}
+
+		done := s.Do(action)
+		events = append(events, done.Events...)
+		if !done.Done {
+			result.Reason = StopBlocked
+			break
+		}
+		result.Steps++
+
+		if !s.player.IsAlive() {
+			result.Reason = StopDead
+			break
+		}
+		if s.player.HP < hp {
+			result.Reason = StopDamaged
+			break
+		}
+		if stopAtItems && (s.level.GetItemAt(s.player.Position.X, s.player.Position.Y) != nil || hasPickUp(done.Events)) {
+			result.Reason = StopItem
+			break
+		}
+	}
+
+	logger.Debug("Run stopped", "steps", result.Steps, "reason", result.Reason.String())
+	result.Events = events
+	result.Snapshot = s.Snapshot()
+	return result
+}
+
+// MonsterInView reports whether a living monster can see the player
+func (s *Session) MonsterInView() bool {
+	return s.newMonsterInView(nil)
+}
+
+// visibleMonsters returns the monsters that can currently see the player
+func (s *Session) visibleMonsters() map[*actor.Monster]bool {
+	visible := make(map[*actor.Monster]bool)
+	for _, monster := range s.level.Monsters {
+		if monster.IsAlive() && monster.CanSeePlayer(s.player, s.level) {
+			visible[monster] = true
+		}
+	}
+	return visible
+}
+
+// newMonsterInView reports whether a monster not in known can see the player
+func (s *Session) newMonsterInView(known map[*actor.Monster]bool) bool {
+	for monster := range s.visibleMonsters() {
+		if !known[monster] {
+			return true
+		}
+	}
+	return false
+}
+
+// hasPickUp reports whether the events contain a picked up item
+func hasPickUp(events []event.Event) bool {
+	for _, ev := range events {
+		if ev.Kind() == event.KindItemPickedUp {
+			return true
+		}
+	}
+	return false
+}
+
+// explorePath finds the shortest path over explored tiles to the nearest target
+// 目標は未探索のタイルに隣接する探索済みの床か、探索済みの場所にあるアイテム
+func (s *Session) explorePath() []dungeon.Position {
+	start := dungeon.Position{X: s.player.Position.X, Y: s.player.Position.Y}
+	previous := map[dungeon.Position]dungeon.Position{start: start}
+	queue := []dungeon.Position{start}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		if current != start && s.isExploreTarget(current) {
+			// 経路を逆にたどって最初の一歩からの順に並べる
+			path := make([]dungeon.Position, 0)
+			for pos := current; pos != start; pos = previous[pos] {
+				path = append([]dungeon.Position{pos}, path...)
+			}
+			return path
+		}
+
+		for dy := -1; dy <= 1; dy++ {
+			for dx := -1; dx <= 1; dx++ {
+				next := dungeon.Position{X: current.X + dx, Y: current.Y + dy}
+				if _, seen := previous[next]; seen || !s.isExplorePassable(next) {
+					continue
+				}
+				previous[next] = current
+				queue = append(queue, next)
+			}
+		}
+	}
+	return nil
+}
+
+// isExplorePassable reports whether auto-explore may walk through a position
+func (s *Session) isExplorePassable(pos dungeon.Position) bool {
+	tile := s.level.GetTile(pos.X, pos.Y)
+	if tile == nil || !tile.Explored {
+		return false
+	}
+	return tile.Walkable() || tile.Type == dungeon.TileDoor
+}
+
+// isExploreTarget reports whether a position is worth walking to
+func (s *Session) isExploreTarget(pos dungeon.Position) bool {
+	// 持ちきれないアイテムは目標にしない（同じアイテムへ何度も戻らないように）
+	if s.level.GetItemAt(pos.X, pos.Y) != nil && !s.player.Inventory.IsFull() {
+		return true
+	}
+	for dy := -1; dy <= 1; dy++ {
+		for dx := -1; dx <= 1; dx++ {
+			x, y := pos.X+dx, pos.Y+dy
+			if s.level.IsInBounds(x, y) && s.level.GetTile(x, y) != nil && !s.level.IsExplored(x, y) {
+				return true
+			}
+		}
+	}
+	return false
+}
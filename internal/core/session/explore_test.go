@@ -0,0 +1,74 @@
+package session
+
+import (
+	"testing"
+
+	"github.com/yuru-sha/gorogue/internal/game/actor"
+	"github.com/yuru-sha/gorogue/internal/game/dungeon"
+	"github.com/yuru-sha/gorogue/internal/game/item"
+)
+
+// newDoorLevel creates two areas split by a wall with a closed door at (10, 5)
+func newDoorLevel() *dungeon.Level {
+	level := newTestLevel(20, 10)
+	for y := 1; y < 9; y++ {
+		level.Tiles[y][10] = dungeon.NewTile(dungeon.TileWall)
+	}
+	level.Tiles[5][10] = dungeon.NewTile(dungeon.TileDoor)
+	return level
+}
+
+func TestAutoExploreWholeLevel(t *testing.T) {
+	player := actor.NewPlayer(2, 2)
+	level := newDoorLevel()
+	s := NewWithLevel(player, level)
+
+	var result RunResult
+	for i := 0; i < 10; i++ {
+		result = s.AutoExplore()
+		if result.Reason != StopLimit {
+			break
+		}
+	}
+
+	if result.Reason != StopExplored {
+		t.Fatalf("Expected the level to be fully explored, stopped with %v", result.Reason)
+	}
+	if level.Tiles[5][10].Type != dungeon.TileOpenDoor {
+		t.Error("Auto-explore should open the door on its way")
+	}
+	for y := 0; y < level.Height; y++ {
+		for x := 0; x < level.Width; x++ {
+			if !level.Tiles[y][x].Explored {
+				t.Fatalf("Tile (%d, %d) was not explored", x, y)
+			}
+		}
+	}
+}
+
+func TestAutoExploreStopsAtItem(t *testing.T) {
+	player := actor.NewPlayer(2, 2)
+	level := newDoorLevel()
+	level.AddItem(item.NewItem(0, 0, item.ItemFood, "Food", 0), 5, 5)
+	s := NewWithLevel(player, level)
+
+	result := s.AutoExplore()
+	if result.Reason != StopItem {
+		t.Fatalf("Expected to stop at the item, got %v", result.Reason)
+	}
+	if !hasPickUp(result.Events) {
+		t.Error("Auto-explore should pick up the item it reaches")
+	}
+}
+
+func TestAutoExploreStopsForMonster(t *testing.T) {
+	player := actor.NewPlayer(2, 2)
+	level := newDoorLevel()
+	level.Monsters = append(level.Monsters, actor.NewMonster(4, 2, 'K'))
+	s := NewWithLevel(player, level)
+
+	result := s.AutoExplore()
+	if result.Reason != StopMonster || result.Steps != 0 {
+		t.Errorf("Expected to refuse exploring next to a monster, got %v after %d steps", result.Reason, result.Steps)
+	}
+}
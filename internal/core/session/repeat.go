@@ -0,0 +1,79 @@
+package session
+
+import (
+	"github.com/yuru-sha/gorogue/internal/core/event"
+	"github.com/yuru-sha/gorogue/internal/utils/logger"
+)
+
+// Repeat performs an action up to count times like Rogue's count prefix (20s)
+// 走るときと同じく、新しいモンスターが見えたとき・HPが減ったとき・気になる出来事があったときに中断する
+func (s *Session) Repeat(action Action, count int) RunResult {
+	known := s.visibleMonsters()
+	events := make([]event.Event, 0)
+	routine := make(map[string]bool)
+	result := RunResult{Reason: StopFinished}
+
+	for result.Steps < count {
+		if result.Steps >= maxRunSteps {
+			result.Reason = StopLimit
+			break
+		}
+		if s.newMonsterInView(known) {
+			result.Reason = StopMonster
+			break
+		}
+
+		hp := s.player.HP
+		done := s.Do(action)
+		events = append(events, done.Events...)
+		if !done.Done {
+			result.Reason = StopBlocked
+			break
+		}
+		result.Steps++
+
+		if !s.player.IsAlive() {
+			result.Reason = StopDead
+			break
+		}
+		if s.player.HP < hp {
+			result.Reason = StopDamaged
+			break
+		}
+
+		// 1回目に出たメッセージ（"You rest." など）は毎回出るものとして扱う
+		if result.Steps == 1 {
+			for _, ev := range done.Events {
+				if msg, ok := ev.(event.MessageEvent); ok {
+					routine[msg.Text] = true
+				}
+			}
+		}
+		if isNotable(done.Events, routine) {
+			result.Reason = StopInterrupted
+			break
+		}
+	}
+
+	logger.Debug("Repeat stopped", "action", action.Type.String(), "count", result.Steps, "reason", result.Reason.String())
+	result.Events = events
+	result.Snapshot = s.Snapshot()
+	return result
+}
+
+// isNotable reports whether the events contain something worth stopping a repeat for
+// 移動とターン終了、いつものメッセージ以外はすべて気になる出来事とする
+func isNotable(events []event.Event, routine map[string]bool) bool {
+	for _, ev := range events {
+		switch ev := ev.(type) {
+		case event.PlayerMovedEvent, event.TurnEndedEvent:
+		case event.MessageEvent:
+			if !routine[ev.Text] {
+				return true
+			}
+		default:
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,58 @@
+package session
+
+import (
+	"testing"
+
+	"github.com/yuru-sha/gorogue/internal/core/event"
+	"github.com/yuru-sha/gorogue/internal/game/actor"
+)
+
+func TestRepeatFinishesCount(t *testing.T) {
+	player := actor.NewPlayer(5, 5)
+	s := NewWithLevel(player, newTestLevel(20, 10))
+
+	result := s.Repeat(Search(), 20)
+	if result.Reason != StopFinished || result.Steps != 20 {
+		t.Errorf("Expected to search 20 times, got %d (%v)", result.Steps, result.Reason)
+	}
+}
+
+func TestRepeatMovesUntilBlocked(t *testing.T) {
+	player := actor.NewPlayer(5, 5)
+	s := NewWithLevel(player, newTestLevel(20, 10))
+
+	result := s.Repeat(Move(0, -1), 10)
+	if result.Reason != StopBlocked || player.Position.Y != 1 {
+		t.Errorf("Expected to stop at the wall at y=1, got y=%d (%v)", player.Position.Y, result.Reason)
+	}
+}
+
+func TestRepeatStopsAfterAttack(t *testing.T) {
+	player := actor.NewPlayer(5, 5)
+	level := newTestLevel(20, 10)
+	level.Monsters = append(level.Monsters, actor.NewMonster(5, 4, 'K'))
+	s := NewWithLevel(player, level)
+
+	// モンスターへの移動は攻撃になり、攻撃は気になる出来事なので1回で止まる
+	result := s.Repeat(Move(0, -1), 10)
+	if result.Steps != 1 {
+		t.Errorf("Expected to stop after the first attack, got %d steps (%v)", result.Steps, result.Reason)
+	}
+	if result.Reason != StopInterrupted && result.Reason != StopDamaged && result.Reason != StopDead {
+		t.Errorf("Expected an interrupted repeat, got %v", result.Reason)
+	}
+}
+
+func TestIsNotable(t *testing.T) {
+	routine := map[string]bool{"You rest.": true}
+
+	if isNotable([]event.Event{event.MessageEvent{Text: "You rest."}, event.TurnEndedEvent{}}, routine) {
+		t.Error("Routine messages should not interrupt a repeat")
+	}
+	if !isNotable([]event.Event{event.MessageEvent{Text: "You feel hungry."}}, routine) {
+		t.Error("A new message should interrupt a repeat")
+	}
+	if !isNotable([]event.Event{event.PlayerDamagedEvent{Damage: 1}}, routine) {
+		t.Error("Damage should interrupt a repeat")
+	}
+}
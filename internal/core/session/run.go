@@ -0,0 +1,143 @@
+package session
+
+import (
+	"github.com/yuru-sha/gorogue/internal/game/dungeon"
+)
+
+// orthogonal are the four directions a corridor can continue in
+var orthogonal = [4]dungeon.Position{{X: 0, Y: -1}, {X: 1, Y: 0}, {X: 0, Y: 1}, {X: -1, Y: 0}}
+
+// Run moves repeatedly in a direction like Rogue's shifted movement keys
+// 通路では曲がり角に沿って進み、分かれ道・扉・部屋の入口・アイテム・階段・モンスターで止まる
+func (s *Session) Run(dx, dy int) RunResult {
+	first := true
+	prev := dungeon.Position{X: s.player.Position.X, Y: s.player.Position.Y}
+	wasInRoom := s.inRoom(prev.X, prev.Y)
+
+	// 走り出す前から見えているモンスターでは止まらない（隣に来たら止まる）
+	return s.run(s.visibleMonsters(), true, func() (dungeon.Position, StopReason) {
+		pos := dungeon.Position{X: s.player.Position.X, Y: s.player.Position.Y}
+
+		if !first {
+			if reason, stop := s.runStop(pos, wasInRoom); stop {
+				return dungeon.Position{}, reason
+			}
+
+			// 通路では進める方向が一つだけなら曲がり角に沿って向きを変える
+			if !s.inRoom(pos.X, pos.Y) {
+				var reason StopReason
+				if dx, dy, reason = s.corridorDirection(pos, prev, dx, dy); reason != StopLimit {
+					return dungeon.Position{}, reason
+				}
+			}
+		}
+
+		next := dungeon.Position{X: pos.X + dx, Y: pos.Y + dy}
+		if !first {
+			if tile := s.level.GetTile(next.X, next.Y); tile == nil || !tile.Walkable() {
+				if tile != nil && tile.Type == dungeon.TileDoor {
+					return dungeon.Position{}, StopDoor
+				}
+				return dungeon.Position{}, StopBlocked
+			}
+		}
+
+		// 最初の一歩は通常の移動と同じ（壁なら動かず、モンスターがいれば攻撃）
+		first = false
+		prev = pos
+		wasInRoom = s.inRoom(pos.X, pos.Y)
+		return next, StopLimit
+	})
+}
+
+// runStop checks whether running should stop at a position
+func (s *Session) runStop(pos dungeon.Position, wasInRoom bool) (StopReason, bool) {
+	if s.monsterAdjacent(pos) {
+		return StopMonster, true
+	}
+
+	tile := s.level.GetTile(pos.X, pos.Y)
+	if tile == nil {
+		return StopBlocked, true
+	}
+	switch tile.Type {
+	case dungeon.TileStairsUp, dungeon.TileStairsDown:
+		return StopStairs, true
+	case dungeon.TileDoor, dungeon.TileOpenDoor, dungeon.TileDoorOpen:
+		return StopDoor, true
+	}
+
+	inRoom := s.inRoom(pos.X, pos.Y)
+	if inRoom != wasInRoom {
+		// 部屋の入口（扉のない出入口を含む）を通った
+		return StopDoor, true
+	}
+	if inRoom {
+		// 部屋の中では扉の横を通り過ぎるときに止まる
+		for _, d := range orthogonal {
+			if t := s.level.GetTile(pos.X+d.X, pos.Y+d.Y); t != nil && isDoor(t.Type) {
+				return StopDoor, true
+			}
+		}
+	}
+	return StopLimit, false
+}
+
+// corridorDirection picks the way on along a corridor
+// 来た方向を除いて進める方向がちょうど一つならその方向、なければ行き止まり、複数なら分かれ道で止まる
+func (s *Session) corridorDirection(pos, prev dungeon.Position, dx, dy int) (int, int, StopReason) {
+	options := make([]dungeon.Position, 0, len(orthogonal))
+	for _, d := range orthogonal {
+		next := dungeon.Position{X: pos.X + d.X, Y: pos.Y + d.Y}
+		if next == prev {
+			continue
+		}
+		if t := s.level.GetTile(next.X, next.Y); t != nil && (t.Walkable() || isDoor(t.Type)) {
+			options = append(options, d)
+		}
+	}
+
+	switch len(options) {
+	case 0:
+		// 斜めに進んでいる場合は、そのまま進めるなら進む
+		if t := s.level.GetTile(pos.X+dx, pos.Y+dy); t != nil && t.Walkable() {
+			return dx, dy, StopLimit
+		}
+		return dx, dy, StopBlocked
+	case 1:
+		return options[0].X, options[0].Y, StopLimit
+	default:
+		return dx, dy, StopJunction
+	}
+}
+
+// inRoom reports whether a position is inside a room (walls and doorways included)
+func (s *Session) inRoom(x, y int) bool {
+	return s.level.RoomAt(x, y) != nil
+}
+
+// monsterAdjacent reports whether a living monster stands next to a position
+func (s *Session) monsterAdjacent(pos dungeon.Position) bool {
+	for _, monster := range s.level.Monsters {
+		if monster.IsAlive() && isNextTo(dungeon.Position{X: monster.Position.X, Y: monster.Position.Y}, pos) {
+			return true
+		}
+	}
+	return false
+}
+
+// isNextTo reports whether two positions touch, diagonals included
+func isNextTo(a, b dungeon.Position) bool {
+	dx, dy := a.X-b.X, a.Y-b.Y
+	return dx >= -1 && dx <= 1 && dy >= -1 && dy <= 1 && (dx != 0 || dy != 0)
+}
+
+// isDoor reports whether a tile type is a door, open or closed
+func isDoor(t dungeon.TileType) bool {
+	switch t {
+	case dungeon.TileDoor, dungeon.TileDoorClosed, dungeon.TileDoorOpen, dungeon.TileOpenDoor:
+		return true
+	default:
+		return false
+	}
+}
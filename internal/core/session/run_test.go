@@ -0,0 +1,100 @@
+package session
+
+import (
+	"testing"
+
+	"github.com/yuru-sha/gorogue/internal/game/actor"
+	"github.com/yuru-sha/gorogue/internal/game/dungeon"
+	"github.com/yuru-sha/gorogue/internal/game/item"
+)
+
+// newCorridorLevel creates a solid level with corridors carved at the given positions
+func newCorridorLevel(width, height int, corridor ...dungeon.Position) *dungeon.Level {
+	level := newTestLevel(width, height)
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			level.Tiles[y][x] = dungeon.NewTile(dungeon.TileWall)
+		}
+	}
+	for _, pos := range corridor {
+		level.Tiles[pos.Y][pos.X] = dungeon.NewTile(dungeon.TileFloor)
+	}
+	return level
+}
+
+// line returns the positions from (x1, y1) to (x2, y2) along one axis
+func line(x1, y1, x2, y2 int) []dungeon.Position {
+	positions := make([]dungeon.Position, 0)
+	for x := min(x1, x2); x <= max(x1, x2); x++ {
+		for y := min(y1, y2); y <= max(y1, y2); y++ {
+			positions = append(positions, dungeon.Position{X: x, Y: y})
+		}
+	}
+	return positions
+}
+
+func TestRunStopsAtWall(t *testing.T) {
+	player := actor.NewPlayer(1, 1)
+	s := NewWithLevel(player, newCorridorLevel(12, 3, line(1, 1, 8, 1)...))
+
+	result := s.Run(1, 0)
+	if result.Reason != StopBlocked {
+		t.Errorf("Expected to stop at the dead end, got %v", result.Reason)
+	}
+	if player.Position.X != 8 || result.Steps != 7 {
+		t.Errorf("Expected to run 7 steps to x=8, got %d steps to x=%d", result.Steps, player.Position.X)
+	}
+}
+
+func TestRunFollowsCorridorBend(t *testing.T) {
+	corridor := append(line(1, 1, 5, 1), line(5, 2, 5, 6)...)
+	player := actor.NewPlayer(1, 1)
+	s := NewWithLevel(player, newCorridorLevel(10, 8, corridor...))
+
+	s.Run(1, 0)
+	if player.Position.X != 5 || player.Position.Y != 6 {
+		t.Errorf("Expected to follow the bend to (5, 6), got (%d, %d)", player.Position.X, player.Position.Y)
+	}
+}
+
+func TestRunStopsAtJunction(t *testing.T) {
+	corridor := append(line(1, 3, 8, 3), line(5, 1, 5, 5)...)
+	player := actor.NewPlayer(1, 3)
+	s := NewWithLevel(player, newCorridorLevel(10, 7, corridor...))
+
+	result := s.Run(1, 0)
+	if result.Reason != StopJunction {
+		t.Errorf("Expected to stop at the junction, got %v", result.Reason)
+	}
+	if player.Position.X != 5 {
+		t.Errorf("Expected to stop at x=5, got x=%d", player.Position.X)
+	}
+}
+
+func TestRunStopsAtItem(t *testing.T) {
+	player := actor.NewPlayer(1, 1)
+	level := newCorridorLevel(12, 3, line(1, 1, 10, 1)...)
+	level.AddItem(item.NewItem(0, 0, item.ItemFood, "Food", 0), 4, 1)
+	s := NewWithLevel(player, level)
+
+	result := s.Run(1, 0)
+	if result.Reason != StopItem || player.Position.X != 4 {
+		t.Errorf("Expected to stop on the item at x=4, got %v at x=%d", result.Reason, player.Position.X)
+	}
+}
+
+func TestRunStopsNextToMonster(t *testing.T) {
+	player := actor.NewPlayer(1, 1)
+	level := newCorridorLevel(14, 3, line(1, 1, 12, 1)...)
+	monster := actor.NewMonster(8, 1, 'K')
+	level.Monsters = append(level.Monsters, monster)
+	s := NewWithLevel(player, level)
+
+	result := s.Run(1, 0)
+	if result.Reason != StopMonster && result.Reason != StopDamaged {
+		t.Errorf("Expected to stop for the monster, got %v", result.Reason)
+	}
+	if player.Position.X >= monster.Position.X {
+		t.Errorf("Should not run past the monster, got x=%d", player.Position.X)
+	}
+}
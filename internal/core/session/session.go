@@ -0,0 +1,477 @@
+// Package session ゲームルールを実行するヘッドレスなゲームセッション
+// 抽象的な Action を受け取り、発生したイベントと観測可能な状態のスナップショットを返す。
+// gruid の入力や描画には依存しないため、SDL 画面・CLI・ボット・テストが同じルール実装を共有できる
+package session
+
+import (
+	"github.com/yuru-sha/gorogue/internal/core/event"
+	"github.com/yuru-sha/gorogue/internal/game/actor"
+	"github.com/yuru-sha/gorogue/internal/game/dungeon"
+	"github.com/yuru-sha/gorogue/internal/game/item"
+	"github.com/yuru-sha/gorogue/internal/game/magic"
+	"github.com/yuru-sha/gorogue/internal/i18n"
+	"github.com/yuru-sha/gorogue/internal/utils/logger"
+)
+
+// Session runs the game rules for one player
+type Session struct {
+	player         *actor.Player
+	dungeonManager *dungeon.DungeonManager // nil の場合は level だけで遊ぶ（階段は使えない）
+	level          *dungeon.Level
+	events         *event.Bus
+	recorded       []event.Event
+	turn           int
+	deathReported  bool
+	escaped        bool
+}
+
+// Result is the outcome of an action
+type Result struct {
+	Done     bool // 行動が実行されたか（壁に向かって移動した場合などは false）
+	TookTurn bool // モンスターのターンが進んだか
+	Events   []event.Event
+	Snapshot Snapshot
+}
+
+// New creates a session over a dungeon
+func New(player *actor.Player, dungeonManager *dungeon.DungeonManager) *Session {
+	s := &Session{
+		player:         player,
+		dungeonManager: dungeonManager,
+		level:          dungeonManager.GetCurrentLevel(),
+	}
+	s.explore()
+	return s
+}
+
+// NewWithLevel creates a session over a single level without stairs
+func NewWithLevel(player *actor.Player, level *dungeon.Level) *Session {
+	s := &Session{
+		player: player,
+		level:  level,
+	}
+	s.explore()
+	return s
+}
+
+// SetEventBus sets the bus the session publishes events on (nil: events are only returned)
+func (s *Session) SetEventBus(bus *event.Bus) {
+	s.events = bus
+}
+
+// Player returns the player
+func (s *Session) Player() *actor.Player {
+	return s.player
+}
+
+// Level returns the current level
+func (s *Session) Level() *dungeon.Level {
+	return s.level
+}
+
+// DungeonManager returns the dungeon (nil for single level sessions)
+func (s *Session) DungeonManager() *dungeon.DungeonManager {
+	return s.dungeonManager
+}
+
+// Floor returns the current floor number
+func (s *Session) Floor() int {
+	if s.dungeonManager != nil {
+		return s.dungeonManager.GetCurrentFloor()
+	}
+	if s.level != nil {
+		return s.level.FloorNumber
+	}
+	return 0
+}
+
+// CanDescend reports whether the player stands on the down stairs
+func (s *Session) CanDescend() bool {
+	return s.dungeonManager != nil && s.dungeonManager.CanGoDownstairs()
+}
+
+// Do performs an action and returns the resulting events and state
+func (s *Session) Do(action Action) Result {
+	s.recorded = make([]event.Event, 0)
+
+	var done, tookTurn bool
+	switch action.Type {
+	case ActionMove:
+		done, tookTurn = s.move(action.DX, action.DY)
+	case ActionWait:
+		s.message(i18n.T("game.rest"))
+		done, tookTurn = true, s.endTurn()
+	case ActionSearch:
+		// TODO: 隠し扉や罠の探索
+		s.message(i18n.T("game.search"))
+		done, tookTurn = true, s.endTurn()
+	case ActionPickUp:
+		done = s.pickUp()
+	case ActionDrop:
+		done = s.drop(action.Index)
+	case ActionQuaff:
+		done = s.use(action.Index, item.ItemPotion, i18n.T("game.cannot_drink"))
+	case ActionRead:
+		done = s.use(action.Index, item.ItemScroll, i18n.T("game.cannot_read"))
+	case ActionEat:
+		done = s.use(action.Index, item.ItemFood, i18n.T("game.cannot_eat"))
+	case ActionEquip:
+		done = s.equip(action.Index)
+	case ActionUnequip:
+		done = s.unequip(action.Slot)
+	case ActionAscend:
+		done = s.ascend()
+	case ActionDescend:
+		done = s.descend()
+	case ActionOpen:
+		done, tookTurn = s.open(action.DX, action.DY)
+	default:
+		logger.Warn("Unknown session action", "type", action.Type)
+	}
+
+	if !s.player.IsAlive() {
+		s.ReportDeath()
+	}
+
+	return Result{
+		Done:     done,
+		TookTurn: tookTurn,
+		Events:   s.recorded,
+		Snapshot: s.Snapshot(),
+	}
+}
+
+// ReportDeath publishes the player's death once
+// CLI のデバッグコマンドなど Do 以外で死亡した場合にも呼び出す
+func (s *Session) ReportDeath() {
+	if s.deathReported || s.player.IsAlive() {
+		return
+	}
+	s.deathReported = true
+
+	reason := s.player.KilledBy
+	if reason == "" {
+		reason = actor.DeathUnknown
+	}
+	logger.Info("Player died", "killed_by", reason)
+	s.publish(event.PlayerDiedEvent{KilledBy: reason, Floor: s.Floor()})
+}
+
+// publish records an event for the result and sends it to the bus
+func (s *Session) publish(ev event.Event) {
+	s.recorded = append(s.recorded, ev)
+	s.events.Publish(ev)
+}
+
+// message publishes a plain message
+func (s *Session) message(text string) {
+	s.publish(event.MessageEvent{Text: text})
+}
+
+// move moves the player or attacks the monster in the way
+func (s *Session) move(dx, dy int) (done, tookTurn bool) {
+	newX := s.player.Position.X + dx
+	newY := s.player.Position.Y + dy
+
+	// 境界チェック
+	if newX < 0 || newX >= s.level.Width || newY < 0 || newY >= s.level.Height {
+		logger.Debug("Player movement blocked by bounds",
+			"current_x", s.player.Position.X,
+			"current_y", s.player.Position.Y,
+			"new_x", newX,
+			"new_y", newY,
+		)
+		return false, false
+	}
+
+	// 壁の衝突判定（タイルのないレベルでは自由に動ける）
+	if tile := s.level.GetTile(newX, newY); tile != nil && !tile.Walkable() {
+		logger.Debug("Player movement blocked by wall",
+			"current_x", s.player.Position.X,
+			"current_y", s.player.Position.Y,
+			"new_x", newX,
+			"new_y", newY,
+		)
+		return false, false
+	}
+
+	// モンスターとの戦闘判定
+	if monster := s.level.GetMonsterAt(newX, newY); monster != nil {
+		return true, s.attack(monster)
+	}
+
+	// 移動実行
+	from := *s.player.Position
+	s.player.Position.Move(dx, dy)
+	logger.Debug("Player moved",
+		"new_x", s.player.Position.X,
+		"new_y", s.player.Position.Y,
+	)
+	s.publish(event.PlayerMovedEvent{From: from, To: *s.player.Position})
+	s.explore()
+
+	// 足元のアイテムを拾う
+	if s.level.GetItemAt(newX, newY) != nil {
+		s.pickUp()
+	}
+
+	return true, s.endTurn()
+}
+
+// explore marks what the player sees as explored
+func (s *Session) explore() {
+	if s.level != nil {
+		s.level.Explore(s.player.Position.X, s.player.Position.Y)
+	}
+}
+
+// open opens a closed door next to the player
+func (s *Session) open(dx, dy int) (done, tookTurn bool) {
+	x, y := s.player.Position.X+dx, s.player.Position.Y+dy
+	if tile := s.level.GetTile(x, y); tile == nil || tile.Type != dungeon.TileDoor {
+		s.message(i18n.T("game.no_door"))
+		return false, false
+	}
+
+	// 扉のタイルは作り直されるので、開けた扉の周囲を改めて探索済みにする
+	dungeon.NewDoorPlacer(s.level).OpenDoor(x, y)
+	s.level.Explore(x, y)
+	s.message(i18n.T("game.open_door"))
+	return true, s.endTurn()
+}
+
+// attack attacks a monster; killing it grants the rewards without a monster turn
+func (s *Session) attack(monster *actor.Monster) (tookTurn bool) {
+	damage := s.player.CalculateDamage(monster.Defense)
+	monster.TakeDamage(damage)
+	s.publish(event.AttackEvent{Monster: monster, Damage: damage})
+
+	if monster.IsAlive() {
+		return s.endTurn()
+	}
+
+	// 経験値とゴールドを取得
+	exp, gold := monster.Rewards()
+	previousLevel := s.player.Level
+	s.player.GainExp(exp)
+	s.player.AddGold(gold)
+
+	s.publish(event.MonsterKilledEvent{Monster: monster, Exp: exp, Gold: gold})
+	if s.player.Level > previousLevel {
+		s.publish(event.StatusChangedEvent{Status: event.StatusLevelUp, Level: s.player.Level})
+	}
+	s.finishTurn()
+	return false
+}
+
+// endTurn lets the monsters act and finishes the turn
+// ダメージはモンスターごとに発行し、どのモンスターの攻撃かをモンスター図鑑に伝える
+func (s *Session) endTurn() bool {
+	for _, monster := range s.level.Monsters {
+		if !monster.IsAlive() {
+			continue
+		}
+		hp := s.player.HP
+		monster.Update(s.player, s.level)
+		if s.player.HP < hp {
+			s.publish(event.PlayerDamagedEvent{
+				Source:  monster.Type.DisplayName(),
+				Damage:  hp - s.player.HP,
+				Monster: monster,
+				Effect:  monster.LastEffect,
+			})
+		}
+	}
+	s.level.RemoveDeadMonsters()
+	s.finishTurn()
+	return true
+}
+
+// finishTurn counts the turn and publishes its end
+func (s *Session) finishTurn() {
+	s.turn++
+	s.publish(event.TurnEndedEvent{})
+}
+
+// pickUp picks up the item under the player
+func (s *Session) pickUp() bool {
+	itm := s.level.GetItemAt(s.player.Position.X, s.player.Position.Y)
+	if itm == nil {
+		s.message(i18n.T("game.nothing_to_pick_up"))
+		return false
+	}
+
+	if !s.player.Inventory.AddItem(itm) {
+		s.message(i18n.T("game.pack_full"))
+		return false
+	}
+
+	s.level.RemoveItem(itm)
+	s.publish(event.ItemPickedUpEvent{Item: itm})
+	return true
+}
+
+// drop drops an inventory item at the player's position
+func (s *Session) drop(index int) bool {
+	itm := s.player.Inventory.GetItem(index)
+	if itm == nil {
+		s.message(i18n.T("game.invalid_selection"))
+		return false
+	}
+
+	s.level.AddItem(itm, s.player.Position.X, s.player.Position.Y)
+	s.player.Inventory.RemoveItem(index)
+	s.publish(event.ItemDroppedEvent{Item: itm})
+	return true
+}
+
+// use consumes a potion, scroll or food
+func (s *Session) use(index int, itemType item.ItemType, wrongType string) bool {
+	itm := s.player.Inventory.GetItem(index)
+	if itm == nil {
+		s.message(i18n.T("game.invalid_selection"))
+		return false
+	}
+	if itm.Type != itemType {
+		s.message(wrongType)
+		return false
+	}
+
+	var result *magic.EffectResult
+	switch itemType {
+	case item.ItemPotion:
+		result = magic.UsePotion(itm.Name, s.player)
+	case item.ItemScroll:
+		result = magic.UseScroll(itm.Name, s.player, s.level)
+	default:
+		s.player.Hunger = 100
+		result = &magic.EffectResult{
+			Message:    i18n.T("game.eat_food"),
+			Success:    true,
+			Identified: true,
+		}
+	}
+	s.publish(event.ItemUsedEvent{Item: itm, Message: result.Message})
+
+	if result.Identified {
+		s.player.IdentifyMgr.IdentifyByUse(itm)
+	}
+
+	// 使ったアイテムを消費
+	s.player.Inventory.RemoveItem(index)
+	return true
+}
+
+// CanEquip checks if an item can be equipped
+func CanEquip(itm *item.Item) bool {
+	switch itm.Type {
+	case item.ItemWeapon, item.ItemArmor, item.ItemRing:
+		return true
+	default:
+		return false
+	}
+}
+
+// equip equips an inventory item
+func (s *Session) equip(index int) bool {
+	itm := s.player.Inventory.GetItem(index)
+	if itm == nil {
+		s.message(i18n.T("game.invalid_selection"))
+		return false
+	}
+	if !CanEquip(itm) || !s.player.Equipment.EquipItem(itm) {
+		s.message(i18n.T("game.cannot_equip"))
+		return false
+	}
+
+	s.player.Inventory.RemoveItem(index)
+	s.message(i18n.T("game.equipped", s.player.IdentifyMgr.GetDisplayName(itm)))
+	s.publish(event.ItemEquippedEvent{Item: itm})
+	return true
+}
+
+// unequip takes off the item in an equipment slot
+func (s *Session) unequip(slot string) bool {
+	itm := s.player.Equipment.UnequipItem(slot)
+	if itm == nil {
+		s.message(i18n.T("game.not_equipped", slotName(slot)))
+		return false
+	}
+
+	if !s.player.Inventory.AddItem(itm) {
+		s.message(i18n.T("game.pack_full"))
+		// 装備を戻す
+		s.player.Equipment.EquipItem(itm)
+		return false
+	}
+
+	s.message(i18n.T("game.took_off", s.player.IdentifyMgr.GetDisplayName(itm)))
+	return true
+}
+
+// slotName returns the display name of an equipment slot
+func slotName(slot string) string {
+	switch slot {
+	case SlotWeapon, SlotArmor, SlotRingLeft, SlotRingRight:
+		return i18n.T("slot." + slot)
+	default:
+		return slot
+	}
+}
+
+// ascend climbs the up stairs, or escapes the dungeon with the amulet
+func (s *Session) ascend() bool {
+	if s.dungeonManager == nil {
+		s.message(i18n.T("game.no_dungeon"))
+		return false
+	}
+
+	if s.dungeonManager.CheckVictoryCondition() {
+		s.escaped = true
+		return true
+	}
+
+	if !s.dungeonManager.CanGoUpstairs() {
+		if s.dungeonManager.CanEscapeWithAmulet() {
+			s.message(i18n.T("game.escape_needs_stairs"))
+		} else {
+			s.message(i18n.T("game.no_upstairs"))
+		}
+		return false
+	}
+	if !s.dungeonManager.GoUpstairs() {
+		return false
+	}
+
+	s.level = s.dungeonManager.GetCurrentLevel()
+	s.explore()
+	s.publish(event.FloorChangedEvent{Floor: s.dungeonManager.GetCurrentFloor(), Down: false})
+	return true
+}
+
+// descend takes the down stairs
+func (s *Session) descend() bool {
+	if s.dungeonManager == nil {
+		s.message(i18n.T("game.no_dungeon"))
+		return false
+	}
+
+	if !s.dungeonManager.CanGoDownstairs() {
+		s.message(i18n.T("game.no_downstairs"))
+		return false
+	}
+	if !s.dungeonManager.GoDownstairs() {
+		return false
+	}
+
+	s.level = s.dungeonManager.GetCurrentLevel()
+	s.explore()
+	s.publish(event.FloorChangedEvent{Floor: s.dungeonManager.GetCurrentFloor(), Down: true})
+
+	// 最終階層に到達した場合、イェンダーの魔除けを配置
+	if s.dungeonManager.IsOnFinalFloor() {
+		s.dungeonManager.PlaceAmuletOfYendor()
+		s.message(i18n.T("game.final_floor"))
+	}
+	return true
+}
@@ -0,0 +1,243 @@
+package session
+
+import (
+	"testing"
+
+	"github.com/yuru-sha/gorogue/internal/core/event"
+	"github.com/yuru-sha/gorogue/internal/game/actor"
+	"github.com/yuru-sha/gorogue/internal/game/dungeon"
+	"github.com/yuru-sha/gorogue/internal/game/item"
+	"github.com/yuru-sha/gorogue/internal/utils/logger"
+)
+
+func init() {
+	// テスト用のログ初期化
+	logger.Setup()
+}
+
+// newTestLevel creates a walled room with a floor inside
+func newTestLevel(width, height int) *dungeon.Level {
+	level := &dungeon.Level{
+		Width:    width,
+		Height:   height,
+		Tiles:    make([][]*dungeon.Tile, height),
+		Monsters: make([]*actor.Monster, 0),
+		Items:    make([]*item.Item, 0),
+	}
+	for y := 0; y < height; y++ {
+		level.Tiles[y] = make([]*dungeon.Tile, width)
+		for x := 0; x < width; x++ {
+			if x == 0 || y == 0 || x == width-1 || y == height-1 {
+				level.Tiles[y][x] = dungeon.NewTile(dungeon.TileWall)
+			} else {
+				level.Tiles[y][x] = dungeon.NewTile(dungeon.TileFloor)
+			}
+		}
+	}
+	return level
+}
+
+func hasKind(events []event.Event, kind event.Kind) bool {
+	for _, ev := range events {
+		if ev.Kind() == kind {
+			return true
+		}
+	}
+	return false
+}
+
+func TestSessionMove(t *testing.T) {
+	player := actor.NewPlayer(2, 2)
+	s := NewWithLevel(player, newTestLevel(10, 10))
+
+	result := s.Do(Move(1, 0))
+	if !result.Done || !result.TookTurn {
+		t.Fatalf("Expected the move to take a turn, got %+v", result)
+	}
+	if result.Snapshot.Player.X != 3 || result.Snapshot.Player.Y != 2 {
+		t.Errorf("Expected player at (3, 2), got (%d, %d)", result.Snapshot.Player.X, result.Snapshot.Player.Y)
+	}
+	if !hasKind(result.Events, event.KindPlayerMoved) || !hasKind(result.Events, event.KindTurnEnded) {
+		t.Errorf("Expected moved and turn ended events, got %v", result.Events)
+	}
+	if result.Snapshot.Turn != 1 {
+		t.Errorf("Expected turn 1, got %d", result.Snapshot.Turn)
+	}
+}
+
+func TestSessionMoveBlockedByWall(t *testing.T) {
+	player := actor.NewPlayer(1, 1)
+	s := NewWithLevel(player, newTestLevel(10, 10))
+
+	result := s.Do(Move(-1, 0))
+	if result.Done || result.TookTurn {
+		t.Errorf("Moving into a wall should do nothing, got %+v", result)
+	}
+	if player.Position.X != 1 || player.Position.Y != 1 {
+		t.Errorf("Player should not move, got (%d, %d)", player.Position.X, player.Position.Y)
+	}
+	if result.Snapshot.Turn != 0 {
+		t.Errorf("Blocked move should not count a turn, got %d", result.Snapshot.Turn)
+	}
+}
+
+func TestSessionAttackAndKill(t *testing.T) {
+	player := actor.NewPlayer(2, 2)
+	level := newTestLevel(10, 10)
+	monster := actor.NewMonster(3, 2, 'K')
+	level.Monsters = append(level.Monsters, monster)
+
+	bus := event.NewBus()
+	var published []event.Kind
+	bus.SubscribeAll(func(ev event.Event) {
+		published = append(published, ev.Kind())
+	})
+
+	s := NewWithLevel(player, level)
+	s.SetEventBus(bus)
+
+	var last Result
+	for i := 0; i < 20 && monster.IsAlive() && player.IsAlive(); i++ {
+		last = s.Do(Move(1, 0))
+		if !hasKind(last.Events, event.KindAttack) {
+			t.Fatalf("Moving into a monster should attack, got %v", last.Events)
+		}
+	}
+
+	if monster.IsAlive() {
+		t.Skip("Monster survived 20 attacks")
+	}
+	if !hasKind(last.Events, event.KindMonsterKilled) {
+		t.Errorf("Expected a monster_killed event, got %v", last.Events)
+	}
+	if last.TookTurn {
+		t.Error("Killing a monster should not give the monsters a turn")
+	}
+	if player.Gold == 0 {
+		t.Error("Killing a monster should grant gold")
+	}
+	if player.Position.X != 2 {
+		t.Errorf("Attacking should not move the player, got x=%d", player.Position.X)
+	}
+	if len(published) == 0 || published[len(published)-1] != event.KindTurnEnded {
+		t.Errorf("Expected the events on the bus, got %v", published)
+	}
+}
+
+func TestSessionPickUpAndDrop(t *testing.T) {
+	player := actor.NewPlayer(2, 2)
+	level := newTestLevel(10, 10)
+	potion := item.NewItem(3, 2, item.ItemPotion, "Potion of Healing", 0)
+	level.AddItem(potion, 3, 2)
+
+	s := NewWithLevel(player, level)
+
+	result := s.Do(Move(1, 0))
+	if !hasKind(result.Events, event.KindItemPickedUp) {
+		t.Fatalf("Walking onto an item should pick it up, got %v", result.Events)
+	}
+	if len(result.Snapshot.Items) != 0 {
+		t.Errorf("Picked up item should leave the floor, got %v", result.Snapshot.Items)
+	}
+
+	index := len(player.Inventory.Items) - 1
+	result = s.Do(Drop(index))
+	if !result.Done || !hasKind(result.Events, event.KindItemDropped) {
+		t.Errorf("Expected the item to be dropped, got %+v", result)
+	}
+	if len(result.Snapshot.Items) != 1 {
+		t.Errorf("Dropped item should lie on the floor, got %v", result.Snapshot.Items)
+	}
+}
+
+func TestSessionEquipPublishesEvent(t *testing.T) {
+	player := actor.NewPlayer(2, 2)
+	s := NewWithLevel(player, newTestLevel(10, 10))
+	armor := item.NewItem(0, 0, item.ItemArmor, "Leather Armor", 30)
+	player.Inventory.AddItem(armor)
+
+	result := s.Do(Equip(len(player.Inventory.Items) - 1))
+	if !result.Done || !hasKind(result.Events, event.KindItemEquipped) {
+		t.Fatalf("Expected the armor to be equipped, got %+v", result)
+	}
+	if player.Equipment.Armor != armor {
+		t.Errorf("Expected the armor to be worn, got %v", player.Equipment.Armor)
+	}
+}
+
+func TestSessionUseWrongItemType(t *testing.T) {
+	player := actor.NewPlayer(2, 2)
+	s := NewWithLevel(player, newTestLevel(10, 10))
+	player.Inventory.AddItem(item.NewItem(0, 0, item.ItemFood, "Food", 0))
+	index := len(player.Inventory.Items) - 1
+
+	result := s.Do(Quaff(index))
+	if result.Done {
+		t.Error("Drinking food should fail")
+	}
+
+	result = s.Do(Eat(index))
+	if !result.Done || !hasKind(result.Events, event.KindItemUsed) {
+		t.Errorf("Expected the food to be eaten, got %+v", result)
+	}
+	if player.Hunger != 100 {
+		t.Errorf("Eating should satisfy hunger, got %d", player.Hunger)
+	}
+}
+
+func TestSessionStairsWithoutDungeon(t *testing.T) {
+	player := actor.NewPlayer(2, 2)
+	s := NewWithLevel(player, newTestLevel(10, 10))
+
+	if s.CanDescend() {
+		t.Error("Single level session should have no stairs")
+	}
+	if result := s.Do(Descend()); result.Done {
+		t.Error("Descending without a dungeon should fail")
+	}
+}
+
+func TestSessionReportDeathOnce(t *testing.T) {
+	player := actor.NewPlayer(2, 2)
+	s := NewWithLevel(player, newTestLevel(10, 10))
+
+	bus := event.NewBus()
+	deaths := 0
+	bus.Subscribe(event.KindPlayerDied, func(ev event.Event) {
+		deaths++
+	})
+	s.SetEventBus(bus)
+
+	player.TakeDamageFrom(player.HP, "テスト")
+	s.ReportDeath()
+	s.ReportDeath()
+
+	if deaths != 1 {
+		t.Errorf("Expected one death event, got %d", deaths)
+	}
+	if !s.Snapshot().Dead {
+		t.Error("Snapshot should report the death")
+	}
+}
+
+func TestMonsterAttackNamesAttacker(t *testing.T) {
+	player := actor.NewPlayer(2, 2)
+	level := newTestLevel(10, 10)
+	monster := actor.NewMonster(3, 2, 'K')
+	level.Monsters = append(level.Monsters, monster)
+	s := NewWithLevel(player, level)
+
+	// 命中は確率で決まるので、攻撃を受けるまで待つ
+	for i := 0; i < 100; i++ {
+		player.HP = player.MaxHP
+		for _, ev := range s.Do(Wait()).Events {
+			if damaged, ok := ev.(event.PlayerDamagedEvent); ok {
+				if damaged.Monster != monster || damaged.Source != monster.Type.DisplayName() {
+					t.Errorf("Expected the damage to name the attacker, got %+v", damaged)
+				}
+				return
+			}
+		}
+	}
+	t.Fatal("Expected the adjacent monster to hit the player")
+}
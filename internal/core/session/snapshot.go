@@ -0,0 +1,96 @@
+package session
+
+// PlayerSnapshot is the observable state of the player
+type PlayerSnapshot struct {
+	X, Y     int
+	HP       int
+	MaxHP    int
+	Level    int
+	Exp      int
+	Gold     int
+	Attack   int
+	Defense  int
+	Hunger   int
+	KilledBy string
+}
+
+// MonsterSnapshot is the observable state of a living monster
+type MonsterSnapshot struct {
+	Name   string
+	Symbol rune
+	X, Y   int
+	HP     int
+	MaxHP  int
+}
+
+// ItemSnapshot is an item lying on the floor
+type ItemSnapshot struct {
+	Name string
+	X, Y int
+}
+
+// Snapshot is the observable game state after an action
+type Snapshot struct {
+	Floor     int
+	Turn      int
+	Player    PlayerSnapshot
+	Monsters  []MonsterSnapshot
+	Items     []ItemSnapshot
+	Dead      bool
+	Escaped   bool // 魔除けを持って地上へ脱出した
+	HasAmulet bool
+}
+
+// Snapshot returns the current observable state
+func (s *Session) Snapshot() Snapshot {
+	p := s.player
+	snapshot := Snapshot{
+		Floor: s.Floor(),
+		Turn:  s.turn,
+		Player: PlayerSnapshot{
+			X:        p.Position.X,
+			Y:        p.Position.Y,
+			HP:       p.HP,
+			MaxHP:    p.MaxHP,
+			Level:    p.Level,
+			Exp:      p.Exp,
+			Gold:     p.Gold,
+			Attack:   p.Attack + p.Equipment.GetAttackBonus(),
+			Defense:  p.GetTotalDefense(),
+			Hunger:   p.Hunger,
+			KilledBy: p.KilledBy,
+		},
+		Monsters: make([]MonsterSnapshot, 0),
+		Items:    make([]ItemSnapshot, 0),
+		Dead:     !p.IsAlive(),
+		Escaped:  s.escaped,
+	}
+	if s.dungeonManager != nil {
+		snapshot.HasAmulet = s.dungeonManager.PlayerHasAmulet()
+	}
+
+	if s.level == nil {
+		return snapshot
+	}
+	for _, monster := range s.level.Monsters {
+		if !monster.IsAlive() {
+			continue
+		}
+		snapshot.Monsters = append(snapshot.Monsters, MonsterSnapshot{
+			Name:   monster.Type.Name,
+			Symbol: monster.Type.Symbol,
+			X:      monster.Position.X,
+			Y:      monster.Position.Y,
+			HP:     monster.HP,
+			MaxHP:  monster.MaxHP,
+		})
+	}
+	for _, itm := range s.level.Items {
+		snapshot.Items = append(snapshot.Items, ItemSnapshot{
+			Name: p.IdentifyMgr.GetDisplayName(itm),
+			X:    itm.Position.X,
+			Y:    itm.Position.Y,
+		})
+	}
+	return snapshot
+}
@@ -0,0 +1,68 @@
+package session
+
+import (
+	"github.com/yuru-sha/gorogue/internal/core/event"
+	"github.com/yuru-sha/gorogue/internal/game/dungeon"
+)
+
+// Travel walks along the shortest known path to a position
+// 経路は探索済みのマップ上で A* により毎歩計算し直す。途中で見えたモンスターやダメージで中断する
+func (s *Session) Travel(x, y int) RunResult {
+	if !s.level.IsExplored(x, y) {
+		return s.stopRun(StopNoPath)
+	}
+
+	// 出発時に見えているモンスターでは止まらない
+	known := s.visibleMonsters()
+	return s.run(known, false, func() (dungeon.Position, StopReason) {
+		if s.player.Position.X == x && s.player.Position.Y == y {
+			return dungeon.Position{}, StopArrived
+		}
+
+		path := s.player.FindPathTo(x, y, s.isTravelPassable)
+		if len(path) < 2 {
+			return dungeon.Position{}, StopNoPath
+		}
+		return dungeon.Position{X: path[1].X, Y: path[1].Y}, StopLimit
+	})
+}
+
+// TravelToStairs travels to the known up or down staircase
+func (s *Session) TravelToStairs(down bool) RunResult {
+	x, y, ok := s.KnownStairs(down)
+	if !ok {
+		return s.stopRun(StopNoPath)
+	}
+	return s.Travel(x, y)
+}
+
+// KnownStairs returns the position of an explored staircase
+func (s *Session) KnownStairs(down bool) (x, y int, ok bool) {
+	stairs := dungeon.TileStairsUp
+	if down {
+		stairs = dungeon.TileStairsDown
+	}
+
+	for y := range s.level.Tiles {
+		for x, tile := range s.level.Tiles[y] {
+			if tile != nil && tile.Explored && tile.Type == stairs {
+				return x, y, true
+			}
+		}
+	}
+	return 0, 0, false
+}
+
+// isTravelPassable reports whether travel may route through a position
+// 探索済みの通れる場所と閉じた扉を通り、モンスターのいる場所は避ける
+func (s *Session) isTravelPassable(x, y int) bool {
+	if !s.isExplorePassable(dungeon.Position{X: x, Y: y}) {
+		return false
+	}
+	return s.level.GetMonsterAt(x, y) == nil
+}
+
+// stopRun returns a run that stopped before taking a step
+func (s *Session) stopRun(reason StopReason) RunResult {
+	return RunResult{Reason: reason, Events: make([]event.Event, 0), Snapshot: s.Snapshot()}
+}
@@ -0,0 +1,82 @@
+package session
+
+import (
+	"testing"
+
+	"github.com/yuru-sha/gorogue/internal/game/actor"
+	"github.com/yuru-sha/gorogue/internal/game/dungeon"
+)
+
+// exploreAll marks every tile of a level as explored
+func exploreAll(level *dungeon.Level) {
+	for y := range level.Tiles {
+		for _, tile := range level.Tiles[y] {
+			tile.Explored = true
+		}
+	}
+}
+
+func TestTravelThroughDoor(t *testing.T) {
+	player := actor.NewPlayer(2, 2)
+	level := newDoorLevel()
+	exploreAll(level)
+	s := NewWithLevel(player, level)
+
+	result := s.Travel(15, 7)
+	if result.Reason != StopArrived {
+		t.Fatalf("Expected to arrive, stopped with %v", result.Reason)
+	}
+	if player.Position.X != 15 || player.Position.Y != 7 {
+		t.Errorf("Expected player at (15, 7), got (%d, %d)", player.Position.X, player.Position.Y)
+	}
+	if level.Tiles[5][10].Type != dungeon.TileOpenDoor {
+		t.Error("Travel should open the door on its way")
+	}
+}
+
+func TestTravelToUnknownPlace(t *testing.T) {
+	player := actor.NewPlayer(2, 2)
+	s := NewWithLevel(player, newDoorLevel())
+
+	// 扉の向こうはまだ見ていない
+	if result := s.Travel(15, 7); result.Reason != StopNoPath || result.Steps != 0 {
+		t.Errorf("Expected no known path, got %v after %d steps", result.Reason, result.Steps)
+	}
+	if result := s.TravelToStairs(true); result.Reason != StopNoPath {
+		t.Errorf("Expected unknown stairs, got %v", result.Reason)
+	}
+}
+
+func TestTravelToStairs(t *testing.T) {
+	player := actor.NewPlayer(2, 2)
+	level := newDoorLevel()
+	level.Tiles[3][7] = dungeon.NewTile(dungeon.TileStairsDown)
+	exploreAll(level)
+	s := NewWithLevel(player, level)
+
+	result := s.TravelToStairs(true)
+	if result.Reason != StopArrived {
+		t.Fatalf("Expected to arrive at the stairs, stopped with %v", result.Reason)
+	}
+	if player.Position.X != 7 || player.Position.Y != 3 {
+		t.Errorf("Expected player on the stairs at (7, 3), got (%d, %d)", player.Position.X, player.Position.Y)
+	}
+}
+
+func TestTravelStopsForNewMonster(t *testing.T) {
+	player := actor.NewPlayer(2, 2)
+	level := newDoorLevel()
+	exploreAll(level)
+	// 扉の向こうのモンスターは扉を開けるまで見えない
+	monster := actor.NewMonster(12, 5, 'K')
+	level.Monsters = append(level.Monsters, monster)
+	s := NewWithLevel(player, level)
+
+	result := s.Travel(15, 7)
+	if result.Reason != StopMonster && result.Reason != StopDamaged {
+		t.Errorf("Expected travel to be interrupted by the monster, got %v", result.Reason)
+	}
+	if player.Position.X > 10 {
+		t.Errorf("Travel should stop by the door, got x=%d", player.Position.X)
+	}
+}
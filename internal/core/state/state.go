@@ -12,6 +12,14 @@ const (
 	StateHelp
 	StateGameOver
 	StateSaveLoad
+	StateScores
+	StateOptions
+	StateVictory
+	StateStatistics
+	StateAchievements
+	StateBestiary
+	StateCreation
+	StateQuit
 )
 
 // State represents a game state interface
@@ -53,7 +61,7 @@ func (sm *StateManager) SetState(state GameState) {
 func (sm *StateManager) HandleInput(msg gruid.Msg) gruid.Effect {
 	if handler, exists := sm.states[sm.currentState]; exists {
 		sm.currentState = handler.HandleInput(msg)
-		if sm.currentState == StateGameOver {
+		if sm.currentState == StateQuit {
 			return gruid.End()
 		}
 	}
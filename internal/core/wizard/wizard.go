@@ -1,11 +1,10 @@
 package wizard
 
 import (
-	"fmt"
-
 	"github.com/yuru-sha/gorogue/internal/game/actor"
 	"github.com/yuru-sha/gorogue/internal/game/dungeon"
 	"github.com/yuru-sha/gorogue/internal/game/item"
+	"github.com/yuru-sha/gorogue/internal/i18n"
 	"github.com/yuru-sha/gorogue/internal/utils/logger"
 )
 
@@ -71,41 +70,41 @@ func (w *WizardMode) ExecuteCommand(command rune) string {
 	case 'w': // Walk through walls
 		return w.toggleWalkThroughWalls()
 	default:
-		return "不明なウィザードコマンド"
+		return i18n.T("wizard.unknown_command")
 	}
 }
 
 // showHelp displays wizard mode help
 func (w *WizardMode) showHelp() string {
-	return "ウィザードモード: h=ヘルプ g=ゴールド l=レベルアップ r=回復 f=満腹 k=全モンスター撃破 s=ステータス i=アイテム作成 t=テレポート v=視界切替 w=壁通り抜け"
+	return i18n.T("wizard.help")
 }
 
 // grantGold grants gold to the player
 func (w *WizardMode) grantGold() string {
 	w.Player.AddGold(1000)
 	logger.Info("Wizard: Granted gold", "amount", 1000)
-	return "1000ゴールドを取得しました"
+	return i18n.T("wizard.gold")
 }
 
 // levelUp levels up the player
 func (w *WizardMode) levelUp() string {
 	w.Player.GainExp(w.Player.GetExpToNextLevel())
 	logger.Info("Wizard: Player leveled up")
-	return "レベルアップしました"
+	return i18n.T("wizard.level_up")
 }
 
 // fullHeal fully heals the player
 func (w *WizardMode) fullHeal() string {
 	w.Player.HP = w.Player.MaxHP
 	logger.Info("Wizard: Player fully healed")
-	return "完全回復しました"
+	return i18n.T("wizard.healed")
 }
 
 // fullFood fills the player's hunger
 func (w *WizardMode) fullFood() string {
 	w.Player.Hunger = 100
 	logger.Info("Wizard: Player hunger restored")
-	return "満腹になりました"
+	return i18n.T("wizard.fed")
 }
 
 // killAllMonsters kills all monsters in the level
@@ -118,12 +117,12 @@ func (w *WizardMode) killAllMonsters() string {
 		}
 	}
 	logger.Info("Wizard: Killed all monsters", "count", count)
-	return fmt.Sprintf("すべてのモンスター（%d体）を倒しました", count)
+	return i18n.T("wizard.killed_all", count)
 }
 
 // showStats shows detailed player statistics
 func (w *WizardMode) showStats() string {
-	return fmt.Sprintf("Lv:%d HP:%d/%d Atk:%d Def:%d Exp:%d Gold:%d Hunger:%d",
+	return i18n.T("wizard.stats",
 		w.Player.Level, w.Player.HP, w.Player.MaxHP,
 		w.Player.Attack, w.Player.Defense, w.Player.Exp,
 		w.Player.Gold, w.Player.Hunger)
@@ -151,13 +150,13 @@ func (w *WizardMode) createItem() string {
 
 	w.Level.Items = append(w.Level.Items, newItem)
 	logger.Info("Wizard: Created item", "type", newItem.Name, "x", newItem.Position.X, "y", newItem.Position.Y)
-	return fmt.Sprintf("%sを作成しました", newItem.Name)
+	return i18n.T("wizard.created", i18n.Named("item", newItem.Name))
 }
 
 // teleport teleports player to a random room
 func (w *WizardMode) teleport() string {
 	if len(w.Level.Rooms) == 0 {
-		return "テレポート先がありません"
+		return i18n.T("wizard.no_teleport")
 	}
 
 	room := w.Level.Rooms[len(w.Level.Rooms)-1] // Last room
@@ -168,7 +167,7 @@ func (w *WizardMode) teleport() string {
 	w.Player.Position.Y = newY
 
 	logger.Info("Wizard: Player teleported", "x", newX, "y", newY)
-	return "テレポートしました"
+	return i18n.T("wizard.teleported")
 }
 
 // toggleVisibility toggles all tiles visibility
@@ -183,7 +182,7 @@ func (w *WizardMode) toggleVisibility() string {
 		}
 	}
 	logger.Info("Wizard: Toggled visibility")
-	return "視界を切り替えました"
+	return i18n.T("wizard.vision")
 }
 
 // toggleWalkThroughWalls toggles walk through walls ability
@@ -191,5 +190,5 @@ func (w *WizardMode) toggleWalkThroughWalls() string {
 	// This would need to be implemented in the game logic
 	// For now, just return a message
 	logger.Info("Wizard: Wall walking toggle requested")
-	return "壁通り抜けモード（未実装）"
+	return i18n.T("wizard.walls")
 }
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/anaseto/gruid"
+	"github.com/yuru-sha/gorogue/internal/i18n"
 	"github.com/yuru-sha/gorogue/internal/utils/logger"
 )
 
@@ -141,6 +142,72 @@ func TestActorTakeDamage(t *testing.T) {
 	}
 }
 
+func TestPlayerTakeDamageFrom(t *testing.T) {
+	player := NewPlayer(0, 0)
+
+	// 致命傷でなければ死因は記録しない
+	player.TakeDamageFrom(1, "ゴブリン")
+	if player.KilledBy != "" {
+		t.Errorf("KilledBy = %q, want empty while alive", player.KilledBy)
+	}
+
+	player.TakeDamageFrom(player.HP, "オーク")
+	if player.KilledBy != "オーク" {
+		t.Errorf("KilledBy = %q, want %q", player.KilledBy, "オーク")
+	}
+
+	// 最初の死因を保持する
+	player.TakeDamageFrom(5, "starvation")
+	if player.KilledBy != "オーク" {
+		t.Errorf("KilledBy = %q, want first fatal source", player.KilledBy)
+	}
+}
+
+func TestDeathCauseName(t *testing.T) {
+	defer i18n.SetLanguage(i18n.CurrentLanguage())
+
+	// 識別名で保存し、表示する言語で翻訳する
+	i18n.SetLanguage(i18n.English)
+	if got := DeathCauseName(MonsterTypes['O'].Name); got != "orc" {
+		t.Errorf("DeathCauseName(orc) = %q, want %q", got, "orc")
+	}
+	if got := DeathCauseName(DeathStarvation); got != "starvation" {
+		t.Errorf("DeathCauseName(starvation) = %q, want %q", got, "starvation")
+	}
+
+	i18n.SetLanguage(i18n.Japanese)
+	if got := DeathCauseName(MonsterTypes['O'].Name); got != "オーク" {
+		t.Errorf("DeathCauseName(orc) = %q, want %q", got, "オーク")
+	}
+	if got := DeathCauseName(DeathPoison); got != "毒" {
+		t.Errorf("DeathCauseName(poison) = %q, want %q", got, "毒")
+	}
+
+	// 識別名でない古い死因はそのまま表示する
+	if got := DeathCauseName("kobold"); got != "kobold" {
+		t.Errorf("DeathCauseName(kobold) = %q, want %q", got, "kobold")
+	}
+}
+
+func TestMonsterAttackRecordsCauseID(t *testing.T) {
+	defer i18n.SetLanguage(i18n.CurrentLanguage())
+	i18n.SetLanguage(i18n.English)
+
+	player := NewPlayer(0, 0)
+	monster := NewMonster(1, 0, 'O')
+	for i := 0; i < 1000 && player.IsAlive(); i++ {
+		monster.AttackPlayer(player)
+	}
+	if player.IsAlive() {
+		t.Fatal("The player should have been killed")
+	}
+
+	// 表示言語に関係なく種別名が保存される
+	if player.KilledBy != MonsterTypes['O'].Name {
+		t.Errorf("KilledBy = %q, want %q", player.KilledBy, MonsterTypes['O'].Name)
+	}
+}
+
 func TestActorHeal(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -244,3 +311,54 @@ func TestActorCombatScenario(t *testing.T) {
 		t.Errorf("Player HP after heal = %v, want %v", player.HP, expectedPlayerHPAfterHeal)
 	}
 }
+
+func TestPlayerFindPathTo(t *testing.T) {
+	player := NewPlayer(1, 1)
+
+	// x == 3 の壁は y == 4 だけ通れる
+	canMoveTo := func(x, y int) bool {
+		if x < 0 || y < 0 || x >= 6 || y >= 6 {
+			return false
+		}
+		return x != 3 || y == 4
+	}
+
+	path := player.FindPathTo(5, 1, canMoveTo)
+	if len(path) == 0 {
+		t.Fatal("Expected a path around the wall")
+	}
+	if path[0].X != 1 || path[0].Y != 1 {
+		t.Errorf("Path should start at the player, got (%d, %d)", path[0].X, path[0].Y)
+	}
+	last := path[len(path)-1]
+	if last.X != 5 || last.Y != 1 {
+		t.Errorf("Path should end at the target, got (%d, %d)", last.X, last.Y)
+	}
+	for _, node := range path {
+		if !canMoveTo(node.X, node.Y) {
+			t.Errorf("Path goes through a blocked position (%d, %d)", node.X, node.Y)
+		}
+	}
+
+	solidWall := func(x, y int) bool { return canMoveTo(x, y) && x != 3 }
+	if path := player.FindPathTo(5, 1, solidWall); path != nil {
+		t.Errorf("Expected no path through a solid wall, got %v", path)
+	}
+}
+
+func TestAbilityName(t *testing.T) {
+	defer i18n.SetLanguage(i18n.CurrentLanguage())
+
+	// 特殊攻撃は識別名で記録し、表示する言語で翻訳する
+	i18n.SetLanguage(i18n.English)
+	if got := AbilityName(EffectStealGold.ID()); got != "steals gold" {
+		t.Errorf("AbilityName(steal_gold) = %q, want %q", got, "steals gold")
+	}
+	i18n.SetLanguage(i18n.Japanese)
+	if got := AbilityName(EffectStealItem.ID()); got != "アイテムを盗む" {
+		t.Errorf("AbilityName(steal_item) = %q, want %q", got, "アイテムを盗む")
+	}
+	if EffectNone.ID() != "" {
+		t.Errorf("Expected no id for EffectNone, got %q", EffectNone.ID())
+	}
+}
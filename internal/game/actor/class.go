@@ -0,0 +1,114 @@
+package actor
+
+import (
+	"strings"
+
+	"github.com/yuru-sha/gorogue/internal/game/item"
+	"github.com/yuru-sha/gorogue/internal/utils/logger"
+)
+
+// Class is the character class chosen when a game starts
+type Class string
+
+const (
+	ClassFighter Class = "fighter" // 体力と武具に優れる
+	ClassRogue   Class = "rogue"   // 軽装で金貨と道具を持つ
+	ClassWizard  Class = "wizard"  // 打たれ弱いが巻物と薬を識別済みで持つ
+)
+
+// Classes lists the selectable classes in display order
+var Classes = []Class{ClassFighter, ClassRogue, ClassWizard}
+
+// kitItem is one item of a starting kit
+type kitItem struct {
+	itemType item.ItemType
+	name     string
+	value    int
+	count    int
+	equip    bool
+}
+
+// classSpec holds the base stats and starting kit of a class
+type classSpec struct {
+	hp, attack, defense int
+	gold                int
+	kit                 []kitItem
+}
+
+// classSpecs defines every class; weapon and armor values set the equipment bonus (value/10)
+var classSpecs = map[Class]classSpec{
+	ClassFighter: {
+		hp: 24, attack: 6, defense: 3,
+		kit: []kitItem{
+			{item.ItemWeapon, "剣", 40, 1, true},
+			{item.ItemArmor, "鎖帷子", 50, 1, true},
+			{item.ItemFood, "干し肉", 10, 2, false},
+		},
+	},
+	ClassRogue: {
+		hp: 20, attack: 5, defense: 2, gold: 50,
+		kit: []kitItem{
+			{item.ItemWeapon, "短剣", 20, 1, true},
+			{item.ItemArmor, "革鎧", 30, 1, true},
+			{item.ItemFood, "パン", 10, 1, false},
+			{item.ItemPotion, "healing", 50, 1, false},
+			{item.ItemScroll, "magic mapping", 80, 1, false},
+		},
+	},
+	ClassWizard: {
+		hp: 14, attack: 3, defense: 1,
+		kit: []kitItem{
+			{item.ItemWeapon, "短剣", 10, 1, true},
+			{item.ItemArmor, "ローブ", 10, 1, true},
+			{item.ItemFood, "パン", 10, 1, false},
+			{item.ItemPotion, "healing", 50, 2, false},
+			{item.ItemScroll, "identify", 50, 2, false},
+			{item.ItemScroll, "teleportation", 80, 1, false},
+		},
+	},
+}
+
+// ParseClass returns the class with the given name
+func ParseClass(name string) (Class, bool) {
+	class := Class(strings.ToLower(strings.TrimSpace(name)))
+	_, ok := classSpecs[class]
+	return class, ok
+}
+
+// NewPlayerWithClass creates a player with the class's stats and equipped starting kit
+func NewPlayerWithClass(x, y int, class Class) *Player {
+	player := NewPlayer(x, y)
+	spec, ok := classSpecs[class]
+	if !ok {
+		logger.Warn("Unknown class, starting without a kit", "class", class)
+		return player
+	}
+
+	player.Class = class
+	player.HP = spec.hp
+	player.MaxHP = spec.hp
+	player.Attack = spec.attack
+	player.Defense = spec.defense
+	player.Gold = spec.gold
+
+	for _, k := range spec.kit {
+		// 使うと山ごと消えるので、複数ある物は1つずつ持たせる
+		for i := 0; i < k.count; i++ {
+			itm := item.NewItem(0, 0, k.itemType, k.name, k.value)
+			// 持ち物の正体は最初から分かっている
+			player.IdentifyMgr.IdentifyItem(itm)
+			if k.equip && player.Equipment.EquipItem(itm) {
+				continue
+			}
+			player.Inventory.AddItem(itm)
+		}
+	}
+
+	logger.Debug("Created player with class",
+		"class", class,
+		"hp", player.MaxHP,
+		"attack", player.Attack,
+		"defense", player.Defense,
+	)
+	return player
+}
@@ -0,0 +1,79 @@
+package actor
+
+import (
+	"testing"
+
+	"github.com/yuru-sha/gorogue/internal/game/item"
+)
+
+func TestNewPlayerWithClass(t *testing.T) {
+	for _, class := range Classes {
+		t.Run(string(class), func(t *testing.T) {
+			player := NewPlayerWithClass(3, 4, class)
+
+			if player.Class != class {
+				t.Errorf("Class = %q, expected %q", player.Class, class)
+			}
+			if player.HP != player.MaxHP || player.MaxHP != classSpecs[class].hp {
+				t.Errorf("HP = %d/%d, expected %d", player.HP, player.MaxHP, classSpecs[class].hp)
+			}
+			if player.Position.X != 3 || player.Position.Y != 4 {
+				t.Errorf("Position = %v, expected (3,4)", player.Position)
+			}
+
+			// 武器と鎧は持ち物ではなく装備として持つ
+			if player.Equipment.Weapon == nil || player.Equipment.Armor == nil {
+				t.Fatal("Starting weapon and armor should be equipped")
+			}
+			if player.Equipment.GetAttackBonus() <= 0 || player.GetTotalDefense() <= player.Defense {
+				t.Error("Starting weapon and armor should add to attack and defense")
+			}
+			for _, itm := range player.Inventory.Items {
+				if itm.Type == item.ItemWeapon || itm.Type == item.ItemArmor {
+					t.Errorf("%s should be equipped, not in the pack", itm.Name)
+				}
+				if !player.IdentifyMgr.IsIdentified(itm) {
+					t.Errorf("Starting item %s should be identified", itm.Name)
+				}
+			}
+		})
+	}
+}
+
+func TestClassesDiffer(t *testing.T) {
+	fighter := NewPlayerWithClass(0, 0, ClassFighter)
+	wizard := NewPlayerWithClass(0, 0, ClassWizard)
+
+	if fighter.MaxHP <= wizard.MaxHP {
+		t.Errorf("Fighter HP %d should exceed wizard HP %d", fighter.MaxHP, wizard.MaxHP)
+	}
+	if wizard.Inventory.Size() <= fighter.Inventory.Size() {
+		t.Errorf("Wizard should carry more items (%d) than the fighter (%d)", wizard.Inventory.Size(), fighter.Inventory.Size())
+	}
+}
+
+func TestParseClass(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected Class
+		ok       bool
+	}{
+		{"fighter", ClassFighter, true},
+		{" Rogue ", ClassRogue, true},
+		{"WIZARD", ClassWizard, true},
+		{"bard", "bard", false},
+	}
+	for _, tt := range tests {
+		class, ok := ParseClass(tt.name)
+		if class != tt.expected || ok != tt.ok {
+			t.Errorf("ParseClass(%q) = %q, %v, expected %q, %v", tt.name, class, ok, tt.expected, tt.ok)
+		}
+	}
+}
+
+func TestNewPlayerWithUnknownClass(t *testing.T) {
+	player := NewPlayerWithClass(0, 0, "bard")
+	if player.Class != "" || !player.Inventory.IsEmpty() {
+		t.Error("An unknown class should give a plain player without a kit")
+	}
+}
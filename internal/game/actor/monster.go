@@ -6,6 +6,7 @@ import (
 
 	"github.com/anaseto/gruid"
 	"github.com/yuru-sha/gorogue/internal/core/entity"
+	"github.com/yuru-sha/gorogue/internal/i18n"
 	"github.com/yuru-sha/gorogue/internal/utils/logger"
 )
 
@@ -50,6 +51,25 @@ var MonsterTypes = map[rune]MonsterType{
 	'Z': {Symbol: 'Z', Name: "ゾンビ", HP: 35, Attack: 10, Defense: 5, Color: 0x556B2F, Speed: 4},     // DarkOliveGreen
 }
 
+// MonsterTypeByName returns the monster type with the given identifier name
+func MonsterTypeByName(name string) (MonsterType, bool) {
+	for _, t := range MonsterTypes {
+		if t.Name == name {
+			return t, true
+		}
+	}
+	return MonsterType{}, false
+}
+
+// DisplayName returns the monster name in the selected language
+// Name は保存データで使う識別名なので翻訳しない
+func (t MonsterType) DisplayName() string {
+	if id := "monster." + string(t.Symbol); i18n.Has(id) {
+		return i18n.T(id)
+	}
+	return t.Name
+}
+
 // AIState represents the current AI state of a monster
 type AIState int
 
@@ -62,6 +82,38 @@ const (
 	StateFlee
 )
 
+// SpecialEffect is a special attack effect a monster can inflict
+type SpecialEffect int
+
+const (
+	EffectNone SpecialEffect = iota
+	EffectPoison
+	EffectDrain
+	EffectStealGold
+	EffectStealItem
+)
+
+// ID returns the stable identifier of the effect stored in saves and the monster recall (empty for none)
+func (e SpecialEffect) ID() string {
+	switch e {
+	case EffectPoison:
+		return "poison"
+	case EffectDrain:
+		return "drain"
+	case EffectStealGold:
+		return "steal_gold"
+	case EffectStealItem:
+		return "steal_item"
+	default:
+		return ""
+	}
+}
+
+// AbilityName returns the display name of an effect identifier in the current language
+func AbilityName(id string) string {
+	return i18n.Named("ability", id)
+}
+
 // Monster represents a monster in the game
 type Monster struct {
 	*Actor
@@ -77,6 +129,7 @@ type Monster struct {
 	OriginalPos    entity.Position   // Starting position for patrol
 	ViewRange      int               // How far the monster can see
 	DetectionRange int               // How close player must be to detect
+	LastEffect     SpecialEffect     // Special effect of the last attack (EffectNone if none)
 }
 
 // NewMonster creates a new monster of the given type at the specified position
@@ -151,6 +204,11 @@ func calculateDetectionRange(monsterType rune) int {
 	}
 }
 
+// Rewards returns the experience and gold gained for killing the monster
+func (m *Monster) Rewards() (exp, gold int) {
+	return m.MaxHP + m.Attack, m.MaxHP / 2
+}
+
 // generatePatrolPath generates a simple patrol path for the monster
 func (m *Monster) generatePatrolPath() {
 	// Simple 4-point patrol pattern around the original position
@@ -218,6 +276,8 @@ func (m *Monster) DistanceToPlayer(player *Player) float64 {
 
 // AttackPlayer performs an attack on the player with enhanced combat mechanics
 func (m *Monster) AttackPlayer(player *Player) {
+	m.LastEffect = EffectNone
+
 	// Calculate hit chance based on monster type and player defense
 	hitChance := m.calculateHitChance(player)
 
@@ -237,10 +297,10 @@ func (m *Monster) AttackPlayer(player *Player) {
 	finalDamage := m.applyDamageModifiers(baseDamage, player)
 
 	// Apply damage to player
-	player.TakeDamage(finalDamage)
+	player.TakeDamageFrom(finalDamage, m.Type.Name)
 
 	// Apply special effects
-	m.applySpecialEffects(player)
+	m.LastEffect = m.applySpecialEffects(player)
 
 	logger.Info("Monster attacked player",
 		"monster", m.Type.Name,
@@ -319,22 +379,23 @@ func (m *Monster) applyDamageModifiers(baseDamage int, player *Player) int {
 	return finalDamage
 }
 
-// applySpecialEffects applies special combat effects
-func (m *Monster) applySpecialEffects(player *Player) {
+// applySpecialEffects applies special combat effects and returns the one that happened
+// 未実装の効果は何も起きていないので EffectNone を返す
+func (m *Monster) applySpecialEffects(player *Player) SpecialEffect {
 	switch m.Type.Symbol {
 	case 'R': // Rattlesnake poison
 		if rand.Float64() < 0.2 { // 20% chance
 			logger.Info("Player poisoned by rattlesnake",
 				"monster", m.Type.Name,
 			)
-			// TODO: Implement poison effect
+			// TODO: Implement poison effect and return EffectPoison
 		}
 	case 'V': // Vampire level drain
 		if rand.Float64() < 0.1 { // 10% chance
 			logger.Info("Player drained by vampire",
 				"monster", m.Type.Name,
 			)
-			// TODO: Implement level drain
+			// TODO: Implement level drain and return EffectDrain
 		}
 	case 'L': // Leprechaun steals gold
 		if rand.Float64() < 0.15 && player.Gold > 0 { // 15% chance
@@ -345,6 +406,7 @@ func (m *Monster) applySpecialEffects(player *Player) {
 					"monster", m.Type.Name,
 					"amount", stolen,
 				)
+				return EffectStealGold
 			}
 		}
 	case 'N': // Nymph steals items
@@ -352,9 +414,10 @@ func (m *Monster) applySpecialEffects(player *Player) {
 			logger.Info("Nymph attempts to steal item",
 				"monster", m.Type.Name,
 			)
-			// TODO: Implement item stealing
+			// TODO: Implement item stealing and return EffectStealItem
 		}
 	}
+	return EffectNone
 }
 
 // MoveTowardsPlayer moves the monster towards the player
@@ -277,6 +277,16 @@ func TestMonsterSpecialAbilities(t *testing.T) {
 	if player.Gold == initialGold {
 		t.Error("Expected Leprechaun to steal some gold in 100 attempts")
 	}
+
+	// 未実装の効果は起きたことにしない
+	for _, symbol := range []rune{'R', 'V', 'N'} {
+		monster := NewMonster(5, 5, symbol)
+		for i := 0; i < 100; i++ {
+			if effect := monster.applySpecialEffects(player); effect != EffectNone {
+				t.Fatalf("Expected no effect from %c, got %v", symbol, effect)
+			}
+		}
+	}
 }
 
 func TestMonsterIntelligence(t *testing.T) {
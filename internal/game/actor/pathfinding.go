@@ -43,13 +43,19 @@ func (h *NodeHeap) Pop() interface{} {
 
 // AStar implements the A* pathfinding algorithm
 func (m *Monster) AStar(targetX, targetY int, level LevelCollisionChecker) []Node {
-	startX, startY := m.Position.X, m.Position.Y
-
 	// Early exit if target is unreachable
 	if !level.IsInBounds(targetX, targetY) || !level.IsWalkable(targetX, targetY) {
 		return nil
 	}
 
+	return FindPath(m.Position.X, m.Position.Y, targetX, targetY, func(x, y int) bool {
+		return m.CanMoveTo(x, y, level)
+	})
+}
+
+// FindPath finds the shortest path with A*; canMoveTo decides which positions may be entered
+// モンスターとプレイヤー（移動コマンド）で共通の経路探索。返す経路は開始位置を含む
+func FindPath(startX, startY, targetX, targetY int, canMoveTo func(x, y int) bool) []Node {
 	// Early exit if we're already at the target
 	if startX == targetX && startY == targetY {
 		return []Node{{X: startX, Y: startY}}
@@ -65,28 +71,28 @@ func (m *Monster) AStar(targetX, targetY int, level LevelCollisionChecker) []Nod
 		X: startX,
 		Y: startY,
 		G: 0,
-		H: m.heuristic(startX, startY, targetX, targetY),
+		H: heuristic(startX, startY, targetX, targetY),
 	}
 	startNode.F = startNode.G + startNode.H
 
 	heap.Push(openSet, startNode)
-	nodeMap[m.nodeKey(startX, startY)] = startNode
+	nodeMap[nodeKey(startX, startY)] = startNode
 
 	// A* main loop
 	for openSet.Len() > 0 {
 		current := heap.Pop(openSet).(*Node)
-		currentKey := m.nodeKey(current.X, current.Y)
+		currentKey := nodeKey(current.X, current.Y)
 
 		// Check if we've reached the target
 		if current.X == targetX && current.Y == targetY {
-			return m.reconstructPath(current)
+			return reconstructPath(current)
 		}
 
 		closedSet[currentKey] = true
 
 		// Check all neighbors
-		for _, neighbor := range m.getNeighbors(current.X, current.Y, level) {
-			neighborKey := m.nodeKey(neighbor.X, neighbor.Y)
+		for _, neighbor := range getNeighbors(current.X, current.Y, canMoveTo) {
+			neighborKey := nodeKey(neighbor.X, neighbor.Y)
 
 			// Skip if already processed
 			if closedSet[neighborKey] {
@@ -94,7 +100,7 @@ func (m *Monster) AStar(targetX, targetY int, level LevelCollisionChecker) []Nod
 			}
 
 			// Calculate tentative G score
-			tentativeG := current.G + m.moveCost(current.X, current.Y, neighbor.X, neighbor.Y)
+			tentativeG := current.G + moveCost(current.X, current.Y, neighbor.X, neighbor.Y)
 
 			// Check if this path to neighbor is better
 			existingNode, exists := nodeMap[neighborKey]
@@ -105,7 +111,7 @@ func (m *Monster) AStar(targetX, targetY int, level LevelCollisionChecker) []Nod
 
 			if !exists || tentativeG < existingNode.G {
 				existingNode.G = tentativeG
-				existingNode.H = m.heuristic(neighbor.X, neighbor.Y, targetX, targetY)
+				existingNode.H = heuristic(neighbor.X, neighbor.Y, targetX, targetY)
 				existingNode.F = existingNode.G + existingNode.H
 				existingNode.Parent = current
 
@@ -124,12 +130,12 @@ func (m *Monster) AStar(targetX, targetY int, level LevelCollisionChecker) []Nod
 }
 
 // heuristic calculates the Manhattan distance heuristic
-func (m *Monster) heuristic(x1, y1, x2, y2 int) float64 {
+func heuristic(x1, y1, x2, y2 int) float64 {
 	return math.Abs(float64(x1-x2)) + math.Abs(float64(y1-y2))
 }
 
 // moveCost calculates the cost to move from one position to another
-func (m *Monster) moveCost(x1, y1, x2, y2 int) float64 {
+func moveCost(x1, y1, x2, y2 int) float64 {
 	// Diagonal movement costs more
 	if x1 != x2 && y1 != y2 {
 		return 1.4 // sqrt(2) approximation
@@ -138,7 +144,7 @@ func (m *Monster) moveCost(x1, y1, x2, y2 int) float64 {
 }
 
 // getNeighbors returns all valid neighboring positions
-func (m *Monster) getNeighbors(x, y int, level LevelCollisionChecker) []Node {
+func getNeighbors(x, y int, canMoveTo func(x, y int) bool) []Node {
 	neighbors := []Node{}
 
 	// Check all 8 directions
@@ -149,7 +155,7 @@ func (m *Monster) getNeighbors(x, y int, level LevelCollisionChecker) []Node {
 			}
 
 			nx, ny := x+dx, y+dy
-			if m.CanMoveTo(nx, ny, level) {
+			if canMoveTo(nx, ny) {
 				neighbors = append(neighbors, Node{X: nx, Y: ny})
 			}
 		}
@@ -159,7 +165,7 @@ func (m *Monster) getNeighbors(x, y int, level LevelCollisionChecker) []Node {
 }
 
 // reconstructPath reconstructs the path from the target back to the start
-func (m *Monster) reconstructPath(node *Node) []Node {
+func reconstructPath(node *Node) []Node {
 	path := []Node{}
 	current := node
 
@@ -172,7 +178,7 @@ func (m *Monster) reconstructPath(node *Node) []Node {
 }
 
 // nodeKey creates a unique key for a node position
-func (m *Monster) nodeKey(x, y int) string {
+func nodeKey(x, y int) string {
 	return fmt.Sprintf("%d,%d", x, y)
 }
 
@@ -200,3 +206,9 @@ func (m *Monster) MoveAlongPath(path []Node, level LevelCollisionChecker) bool {
 
 	return false
 }
+
+// FindPathTo finds a path from the player to a position
+// canMoveTo には探索済みのマップなど、プレイヤーが知っている範囲での移動可否を渡す
+func (p *Player) FindPathTo(targetX, targetY int, canMoveTo func(x, y int) bool) []Node {
+	return FindPath(p.Position.X, p.Position.Y, targetX, targetY, canMoveTo)
+}
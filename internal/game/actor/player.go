@@ -3,19 +3,47 @@ package actor
 import (
 	"github.com/yuru-sha/gorogue/internal/game/identification"
 	"github.com/yuru-sha/gorogue/internal/game/inventory"
+	"github.com/yuru-sha/gorogue/internal/i18n"
 	"github.com/yuru-sha/gorogue/internal/utils/logger"
 )
 
 // Player represents the player character
 type Player struct {
 	*Actor
-	Level       int
-	Hunger      int
-	Exp         int
-	Gold        int
-	Inventory   *inventory.Inventory
-	Equipment   *inventory.Equipment
-	IdentifyMgr *identification.IdentificationManager
+	Level         int
+	Hunger        int
+	Exp           int
+	Gold          int
+	Inventory     *inventory.Inventory
+	Equipment     *inventory.Equipment
+	IdentifyMgr   *identification.IdentificationManager
+	KilledBy      string         // 死因の識別名（モンスターは種別名、表示時に DeathCauseName で翻訳）
+	Class         Class          // 職業（NewPlayer で作った場合は空）
+	StatusEffects []StatusEffect // 時間で切れる状態（毒や混乱など）
+}
+
+// Causes of death other than monsters (monsters are recorded by their type name)
+const (
+	DeathStarvation = "starvation"
+	DeathPoison     = "poison"
+	DeathUnknown    = "unknown"
+)
+
+// DeathCauseName returns a cause of death in the selected language
+// 翻訳は表示するときだけ行い、スコアや統計には識別名のまま保存する
+func DeathCauseName(cause string) string {
+	if t, exists := MonsterTypeByName(cause); exists {
+		return t.DisplayName()
+	}
+	return i18n.Named("death", cause)
+}
+
+// StatusEffect is a temporary condition on the player
+type StatusEffect struct {
+	Type      string
+	Duration  int // 残りターン数
+	Intensity int
+	Source    string
 }
 
 // NewPlayer creates a new player at the given position
@@ -101,7 +129,16 @@ func (p *Player) UpdateHunger() {
 			"damage", 1,
 			"hunger", p.Hunger,
 		)
-		p.TakeDamage(1) // Starvation damage
+		p.TakeDamageFrom(1, DeathStarvation) // Starvation damage
+	}
+}
+
+// TakeDamageFrom applies damage and remembers the source if it was fatal
+func (p *Player) TakeDamageFrom(damage int, source string) {
+	p.TakeDamage(damage)
+	if !p.IsAlive() && p.KilledBy == "" {
+		p.KilledBy = source
+		logger.Debug("Player killed", "killed_by", source)
 	}
 }
 
@@ -227,3 +227,47 @@ func TestDungeonBuilderStairPlacement(t *testing.T) {
 		t.Errorf("Floor 26 should have no down stairs, found %d", downStairs)
 	}
 }
+
+func TestNewLevelFromSeed(t *testing.T) {
+	for _, floor := range []int{1, 7} {
+		a := NewLevelFromSeed(80, 41, floor, 12345)
+		b := NewLevelFromSeed(80, 41, floor, 12345)
+
+		if a.Seed != 12345 {
+			t.Errorf("Floor %d: seed = %d, want 12345", floor, a.Seed)
+		}
+		if len(a.Rooms) != len(b.Rooms) {
+			t.Fatalf("Floor %d: room count %d != %d", floor, len(a.Rooms), len(b.Rooms))
+		}
+		for i := range a.Rooms {
+			if *a.Rooms[i] != *b.Rooms[i] {
+				t.Errorf("Floor %d: room %d differs: %+v != %+v", floor, i, *a.Rooms[i], *b.Rooms[i])
+			}
+		}
+		for y := 0; y < a.Height; y++ {
+			for x := 0; x < a.Width; x++ {
+				if a.GetTile(x, y).Type != b.GetTile(x, y).Type {
+					t.Fatalf("Floor %d: tile (%d,%d) differs for the same seed", floor, x, y)
+				}
+			}
+		}
+	}
+}
+
+func TestLevelIsLit(t *testing.T) {
+	level := NewLevel(80, 41, 1)
+	level.Rooms = []*Room{
+		{X: 5, Y: 5, Width: 4, Height: 4},
+		{X: 20, Y: 5, Width: 4, Height: 4, Type: RoomTypeDark},
+	}
+
+	if !level.IsLit(6, 6) || !level.IsLit(4, 4) {
+		t.Error("A normal room should be lit, walls included")
+	}
+	if level.IsLit(21, 6) {
+		t.Error("A dark room should not be lit")
+	}
+	if level.IsLit(15, 15) {
+		t.Error("A corridor should not be lit")
+	}
+}
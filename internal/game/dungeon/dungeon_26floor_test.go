@@ -225,6 +225,30 @@ func TestVictoryCondition(t *testing.T) {
 			t.Error("Player should have amulet in inventory")
 		}
 	})
+
+	t.Run("VictoryOnFloorOneStairs", func(t *testing.T) {
+		dm.MoveToFloor(1)
+		level := dm.GetCurrentLevel()
+
+		// 1階には上り階段がないので、階段の上に立てば脱出できる
+		found := false
+		for y := 0; y < level.Height && !found; y++ {
+			for x := 0; x < level.Width; x++ {
+				if level.GetTile(x, y).Type == TileStairsDown {
+					player.Position.X, player.Position.Y = x, y
+					found = true
+					break
+				}
+			}
+		}
+		if !found {
+			t.Fatal("Floor 1 has no stairs")
+		}
+
+		if !dm.CheckVictoryCondition() {
+			t.Error("Victory condition should be met on floor 1 stairs with the amulet")
+		}
+	})
 }
 
 func TestMazeGeneration(t *testing.T) {
@@ -62,7 +62,7 @@ func (dm *DungeonManager) SetLevel(floor int, level *Level) {
 
 // generateLevel generates a new level for the given floor
 func (dm *DungeonManager) generateLevel(floor int) *Level {
-	level := NewLevel(DungeonWidth, DungeonHeight, floor)
+	level := NewLevelFromSeed(DungeonWidth, DungeonHeight, floor, rand.Int63())
 	dm.levels[floor] = level
 
 	// 最終階層の場合はAmulet of Yendorを配置
@@ -74,6 +74,7 @@ func (dm *DungeonManager) generateLevel(floor int) *Level {
 		"floor", floor,
 		"width", DungeonWidth,
 		"height", DungeonHeight,
+		"seed", level.Seed,
 		"has_amulet", floor == MaxFloors,
 	)
 
@@ -298,8 +299,8 @@ func (dm *DungeonManager) PlaceAmuletOfYendor() {
 			break
 		}
 		// 部屋内のランダムな位置を試す
-		x = largestRoom.X + rand.Intn(largestRoom.Width)
-		y = largestRoom.Y + rand.Intn(largestRoom.Height)
+		x = largestRoom.X + rng.Intn(largestRoom.Width)
+		y = largestRoom.Y + rng.Intn(largestRoom.Height)
 	}
 
 	amulet := item.NewAmulet(x, y)
@@ -338,10 +339,10 @@ func (dm *DungeonManager) CanEscapeWithAmulet() bool {
 func (dm *DungeonManager) CheckVictoryCondition() bool {
 	// プレイヤーが1階で魔除けを持っている場合、勝利
 	if dm.CanEscapeWithAmulet() {
-		// プレイヤーが上り階段にいる場合
+		// 1階には上り階段がないため、階段の上にいれば地上へ脱出できる
 		level := dm.GetCurrentLevel()
 		tile := level.GetTile(dm.player.Position.X, dm.player.Position.Y)
-		if tile.Type == TileStairsUp {
+		if tile.Type == TileStairsUp || tile.Type == TileStairsDown {
 			logger.Info("Player has won the game!",
 				"floor", dm.currentFloor,
 				"has_amulet", dm.PlayerHasAmulet(),
@@ -0,0 +1,51 @@
+package dungeon
+
+// exploreRadius is how far the player sees outside lit rooms
+const exploreRadius = 1
+
+// RoomAt returns the room containing a position, walls included (nil in corridors)
+func (l *Level) RoomAt(x, y int) *Room {
+	for _, room := range l.Rooms {
+		if x >= room.X-1 && x <= room.X+room.Width && y >= room.Y-1 && y <= room.Y+room.Height {
+			return room
+		}
+	}
+	return nil
+}
+
+// IsLit reports whether a position is inside a lit room, walls included
+func (l *Level) IsLit(x, y int) bool {
+	room := l.RoomAt(x, y)
+	return room != nil && room.Type != RoomTypeDark
+}
+
+// Explore marks the tiles the player sees from a position as explored
+// 周囲1マスに加えて、明るい部屋の中なら部屋全体（壁を含む）が見える
+// 新たに探索済みになったタイル数を返す
+func (l *Level) Explore(x, y int) int {
+	explored := l.exploreRect(x-exploreRadius, y-exploreRadius, x+exploreRadius, y+exploreRadius)
+	if room := l.RoomAt(x, y); room != nil && room.Type != RoomTypeDark {
+		explored += l.exploreRect(room.X-1, room.Y-1, room.X+room.Width, room.Y+room.Height)
+	}
+	return explored
+}
+
+// exploreRect marks a rectangle as explored
+func (l *Level) exploreRect(minX, minY, maxX, maxY int) int {
+	explored := 0
+	for y := minY; y <= maxY; y++ {
+		for x := minX; x <= maxX; x++ {
+			if tile := l.GetTile(x, y); tile != nil && !tile.Explored {
+				tile.Explored = true
+				explored++
+			}
+		}
+	}
+	return explored
+}
+
+// IsExplored reports whether the player has seen a position
+func (l *Level) IsExplored(x, y int) bool {
+	tile := l.GetTile(x, y)
+	return tile != nil && tile.Explored
+}
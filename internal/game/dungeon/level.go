@@ -1,8 +1,6 @@
 package dungeon
 
 import (
-	"math/rand"
-
 	"github.com/yuru-sha/gorogue/internal/game/actor"
 	"github.com/yuru-sha/gorogue/internal/game/item"
 	"github.com/yuru-sha/gorogue/internal/utils/logger"
@@ -21,8 +19,20 @@ type Room struct {
 	Width, Height int
 	IsSpecial     bool
 	Connected     bool
+	Type          string // 部屋の種類（特別な部屋・暗い部屋のみ）
 }
 
+// Room types
+const (
+	RoomTypeTreasure    = "treasure"
+	RoomTypeArmory      = "armory"
+	RoomTypeFoodStorage = "food_storage"
+	RoomTypeMonsterLair = "monster_lair"
+	RoomTypeLaboratory  = "laboratory"
+	RoomTypeLibrary     = "library"
+	RoomTypeDark        = "dark"
+)
+
 // Level represents a single dungeon level
 type Level struct {
 	Width, Height int
@@ -31,6 +41,7 @@ type Level struct {
 	FloorNumber   int
 	Monsters      []*actor.Monster
 	Items         []*item.Item
+	Seed          int64 // 生成に使った乱数シード（0: 不明）
 }
 
 // NewLevel creates a new dungeon level using the builder pattern
@@ -66,7 +77,7 @@ func NewLevel(width, height, floorNum int) *Level {
 // Generate generates the dungeon layout
 func (l *Level) Generate() {
 	// 部屋の生成
-	numRooms := MinRooms + rand.Intn(MaxRooms-MinRooms+1)
+	numRooms := MinRooms + rng.Intn(MaxRooms-MinRooms+1)
 	for i := 0; i < numRooms; i++ {
 		l.GenerateRoom()
 	}
@@ -97,10 +108,10 @@ func (l *Level) Generate() {
 // GenerateRoom generates a single room
 func (l *Level) GenerateRoom() {
 	for attempts := 0; attempts < 100; attempts++ {
-		width := MinRoomSize + rand.Intn(MaxRoomSize-MinRoomSize+1)
-		height := MinRoomSize + rand.Intn(MaxRoomSize-MinRoomSize+1)
-		x := 1 + rand.Intn(l.Width-width-2)
-		y := 1 + rand.Intn(l.Height-height-2)
+		width := MinRoomSize + rng.Intn(MaxRoomSize-MinRoomSize+1)
+		height := MinRoomSize + rng.Intn(MaxRoomSize-MinRoomSize+1)
+		x := 1 + rng.Intn(l.Width-width-2)
+		y := 1 + rng.Intn(l.Height-height-2)
 
 		if l.CanPlaceRoom(x, y, width, height) {
 			room := &Room{
@@ -182,7 +193,7 @@ func (l *Level) ConnectRoomPair(r1, r2 *Room) {
 	y2 := r2.Y + r2.Height/2
 
 	// L字型の通路を生成
-	if rand.Float64() < 0.5 {
+	if rng.Float64() < 0.5 {
 		l.CreateHorizontalCorridor(x1, x2, y1)
 		l.CreateVerticalCorridor(y1, y2, x2)
 	} else {
@@ -248,6 +259,10 @@ func (l *Level) GetTile(x, y int) *Tile {
 	if !l.IsInBounds(x, y) {
 		return nil
 	}
+	// タイルが生成されていないレベル（CLIの仮レベルなど）
+	if y >= len(l.Tiles) || x >= len(l.Tiles[y]) {
+		return nil
+	}
 	return l.Tiles[y][x]
 }
 
@@ -300,7 +315,7 @@ func (l *Level) IsSpecialFloor() bool {
 
 // ShouldGenerateSpecialRoom returns whether a special room should be generated
 func (l *Level) ShouldGenerateSpecialRoom() bool {
-	shouldGenerate := l.IsSpecialFloor() && rand.Float64() < 0.10 // 10% chance
+	shouldGenerate := l.IsSpecialFloor() && rng.Float64() < 0.10 // 10% chance
 	if shouldGenerate {
 		logger.Info("Special room generation triggered",
 			"floor", l.FloorNumber,
@@ -342,7 +357,7 @@ func (l *Level) SpawnMonsters() {
 		
 		for attempts := 0; attempts < maxAttempts; attempts++ {
 			// ランダムな部屋を選択
-			room := l.Rooms[rand.Intn(len(l.Rooms))]
+			room := l.Rooms[rng.Intn(len(l.Rooms))]
 
 			// 部屋が十分な大きさかチェック
 			if room.Width <= 2 || room.Height <= 2 {
@@ -350,8 +365,8 @@ func (l *Level) SpawnMonsters() {
 			}
 
 			// 部屋内のランダムな位置を選択
-			x = room.X + 1 + rand.Intn(room.Width-2)
-			y = room.Y + 1 + rand.Intn(room.Height-2)
+			x = room.X + 1 + rng.Intn(room.Width-2)
+			y = room.Y + 1 + rng.Intn(room.Height-2)
 
 			// その位置が床タイルかチェック
 			if l.GetTile(x, y).Type != TileFloor {
@@ -405,35 +420,35 @@ func (l *Level) selectMonsterType() rune {
 	case l.FloorNumber <= 2:
 		// 最浅階層：超弱いモンスター
 		monsters := []rune{'A', 'B', 'F', 'G', 'K'} // Aquator, Bat, Flyting, Griffin, Kobold
-		return monsters[rand.Intn(len(monsters))]
+		return monsters[rng.Intn(len(monsters))]
 	case l.FloorNumber <= 5:
 		// 浅い階層：弱いモンスター
 		monsters := []rune{'A', 'B', 'E', 'F', 'G', 'I', 'K', 'N'} // + Emu, Ice monster, Nymph
-		return monsters[rand.Intn(len(monsters))]
+		return monsters[rng.Intn(len(monsters))]
 	case l.FloorNumber <= 8:
 		// 初期中間階層：基本的なモンスター
 		monsters := []rune{'A', 'B', 'E', 'F', 'G', 'I', 'K', 'L', 'N', 'R', 'S'} // + Leprechaun, Rattlesnake, Snake
-		return monsters[rand.Intn(len(monsters))]
+		return monsters[rng.Intn(len(monsters))]
 	case l.FloorNumber <= 12:
 		// 中間階層：中程度のモンスター
 		monsters := []rune{'B', 'C', 'E', 'G', 'H', 'I', 'J', 'L', 'O', 'R', 'S', 'W'} // + Centaur, Hobgoblin, Jackal, Orc, Wraith
-		return monsters[rand.Intn(len(monsters))]
+		return monsters[rng.Intn(len(monsters))]
 	case l.FloorNumber <= 16:
 		// 深い階層：強いモンスター
 		monsters := []rune{'C', 'E', 'G', 'H', 'J', 'M', 'O', 'P', 'S', 'T', 'U', 'W', 'Z'} // + Minotaur, Phantom, Troll, Ur-vile, Zombie
-		return monsters[rand.Intn(len(monsters))]
+		return monsters[rng.Intn(len(monsters))]
 	case l.FloorNumber <= 20:
 		// 深層：非常に強いモンスター
 		monsters := []rune{'C', 'H', 'M', 'O', 'P', 'Q', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z'} // + Quasit, Vampire, Xorn, Yeti
-		return monsters[rand.Intn(len(monsters))]
+		return monsters[rng.Intn(len(monsters))]
 	case l.FloorNumber <= 24:
 		// 最深層：最強のモンスター
 		monsters := []rune{'D', 'M', 'P', 'Q', 'T', 'U', 'V', 'X', 'Y', 'Z'} // + Dragon
-		return monsters[rand.Intn(len(monsters))]
+		return monsters[rng.Intn(len(monsters))]
 	default:
 		// 最終階層：ドラゴンと最強モンスター
 		monsters := []rune{'D', 'Q', 'T', 'V', 'X', 'Y', 'Z'} // 最強のみ
-		return monsters[rand.Intn(len(monsters))]
+		return monsters[rng.Intn(len(monsters))]
 	}
 }
 
@@ -562,7 +577,7 @@ func (l *Level) GenerateSpecialRoom() {
 	}
 
 	// 10%の確率で特別な部屋を生成
-	if rand.Float64() > 0.1 {
+	if rng.Float64() > 0.1 {
 		return
 	}
 
@@ -575,8 +590,8 @@ func (l *Level) GenerateSpecialRoom() {
 
 	// 5x5の特別な部屋を生成
 	for attempts := 0; attempts < 100; attempts++ {
-		x := 1 + rand.Intn(l.Width-7)  // 5x5の部屋 + 周囲1マス
-		y := 1 + rand.Intn(l.Height-7) // 5x5の部屋 + 周囲1マス
+		x := 1 + rng.Intn(l.Width-7)  // 5x5の部屋 + 周囲1マス
+		y := 1 + rng.Intn(l.Height-7) // 5x5の部屋 + 周囲1マス
 
 		if l.CanPlaceRoom(x, y, 5, 5) {
 			room := &Room{
@@ -607,22 +622,22 @@ func (l *Level) GenerateSpecialRoom() {
 // PlaceSecretDoor places a secret door for a special room
 func (l *Level) PlaceSecretDoor(room *Room) {
 	// 部屋の4辺のいずれかにランダムに隠し扉を配置
-	side := rand.Intn(4)
+	side := rng.Intn(4)
 	var x, y int
 
 	switch side {
 	case 0: // 上辺
-		x = room.X + rand.Intn(room.Width)
+		x = room.X + rng.Intn(room.Width)
 		y = room.Y - 1
 	case 1: // 右辺
 		x = room.X + room.Width
-		y = room.Y + rand.Intn(room.Height)
+		y = room.Y + rng.Intn(room.Height)
 	case 2: // 下辺
-		x = room.X + rand.Intn(room.Width)
+		x = room.X + rng.Intn(room.Width)
 		y = room.Y + room.Height
 	case 3: // 左辺
 		x = room.X - 1
-		y = room.Y + rand.Intn(room.Height)
+		y = room.Y + rng.Intn(room.Height)
 	}
 
 	if l.IsInBounds(x, y) {
@@ -637,25 +652,31 @@ func (l *Level) PlaceSecretDoor(room *Room) {
 // PopulateSpecialRoom populates a special room with content
 func (l *Level) PopulateSpecialRoom(room *Room) {
 	// 部屋の種類をランダムに決定
-	roomType := rand.Intn(6)
+	roomType := rng.Intn(6)
 
 	switch roomType {
 	case 0: // 宝物庫
+		room.Type = RoomTypeTreasure
 		logger.Info("Generating treasure vault")
 		// TODO: 宝物を配置
 	case 1: // 武器庫
+		room.Type = RoomTypeArmory
 		logger.Info("Generating armory")
 		// TODO: 武器を配置
 	case 2: // 食料庫
+		room.Type = RoomTypeFoodStorage
 		logger.Info("Generating food storage")
 		// TODO: 食料を配置
 	case 3: // 魔物のねぐら
+		room.Type = RoomTypeMonsterLair
 		logger.Info("Generating monster lair")
 		// TODO: モンスターを配置
 	case 4: // 実験室
+		room.Type = RoomTypeLaboratory
 		logger.Info("Generating laboratory")
 		// TODO: 薬を配置
 	case 5: // 図書室
+		room.Type = RoomTypeLibrary
 		logger.Info("Generating library")
 		// TODO: 巻物を配置
 	}
@@ -671,7 +692,7 @@ func (l *Level) SpawnItems() {
 	// 各部屋にアイテムを配置
 	for _, room := range l.Rooms {
 		// 通常の部屋: 階層に応じた確率でアイテムを配置
-		if rand.Float64() < itemSpawnChance {
+		if rng.Float64() < itemSpawnChance {
 			l.spawnItemInRoom(room)
 		}
 
@@ -692,8 +713,8 @@ func (l *Level) spawnItemInRoom(room *Room) {
 	maxAttempts := 20
 	for attempts := 0; attempts < maxAttempts; attempts++ {
 		// 部屋内のランダムな位置を選択
-		x := room.X + rand.Intn(room.Width)
-		y := room.Y + rand.Intn(room.Height)
+		x := room.X + rng.Intn(room.Width)
+		y := room.Y + rng.Intn(room.Height)
 
 		// その位置が有効かチェック
 		if !l.IsValidItemPosition(x, y) {
@@ -804,7 +825,7 @@ func (l *Level) selectWeightedItem(items []item.ItemType, weights []float64) ite
 		totalWeight += weight
 	}
 
-	r := rand.Float64() * totalWeight
+	r := rng.Float64() * totalWeight
 	currentWeight := 0.0
 
 	for i, weight := range weights {
@@ -823,33 +844,33 @@ func (l *Level) createRandomItem(x, y int, itemType item.ItemType) *item.Item {
 	switch itemType {
 	case item.ItemWeapon:
 		weapons := []string{"短剣", "剣", "メイス", "斧", "弓"}
-		name := weapons[rand.Intn(len(weapons))]
-		value := 10 + rand.Intn(50)
+		name := weapons[rng.Intn(len(weapons))]
+		value := 10 + rng.Intn(50)
 		return item.NewItem(x, y, itemType, name, value)
 	case item.ItemArmor:
 		armors := []string{"革鎧", "鎖帷子", "板金鎧", "ローブ", "盾"}
-		name := armors[rand.Intn(len(armors))]
-		value := 20 + rand.Intn(80)
+		name := armors[rng.Intn(len(armors))]
+		value := 20 + rng.Intn(80)
 		return item.NewItem(x, y, itemType, name, value)
 	case item.ItemRing:
 		rings := []string{"力の指輪", "知恵の指輪", "体力の指輪", "敏捷の指輪"}
-		name := rings[rand.Intn(len(rings))]
-		value := 50 + rand.Intn(100)
+		name := rings[rng.Intn(len(rings))]
+		value := 50 + rng.Intn(100)
 		return item.NewItem(x, y, itemType, name, value)
 	case item.ItemScroll:
 		scrolls := []string{"テレポートの巻物", "識別の巻物", "治療の巻物", "魔法の巻物"}
-		name := scrolls[rand.Intn(len(scrolls))]
-		value := 15 + rand.Intn(35)
+		name := scrolls[rng.Intn(len(scrolls))]
+		value := 15 + rng.Intn(35)
 		return item.NewItem(x, y, itemType, name, value)
 	case item.ItemPotion:
 		potions := []string{"体力回復薬", "魔力回復薬", "力強化薬", "敏捷強化薬"}
-		name := potions[rand.Intn(len(potions))]
-		value := 10 + rand.Intn(30)
+		name := potions[rng.Intn(len(potions))]
+		value := 10 + rng.Intn(30)
 		return item.NewItem(x, y, itemType, name, value)
 	case item.ItemFood:
 		foods := []string{"パン", "肉", "果物", "チーズ", "干し肉"}
-		name := foods[rand.Intn(len(foods))]
-		value := 5 + rand.Intn(15)
+		name := foods[rng.Intn(len(foods))]
+		value := 5 + rng.Intn(15)
 		return item.NewItem(x, y, itemType, name, value)
 	default:
 		return nil
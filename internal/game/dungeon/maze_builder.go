@@ -1,8 +1,6 @@
 package dungeon
 
 import (
-	"math/rand"
-
 	"github.com/yuru-sha/gorogue/internal/game/actor"
 	"github.com/yuru-sha/gorogue/internal/game/item"
 	"github.com/yuru-sha/gorogue/internal/utils/logger"
@@ -118,7 +116,7 @@ func (mb *MazeBuilder) carvePath(x, y int) {
 
 	// 方向をランダムにシャッフル
 	for i := len(directions) - 1; i > 0; i-- {
-		j := rand.Intn(i + 1)
+		j := rng.Intn(i + 1)
 		directions[i], directions[j] = directions[j], directions[i]
 	}
 
@@ -149,8 +147,8 @@ func (mb *MazeBuilder) addComplexity() {
 
 	for i := 0; i < numExtraPassages; i++ {
 		// ランダムな壁を選択
-		x := 1 + rand.Intn(mb.width-2)
-		y := 1 + rand.Intn(mb.height-2)
+		x := 1 + rng.Intn(mb.width-2)
+		y := 1 + rng.Intn(mb.height-2)
 
 		// 壁の場合、通路に変更する可能性がある
 		if mb.level.GetTile(x, y).Type == TileWall {
@@ -0,0 +1,15 @@
+package dungeon
+
+import "math/rand"
+
+// rng is the random source used while generating levels
+// 階層ごとにシードで初期化するので、同じシードからは同じ配置の階層が生成される
+var rng = rand.New(rand.NewSource(rand.Int63()))
+
+// NewLevelFromSeed generates a level from a seed and records the seed on it
+func NewLevelFromSeed(width, height, floorNum int, seed int64) *Level {
+	rng.Seed(seed)
+	level := NewLevel(width, height, floorNum)
+	level.Seed = seed
+	return level
+}
@@ -1,8 +1,6 @@
 package dungeon
 
 import (
-	"math/rand"
-
 	"github.com/yuru-sha/gorogue/internal/utils/logger"
 )
 
@@ -102,8 +100,8 @@ func (s *StairsManager) placeStairsInRoom(room *Room, stairType TileType) {
 
 	for attempts := 0; attempts < maxAttempts; attempts++ {
 		// 部屋の境界から1マス内側の範囲でランダムな位置を選択
-		x := room.X + 1 + rand.Intn(room.Width-2)
-		y := room.Y + 1 + rand.Intn(room.Height-2)
+		x := room.X + 1 + rng.Intn(room.Width-2)
+		y := room.Y + 1 + rng.Intn(room.Height-2)
 
 		if s.isValidStairPosition(x, y) {
 			s.level.SetTile(x, y, stairType)
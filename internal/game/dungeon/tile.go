@@ -1,6 +1,9 @@
 package dungeon
 
-import "github.com/anaseto/gruid"
+import (
+	"github.com/anaseto/gruid"
+	"github.com/yuru-sha/gorogue/internal/i18n"
+)
 
 // TileType represents different types of tiles in the dungeon
 type TileType int
@@ -19,19 +22,29 @@ const (
 	TileSecretDoor
 )
 
-// String returns the string representation of a TileType
+// String returns the terrain name in the selected language
 func (t TileType) String() string {
 	switch t {
 	case TileFloor:
-		return "floor"
+		return i18n.T("terrain.floor")
 	case TileWall:
-		return "wall"
+		return i18n.T("terrain.wall")
+	case TileDoor, TileDoorClosed:
+		return i18n.T("terrain.closed_door")
+	case TileDoorOpen, TileOpenDoor:
+		return i18n.T("terrain.open_door")
+	case TileStairsUp:
+		return i18n.T("terrain.stairs_up")
+	case TileStairsDown:
+		return i18n.T("terrain.stairs_down")
+	case TileSecretDoor:
+		return i18n.T("terrain.wall") // 見つかるまでは壁に見える
 	case TileWater:
-		return "water"
+		return i18n.T("terrain.water")
 	case TileLava:
-		return "lava"
+		return i18n.T("terrain.lava")
 	default:
-		return "unknown"
+		return i18n.T("terrain.unknown")
 	}
 }
 
@@ -41,6 +54,7 @@ type Tile struct {
 	Rune       rune
 	Color      gruid.Color
 	Visible    bool
+	Explored   bool // プレイヤーが一度でも見たかどうか
 	IsWalkable bool
 }
 
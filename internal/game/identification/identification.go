@@ -1,10 +1,11 @@
 package identification
 
 import (
-	"fmt"
 	"math/rand"
+	"strings"
 
 	"github.com/yuru-sha/gorogue/internal/game/item"
+	"github.com/yuru-sha/gorogue/internal/i18n"
 	"github.com/yuru-sha/gorogue/internal/utils/logger"
 )
 
@@ -21,6 +22,17 @@ type IdentificationManager struct {
 	potionColors  map[string]string
 	ringMaterials map[string]string
 	wandMaterials map[string]string
+
+	// Player-assigned labels keyed by appearance (e.g. "potion:blue")
+	calledNames map[string]string
+}
+
+// AppearanceTable holds the randomized appearance of each item kind
+type AppearanceTable struct {
+	Scrolls map[string]string
+	Potions map[string]string
+	Rings   map[string]string
+	Wands   map[string]string
 }
 
 // ScrollTitles are random titles for unidentified scrolls
@@ -70,6 +82,7 @@ func NewIdentificationManager() *IdentificationManager {
 		potionColors:      make(map[string]string),
 		ringMaterials:     make(map[string]string),
 		wandMaterials:     make(map[string]string),
+		calledNames:       make(map[string]string),
 	}
 
 	// Initialize random appearances
@@ -153,53 +166,62 @@ func (im *IdentificationManager) GetDisplayName(itm *item.Item) string {
 	switch itm.Type {
 	case item.ItemScroll:
 		if im.IsIdentified(itm) {
-			return fmt.Sprintf("scroll of %s", itm.Name)
+			return i18n.T("item.scroll_of", i18n.Named("item", itm.Name))
+		}
+		if called := im.GetCalledName(itm); called != "" {
+			return i18n.T("item.scroll_called", called)
 		}
 		if title, exists := im.scrollTitles[itm.Name]; exists {
-			return fmt.Sprintf("scroll titled %q", title)
+			return i18n.T("item.scroll_titled", title)
 		}
-		return "scroll titled \"UNKNOWN\""
+		return i18n.T("item.scroll_titled", "UNKNOWN")
 
 	case item.ItemPotion:
 		if im.IsIdentified(itm) {
-			return fmt.Sprintf("potion of %s", itm.Name)
+			return i18n.T("item.potion_of", i18n.Named("item", itm.Name))
+		}
+		if called := im.GetCalledName(itm); called != "" {
+			return i18n.T("item.potion_called", called)
 		}
 		if color, exists := im.potionColors[itm.Name]; exists {
-			return fmt.Sprintf("%s potion", color)
+			return i18n.T("item.potion_colored", i18n.Named("color", color))
 		}
-		return "unknown potion"
+		return i18n.T("item.unknown_potion")
 
 	case item.ItemRing:
 		if im.IsIdentified(itm) {
-			return fmt.Sprintf("ring of %s", itm.Name)
+			return i18n.T("item.ring_of", i18n.Named("item", itm.Name))
+		}
+		if called := im.GetCalledName(itm); called != "" {
+			return i18n.T("item.ring_called", called)
 		}
 		if material, exists := im.ringMaterials[itm.Name]; exists {
-			return fmt.Sprintf("%s ring", material)
+			return i18n.T("item.ring_material", i18n.Named("material", material))
 		}
-		return "unknown ring"
+		return i18n.T("item.unknown_ring")
 
 	case item.ItemWeapon:
 		// Weapons are usually identified
-		return itm.Name
+		return i18n.Named("item", itm.Name)
 
 	case item.ItemArmor:
 		// Armor is usually identified
-		return itm.Name
+		return i18n.Named("item", itm.Name)
 
 	case item.ItemFood:
 		// Food is usually identified
-		return itm.Name
+		return i18n.Named("item", itm.Name)
 
 	case item.ItemGold:
 		// Gold is always identified
-		return fmt.Sprintf("%d gold pieces", itm.Value)
+		return i18n.T("item.gold_pieces", itm.Value)
 
 	case item.ItemAmulet:
 		// The Amulet of Yendor is always identified
-		return itm.Name
+		return i18n.Named("item", itm.Name)
 
 	default:
-		return itm.Name
+		return i18n.Named("item", itm.Name)
 	}
 }
 
@@ -247,3 +269,174 @@ func (im *IdentificationManager) IdentifyByUse(itm *item.Item) {
 func (im *IdentificationManager) GetIdentificationScroll() *item.Item {
 	return item.NewItem(0, 0, item.ItemScroll, "identify", 100)
 }
+
+// appearanceKey returns the key identifying an item's random appearance
+func (im *IdentificationManager) appearanceKey(itm *item.Item) (string, bool) {
+	var kind, appearance string
+	var exists bool
+
+	switch itm.Type {
+	case item.ItemScroll:
+		kind = "scroll"
+		appearance, exists = im.scrollTitles[itm.Name]
+	case item.ItemPotion:
+		kind = "potion"
+		appearance, exists = im.potionColors[itm.Name]
+	case item.ItemRing:
+		kind = "ring"
+		appearance, exists = im.ringMaterials[itm.Name]
+	}
+
+	if !exists {
+		return "", false
+	}
+	return kind + ":" + appearance, true
+}
+
+// CanCall checks if an item kind can be given a player label
+func (im *IdentificationManager) CanCall(itm *item.Item) bool {
+	if im.IsIdentified(itm) {
+		return false
+	}
+	_, ok := im.appearanceKey(itm)
+	return ok
+}
+
+// CallItem assigns a player label to an item's appearance (an empty label clears it)
+func (im *IdentificationManager) CallItem(itm *item.Item, label string) bool {
+	if !im.CanCall(itm) {
+		return false
+	}
+
+	key, _ := im.appearanceKey(itm)
+	label = strings.TrimSpace(label)
+	if label == "" {
+		delete(im.calledNames, key)
+		logger.Debug("Cleared item label", "appearance", key)
+		return true
+	}
+
+	im.calledNames[key] = label
+	logger.Debug("Called item", "appearance", key, "label", label)
+	return true
+}
+
+// GetCalledName returns the player label for an item's appearance
+func (im *IdentificationManager) GetCalledName(itm *item.Item) string {
+	key, ok := im.appearanceKey(itm)
+	if !ok {
+		return ""
+	}
+	return im.calledNames[key]
+}
+
+// GetCalledNames returns a copy of all player labels keyed by appearance
+func (im *IdentificationManager) GetCalledNames() map[string]string {
+	return copyStringMap(im.calledNames)
+}
+
+// SetCalledNames replaces all player labels (used when loading a save)
+func (im *IdentificationManager) SetCalledNames(names map[string]string) {
+	im.calledNames = make(map[string]string, len(names))
+	for key, label := range names {
+		if label != "" {
+			im.calledNames[key] = label
+		}
+	}
+}
+
+// GetAppearances returns a copy of the current appearance tables
+func (im *IdentificationManager) GetAppearances() AppearanceTable {
+	return AppearanceTable{
+		Scrolls: copyStringMap(im.scrollTitles),
+		Potions: copyStringMap(im.potionColors),
+		Rings:   copyStringMap(im.ringMaterials),
+		Wands:   copyStringMap(im.wandMaterials),
+	}
+}
+
+// SetAppearances restores appearance tables (empty tables keep the current ones)
+func (im *IdentificationManager) SetAppearances(table AppearanceTable) {
+	if len(table.Scrolls) > 0 {
+		im.scrollTitles = copyStringMap(table.Scrolls)
+	}
+	if len(table.Potions) > 0 {
+		im.potionColors = copyStringMap(table.Potions)
+	}
+	if len(table.Rings) > 0 {
+		im.ringMaterials = copyStringMap(table.Rings)
+	}
+	if len(table.Wands) > 0 {
+		im.wandMaterials = copyStringMap(table.Wands)
+	}
+
+	logger.Debug("Restored item appearances",
+		"scrolls", len(im.scrollTitles),
+		"potions", len(im.potionColors),
+		"rings", len(im.ringMaterials),
+		"wands", len(im.wandMaterials),
+	)
+}
+
+// GetIdentifiedItems returns identified item kinds keyed by "type:name"
+func (im *IdentificationManager) GetIdentifiedItems() map[string]bool {
+	identified := make(map[string]bool)
+	for name, ok := range im.identifiedScrolls {
+		if ok {
+			identified["scroll:"+name] = true
+		}
+	}
+	for name, ok := range im.identifiedPotions {
+		if ok {
+			identified["potion:"+name] = true
+		}
+	}
+	for name, ok := range im.identifiedRings {
+		if ok {
+			identified["ring:"+name] = true
+		}
+	}
+	for name, ok := range im.identifiedWands {
+		if ok {
+			identified["wand:"+name] = true
+		}
+	}
+	return identified
+}
+
+// SetIdentifiedItems restores identified item kinds keyed by "type:name"
+func (im *IdentificationManager) SetIdentifiedItems(identified map[string]bool) {
+	for key, ok := range identified {
+		if !ok {
+			continue
+		}
+
+		kind, name, found := strings.Cut(key, ":")
+		if !found {
+			logger.Warn("Invalid identified item key", "key", key)
+			continue
+		}
+
+		switch kind {
+		case "scroll":
+			im.identifiedScrolls[name] = true
+		case "potion":
+			im.identifiedPotions[name] = true
+		case "ring":
+			im.identifiedRings[name] = true
+		case "wand":
+			im.identifiedWands[name] = true
+		default:
+			logger.Warn("Unknown identified item kind", "key", key)
+		}
+	}
+}
+
+// copyStringMap returns a shallow copy of a string map
+func copyStringMap(src map[string]string) map[string]string {
+	dst := make(map[string]string, len(src))
+	for k, v := range src {
+		dst[k] = v
+	}
+	return dst
+}
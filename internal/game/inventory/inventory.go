@@ -5,6 +5,7 @@ import (
 
 	"github.com/yuru-sha/gorogue/internal/game/identification"
 	"github.com/yuru-sha/gorogue/internal/game/item"
+	"github.com/yuru-sha/gorogue/internal/i18n"
 	"github.com/yuru-sha/gorogue/internal/utils/logger"
 )
 
@@ -138,11 +139,11 @@ func (inv *Inventory) HasItemType(itemType item.ItemType) bool {
 // GetInventoryListing returns a formatted inventory listing
 func (inv *Inventory) GetInventoryListing(identifyMgr *identification.IdentificationManager) []string {
 	if inv.IsEmpty() {
-		return []string{"Your pack is empty."}
+		return []string{i18n.T("inventory.empty")}
 	}
 
 	listing := make([]string, 0, len(inv.Items)+1)
-	listing = append(listing, "Current inventory:")
+	listing = append(listing, i18n.T("inventory.header"))
 
 	for i, itm := range inv.Items {
 		letter := rune('a' + i)
@@ -155,9 +156,9 @@ func (inv *Inventory) GetInventoryListing(identifyMgr *identification.Identifica
 		} else {
 			// フォールバック：識別マネージャーがない場合
 			if itm.Type == item.ItemGold {
-				line = fmt.Sprintf("%c) %d gold pieces", letter, itm.Value)
+				line = i18n.T("inventory.gold_pieces", letter, itm.Value)
 			} else {
-				line = fmt.Sprintf("%c) %s", letter, itm.Name)
+				line = fmt.Sprintf("%c) %s", letter, i18n.Named("item", itm.Name))
 			}
 		}
 
@@ -232,26 +233,29 @@ func (eq *Equipment) UnequipItem(slot string) *item.Item {
 	return nil
 }
 
-const noneEquipped = "None"
+// noneEquipped returns the name shown for an empty slot
+func noneEquipped() string {
+	return i18n.T("item.none")
+}
 
 // GetEquippedNames returns equipped item names for display
 func (eq *Equipment) GetEquippedNames() (string, string, string, string) {
-	weapon := noneEquipped
-	armor := noneEquipped
-	ringLeft := noneEquipped
-	ringRight := noneEquipped
+	weapon := noneEquipped()
+	armor := noneEquipped()
+	ringLeft := noneEquipped()
+	ringRight := noneEquipped()
 
 	if eq.Weapon != nil {
-		weapon = eq.Weapon.Name
+		weapon = i18n.Named("item", eq.Weapon.Name)
 	}
 	if eq.Armor != nil {
-		armor = eq.Armor.Name
+		armor = i18n.Named("item", eq.Armor.Name)
 	}
 	if eq.RingLeft != nil {
-		ringLeft = eq.RingLeft.Name
+		ringLeft = i18n.Named("item", eq.RingLeft.Name)
 	}
 	if eq.RingRight != nil {
-		ringRight = eq.RingRight.Name
+		ringRight = i18n.Named("item", eq.RingRight.Name)
 	}
 
 	return weapon, armor, ringLeft, ringRight
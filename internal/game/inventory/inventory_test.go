@@ -290,7 +290,7 @@ func TestEquipmentGetEquippedNames(t *testing.T) {
 
 	// 空の装備
 	weapon, armor, ringLeft, ringRight := eq.GetEquippedNames()
-	expected := noneEquipped
+	expected := noneEquipped()
 
 	if weapon != expected || armor != expected || ringLeft != expected || ringRight != expected {
 		t.Errorf("Empty equipment names = (%q, %q, %q, %q), want all %q",
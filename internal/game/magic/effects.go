@@ -1,12 +1,12 @@
 package magic
 
 import (
-	"fmt"
 	"math/rand"
 
 	"github.com/yuru-sha/gorogue/internal/game/actor"
 	"github.com/yuru-sha/gorogue/internal/game/dungeon"
 	"github.com/yuru-sha/gorogue/internal/game/item"
+	"github.com/yuru-sha/gorogue/internal/i18n"
 	"github.com/yuru-sha/gorogue/internal/utils/logger"
 )
 
@@ -46,13 +46,13 @@ func UseScroll(scrollName string, player *actor.Player, level *dungeon.Level) *E
 		return useScrollOfDetection(level, "monster")
 	case "blank paper":
 		return &EffectResult{
-			Message:    "This scroll is blank.",
+			Message:    i18n.T("magic.blank"),
 			Success:    false,
 			Identified: true,
 		}
 	default:
 		return &EffectResult{
-			Message:    "Nothing happens.",
+			Message:    i18n.T("magic.nothing"),
 			Success:    false,
 			Identified: true,
 		}
@@ -86,13 +86,13 @@ func UsePotion(potionName string, player *actor.Player) *EffectResult {
 		return usePotionOfPoison(player)
 	case "thirst quenching":
 		return &EffectResult{
-			Message:    "You feel refreshed.",
+			Message:    i18n.T("magic.refreshed"),
 			Success:    true,
 			Identified: true,
 		}
 	default:
 		return &EffectResult{
-			Message:    "Nothing happens.",
+			Message:    i18n.T("magic.nothing"),
 			Success:    false,
 			Identified: true,
 		}
@@ -103,7 +103,7 @@ func UsePotion(potionName string, player *actor.Player) *EffectResult {
 func useScrollOfIdentify(player *actor.Player) *EffectResult {
 	// TODO: Implement item selection for identification
 	return &EffectResult{
-		Message:    "You feel enlightened. (TODO: Select item to identify)",
+		Message:    i18n.T("magic.identify"),
 		Success:    true,
 		Identified: true,
 	}
@@ -122,7 +122,7 @@ func useScrollOfTeleportation(player *actor.Player, level *dungeon.Level) *Effec
 			player.Position.Y = y
 			logger.Debug("Player teleported", "x", x, "y", y)
 			return &EffectResult{
-				Message:    "You suddenly find yourself somewhere else!",
+				Message:    i18n.T("magic.teleported"),
 				Success:    true,
 				Identified: true,
 			}
@@ -130,7 +130,7 @@ func useScrollOfTeleportation(player *actor.Player, level *dungeon.Level) *Effec
 	}
 
 	return &EffectResult{
-		Message:    "The scroll crumbles to dust.",
+		Message:    i18n.T("magic.crumbles"),
 		Success:    false,
 		Identified: true,
 	}
@@ -148,14 +148,14 @@ func useScrollOfSleep(level *dungeon.Level) *EffectResult {
 
 	if sleepCount > 0 {
 		return &EffectResult{
-			Message:    fmt.Sprintf("You hear %d monster(s) yawn.", sleepCount),
+			Message:    i18n.T("magic.monsters_yawn", sleepCount),
 			Success:    true,
 			Identified: true,
 		}
 	}
 
 	return &EffectResult{
-		Message:    "You hear a faint snoring sound.",
+		Message:    i18n.T("magic.snoring"),
 		Success:    true,
 		Identified: true,
 	}
@@ -166,14 +166,14 @@ func useScrollOfEnchantArmor(player *actor.Player) *EffectResult {
 	if player.Equipment.Armor != nil {
 		player.Equipment.Armor.Value += 10
 		return &EffectResult{
-			Message:    "Your armor glows momentarily.",
+			Message:    i18n.T("magic.armor_glows"),
 			Success:    true,
 			Identified: true,
 		}
 	}
 
 	return &EffectResult{
-		Message:    "You are not wearing any armor.",
+		Message:    i18n.T("magic.no_armor"),
 		Success:    false,
 		Identified: true,
 	}
@@ -184,14 +184,14 @@ func useScrollOfEnchantWeapon(player *actor.Player) *EffectResult {
 	if player.Equipment.Weapon != nil {
 		player.Equipment.Weapon.Value += 10
 		return &EffectResult{
-			Message:    "Your weapon glows momentarily.",
+			Message:    i18n.T("magic.weapon_glows"),
 			Success:    true,
 			Identified: true,
 		}
 	}
 
 	return &EffectResult{
-		Message:    "You are not wielding any weapon.",
+		Message:    i18n.T("magic.no_weapon"),
 		Success:    false,
 		Identified: true,
 	}
@@ -229,14 +229,14 @@ func useScrollOfRemoveCurse(player *actor.Player) *EffectResult {
 
 	if cursedCount > 0 {
 		return &EffectResult{
-			Message:    "You feel as if someone is watching over you.",
+			Message:    i18n.T("magic.watched_over"),
 			Success:    true,
 			Identified: true,
 		}
 	}
 
 	return &EffectResult{
-		Message:    "You feel like someone is watching over you.",
+		Message:    i18n.T("magic.watching"),
 		Success:    true,
 		Identified: true,
 	}
@@ -246,7 +246,7 @@ func useScrollOfRemoveCurse(player *actor.Player) *EffectResult {
 func useScrollOfMagicMapping(level *dungeon.Level) *EffectResult {
 	// TODO: Implement magic mapping effect
 	return &EffectResult{
-		Message:    "You see the layout of the dungeon flash before your eyes.",
+		Message:    i18n.T("magic.mapping"),
 		Success:    true,
 		Identified: true,
 	}
@@ -256,7 +256,7 @@ func useScrollOfMagicMapping(level *dungeon.Level) *EffectResult {
 func useScrollOfLight(level *dungeon.Level) *EffectResult {
 	// TODO: Implement light effect
 	return &EffectResult{
-		Message:    "The dungeon is lit up.",
+		Message:    i18n.T("magic.light"),
 		Success:    true,
 		Identified: true,
 	}
@@ -295,14 +295,14 @@ func useScrollOfDetection(level *dungeon.Level, detectType string) *EffectResult
 
 	if count > 0 {
 		return &EffectResult{
-			Message:    fmt.Sprintf("You sense %d %s(s) on this level.", count, detectType),
+			Message:    i18n.T("magic.sense."+detectType, count),
 			Success:    true,
 			Identified: true,
 		}
 	}
 
 	return &EffectResult{
-		Message:    fmt.Sprintf("You sense no %ss on this level.", detectType),
+		Message:    i18n.T("magic.sense_none." + detectType),
 		Success:    true,
 		Identified: true,
 	}
@@ -316,14 +316,14 @@ func usePotionOfHealing(player *actor.Player, amount int) *EffectResult {
 
 	if healedAmount > 0 {
 		return &EffectResult{
-			Message:    fmt.Sprintf("You feel better. (%d HP restored)", healedAmount),
+			Message:    i18n.T("magic.healed", healedAmount),
 			Success:    true,
 			Identified: true,
 		}
 	}
 
 	return &EffectResult{
-		Message:    "Nothing happens.",
+		Message:    i18n.T("magic.nothing"),
 		Success:    false,
 		Identified: true,
 	}
@@ -333,7 +333,7 @@ func usePotionOfHealing(player *actor.Player, amount int) *EffectResult {
 func usePotionOfHaste(player *actor.Player) *EffectResult {
 	// TODO: Implement haste effect
 	return &EffectResult{
-		Message:    "You feel yourself moving much faster.",
+		Message:    i18n.T("magic.haste"),
 		Success:    true,
 		Identified: true,
 	}
@@ -343,7 +343,7 @@ func usePotionOfHaste(player *actor.Player) *EffectResult {
 func usePotionOfRestoreStrength(player *actor.Player) *EffectResult {
 	// TODO: Implement strength restoration
 	return &EffectResult{
-		Message:    "You feel your strength returning.",
+		Message:    i18n.T("magic.strength_returns"),
 		Success:    true,
 		Identified: true,
 	}
@@ -353,7 +353,7 @@ func usePotionOfRestoreStrength(player *actor.Player) *EffectResult {
 func usePotionOfGainStrength(player *actor.Player) *EffectResult {
 	player.Attack += 2
 	return &EffectResult{
-		Message:    "You feel stronger!",
+		Message:    i18n.T("magic.stronger"),
 		Success:    true,
 		Identified: true,
 	}
@@ -364,7 +364,7 @@ func usePotionOfGainExperience(player *actor.Player) *EffectResult {
 	expGain := 100 + rand.Intn(200)
 	player.GainExp(expGain)
 	return &EffectResult{
-		Message:    fmt.Sprintf("You feel more experienced! (%d exp)", expGain),
+		Message:    i18n.T("magic.experienced", expGain),
 		Success:    true,
 		Identified: true,
 	}
@@ -374,7 +374,7 @@ func usePotionOfGainExperience(player *actor.Player) *EffectResult {
 func usePotionOfSeeInvisible(player *actor.Player) *EffectResult {
 	// TODO: Implement see invisible effect
 	return &EffectResult{
-		Message:    "Your eyes tingle.",
+		Message:    i18n.T("magic.eyes_tingle"),
 		Success:    true,
 		Identified: true,
 	}
@@ -384,7 +384,7 @@ func usePotionOfSeeInvisible(player *actor.Player) *EffectResult {
 func usePotionOfBlindness(player *actor.Player) *EffectResult {
 	// TODO: Implement blindness effect
 	return &EffectResult{
-		Message:    "A cloak of darkness falls around you.",
+		Message:    i18n.T("magic.darkness"),
 		Success:    true,
 		Identified: true,
 	}
@@ -394,7 +394,7 @@ func usePotionOfBlindness(player *actor.Player) *EffectResult {
 func usePotionOfParalysis(player *actor.Player) *EffectResult {
 	// TODO: Implement paralysis effect
 	return &EffectResult{
-		Message:    "You can't move!",
+		Message:    i18n.T("magic.paralyzed"),
 		Success:    true,
 		Identified: true,
 	}
@@ -404,7 +404,7 @@ func usePotionOfParalysis(player *actor.Player) *EffectResult {
 func usePotionOfConfusion(player *actor.Player) *EffectResult {
 	// TODO: Implement confusion effect
 	return &EffectResult{
-		Message:    "Wait, what's going on here? Huh? What? Who?",
+		Message:    i18n.T("magic.confused"),
 		Success:    true,
 		Identified: true,
 	}
@@ -413,9 +413,9 @@ func usePotionOfConfusion(player *actor.Player) *EffectResult {
 // usePotionOfPoison poisons the player
 func usePotionOfPoison(player *actor.Player) *EffectResult {
 	damage := 3 + rand.Intn(5)
-	player.TakeDamage(damage)
+	player.TakeDamageFrom(damage, actor.DeathPoison)
 	return &EffectResult{
-		Message:    fmt.Sprintf("You feel very sick. (%d damage)", damage),
+		Message:    i18n.T("magic.sick", damage),
 		Success:    true,
 		Identified: true,
 	}
@@ -0,0 +1,402 @@
+// Package morgue キャラクターダンプ（モルグファイル）
+// ゲーム終了時のステータス・所持品・識別情報・統計・マップを ~/.gorogue/morgue/ にテキストで書き出す
+package morgue
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/yuru-sha/gorogue/internal/game/actor"
+	"github.com/yuru-sha/gorogue/internal/game/dungeon"
+	"github.com/yuru-sha/gorogue/internal/game/item"
+	"github.com/yuru-sha/gorogue/internal/game/save"
+	"github.com/yuru-sha/gorogue/internal/utils/logger"
+)
+
+const (
+	// MorgueDirName はモルグファイルのディレクトリ名
+	MorgueDirName = "morgue"
+
+	// DefaultMessageCount はダンプに含めるメッセージ数
+	DefaultMessageCount = 20
+)
+
+// Report holds everything written to a morgue file
+type Report struct {
+	CharName  string
+	Player    *actor.Player
+	Level     *dungeon.Level
+	Floor     int
+	Stats     *save.GameStats // nil の場合は統計を省略
+	GameInfo  save.GameInfo
+	Messages  []string
+	KilledBy  string
+	IsVictory bool
+	Score     int // 0 の場合は省略
+	Time      time.Time
+}
+
+// GetMorgueDir returns the default morgue directory (~/.gorogue/morgue)
+func GetMorgueDir() string {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		homeDir = "."
+	}
+	return filepath.Join(homeDir, ".gorogue", MorgueDirName)
+}
+
+// Write renders the report into a new file in dir and returns its path
+func Write(dir string, r *Report) (string, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", fmt.Errorf("failed to create morgue directory: %w", err)
+	}
+
+	path := filepath.Join(dir, r.FileName())
+	if err := os.WriteFile(path, []byte(r.Render()), 0644); err != nil {
+		return "", fmt.Errorf("failed to write morgue file: %w", err)
+	}
+
+	logger.Info("Morgue file written", "path", path, "victory", r.IsVictory)
+	return path, nil
+}
+
+// FileName returns the morgue file name, e.g. Rogue-20250101-120000.txt
+func (r *Report) FileName() string {
+	name := strings.Map(func(c rune) rune {
+		if c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-' || c == '_' {
+			return c
+		}
+		return '_'
+	}, r.characterName())
+	return fmt.Sprintf("%s-%s.txt", name, r.timestamp().Format("20060102-150405"))
+}
+
+// Render returns the plain-text character dump
+func (r *Report) Render() string {
+	var sb strings.Builder
+
+	r.writeHeader(&sb)
+	r.writeCharacter(&sb)
+	r.writeEquipment(&sb)
+	r.writeInventory(&sb)
+	r.writeDiscoveries(&sb)
+	r.writeStatistics(&sb)
+	r.writeMessages(&sb)
+	r.writeMap(&sb)
+
+	return sb.String()
+}
+
+// characterName returns the character name with a fallback
+func (r *Report) characterName() string {
+	if r.CharName != "" {
+		return r.CharName
+	}
+	if r.GameInfo.CharName != "" {
+		return r.GameInfo.CharName
+	}
+	return "Rogue"
+}
+
+// timestamp returns the report time, defaulting to now
+func (r *Report) timestamp() time.Time {
+	if r.Time.IsZero() {
+		return time.Now()
+	}
+	return r.Time
+}
+
+// outcome describes how the game ended
+func (r *Report) outcome() string {
+	switch {
+	case r.IsVictory:
+		return "Escaped the Dungeons of Doom with the Amulet of Yendor"
+	case r.KilledBy != "":
+		return fmt.Sprintf("Killed by %s on floor %d", actor.DeathCauseName(r.KilledBy), r.Floor)
+	case r.Player != nil && !r.Player.IsAlive():
+		return fmt.Sprintf("Died on floor %d", r.Floor)
+	default:
+		return fmt.Sprintf("Still alive on floor %d", r.Floor)
+	}
+}
+
+func (r *Report) writeHeader(sb *strings.Builder) {
+	fmt.Fprintf(sb, "GoRogue character dump - %s\n", r.timestamp().Format("2006-01-02 15:04:05"))
+	sb.WriteString(strings.Repeat("=", 60) + "\n\n")
+	fmt.Fprintf(sb, "%s: %s\n", r.characterName(), r.outcome())
+	if r.Score > 0 {
+		fmt.Fprintf(sb, "Final score: %d\n", r.Score)
+	}
+	if r.GameInfo.Seed != 0 {
+		fmt.Fprintf(sb, "Seed: %d\n", r.GameInfo.Seed)
+	}
+	if r.GameInfo.IsWizard {
+		sb.WriteString("Wizard mode was enabled\n")
+	}
+	sb.WriteString("\n")
+}
+
+func (r *Report) writeCharacter(sb *strings.Builder) {
+	writeSection(sb, "Character")
+	p := r.Player
+	if p == nil {
+		sb.WriteString("  (no character)\n\n")
+		return
+	}
+
+	if p.Class != "" {
+		fmt.Fprintf(sb, "  Class %s\n", p.Class)
+	}
+	fmt.Fprintf(sb, "  Level %d  Exp %d\n", p.Level, p.Exp)
+	fmt.Fprintf(sb, "  HP %d/%d  Attack %d  Defense %d\n", p.HP, p.MaxHP, p.Attack, p.Defense)
+	fmt.Fprintf(sb, "  Gold %d  Hunger %d\n", p.Gold, p.Hunger)
+	fmt.Fprintf(sb, "  Floor %d  Turns %d\n", r.Floor, r.GameInfo.TurnCount)
+	sb.WriteString("\n")
+}
+
+func (r *Report) writeEquipment(sb *strings.Builder) {
+	writeSection(sb, "Equipment")
+	if r.Player == nil || r.Player.Equipment == nil {
+		sb.WriteString("  (none)\n\n")
+		return
+	}
+
+	eq := r.Player.Equipment
+	slots := []struct {
+		label string
+		item  *item.Item
+	}{
+		{"Weapon", eq.Weapon},
+		{"Armor", eq.Armor},
+		{"Left ring", eq.RingLeft},
+		{"Right ring", eq.RingRight},
+	}
+	for _, slot := range slots {
+		name := "(none)"
+		if slot.item != nil {
+			name = r.describeItem(slot.item)
+		}
+		fmt.Fprintf(sb, "  %-11s %s\n", slot.label+":", name)
+	}
+	sb.WriteString("\n")
+}
+
+func (r *Report) writeInventory(sb *strings.Builder) {
+	writeSection(sb, "Inventory")
+	if r.Player == nil || r.Player.Inventory == nil || r.Player.Inventory.IsEmpty() {
+		sb.WriteString("  (empty)\n\n")
+		return
+	}
+
+	for i, itm := range r.Player.Inventory.Items {
+		fmt.Fprintf(sb, "  %c) %s\n", rune('a'+i), r.describeItem(itm))
+	}
+	sb.WriteString("\n")
+}
+
+// describeItem returns the true name, plus the name the player knew it by if different
+func (r *Report) describeItem(itm *item.Item) string {
+	trueName := itm.RealName
+	if trueName == "" {
+		trueName = itm.Name
+	}
+	if itm.Type == item.ItemGold {
+		return fmt.Sprintf("%d gold pieces", itm.Value)
+	}
+
+	text := trueName
+	if itm.Quantity > 1 {
+		text = fmt.Sprintf("%d x %s", itm.Quantity, trueName)
+	}
+	if itm.IsCursed {
+		text += " (cursed)"
+	} else if itm.IsBlessed {
+		text += " (blessed)"
+	}
+
+	if r.Player.IdentifyMgr != nil && !r.Player.IdentifyMgr.IsIdentified(itm) {
+		text += fmt.Sprintf(" [known as %s]", r.Player.IdentifyMgr.GetDisplayName(itm))
+	}
+	return text
+}
+
+func (r *Report) writeDiscoveries(sb *strings.Builder) {
+	writeSection(sb, "Discoveries")
+	if r.Player == nil || r.Player.IdentifyMgr == nil {
+		sb.WriteString("  (none)\n\n")
+		return
+	}
+
+	identified := r.Player.IdentifyMgr.GetIdentifiedItems()
+	called := r.Player.IdentifyMgr.GetCalledNames()
+	if len(identified) == 0 && len(called) == 0 {
+		sb.WriteString("  (none)\n\n")
+		return
+	}
+
+	for _, key := range sortedKeys(identified) {
+		kind, name, _ := strings.Cut(key, ":")
+		fmt.Fprintf(sb, "  %-7s %s\n", kind, name)
+	}
+	for _, key := range sortedKeys(called) {
+		fmt.Fprintf(sb, "  called  %s = %q\n", key, called[key])
+	}
+	sb.WriteString("\n")
+}
+
+func (r *Report) writeStatistics(sb *strings.Builder) {
+	writeSection(sb, "Statistics")
+	if r.Stats == nil {
+		sb.WriteString("  (not recorded)\n\n")
+		return
+	}
+
+	// Export の値を JSON 経由で汎用マップに揃えてから出力する
+	data, err := json.Marshal(r.Stats.Export())
+	if err != nil {
+		fmt.Fprintf(sb, "  (failed to export statistics: %v)\n\n", err)
+		return
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var export map[string]interface{}
+	if err := decoder.Decode(&export); err != nil {
+		fmt.Fprintf(sb, "  (failed to export statistics: %v)\n\n", err)
+		return
+	}
+
+	// summary は stats と、timestamp はヘッダーと重複するので省略
+	delete(export, "summary")
+	delete(export, "timestamp")
+	writeValue(sb, export, 1)
+	sb.WriteString("\n")
+}
+
+func (r *Report) writeMessages(sb *strings.Builder) {
+	writeSection(sb, "Last messages")
+	if len(r.Messages) == 0 {
+		sb.WriteString("  (none)\n\n")
+		return
+	}
+
+	messages := r.Messages
+	if len(messages) > DefaultMessageCount {
+		messages = messages[len(messages)-DefaultMessageCount:]
+	}
+	for _, msg := range messages {
+		fmt.Fprintf(sb, "  %s\n", msg)
+	}
+	sb.WriteString("\n")
+}
+
+func (r *Report) writeMap(sb *strings.Builder) {
+	writeSection(sb, fmt.Sprintf("Floor %d", r.Floor))
+	if r.Level == nil || len(r.Level.Tiles) == 0 {
+		sb.WriteString("  (no map)\n")
+		return
+	}
+
+	for _, line := range Snapshot(r.Level, r.Player) {
+		sb.WriteString(strings.TrimRight(line, " ") + "\n")
+	}
+}
+
+// Snapshot returns the level as ASCII lines with items, monsters and the player
+func Snapshot(level *dungeon.Level, player *actor.Player) []string {
+	rows := make([][]rune, level.Height)
+	for y := 0; y < level.Height; y++ {
+		rows[y] = make([]rune, level.Width)
+		for x := 0; x < level.Width; x++ {
+			rows[y][x] = ' '
+			// デバッグ用の不完全なレベルでも落ちないよう行の長さを確認する
+			if y >= len(level.Tiles) || x >= len(level.Tiles[y]) {
+				continue
+			}
+			tile := level.Tiles[y][x]
+			if tile != nil && (tile.Explored || tile.Visible) && tile.Rune != 0 {
+				rows[y][x] = tile.Rune
+			}
+		}
+	}
+
+	set := func(x, y int, r rune) {
+		if level.IsInBounds(x, y) {
+			rows[y][x] = r
+		}
+	}
+	for _, itm := range level.Items {
+		set(itm.Position.X, itm.Position.Y, itm.Symbol)
+	}
+	for _, monster := range level.Monsters {
+		if monster.IsAlive() {
+			set(monster.Position.X, monster.Position.Y, monster.Type.Symbol)
+		}
+	}
+	if player != nil {
+		set(player.Position.X, player.Position.Y, player.Symbol)
+	}
+
+	lines := make([]string, len(rows))
+	for i, row := range rows {
+		lines[i] = string(row)
+	}
+	return lines
+}
+
+// writeSection writes a section title
+func writeSection(sb *strings.Builder, title string) {
+	fmt.Fprintf(sb, "%s\n%s\n", title, strings.Repeat("-", len(title)))
+}
+
+// writeValue writes nested export values with sorted keys
+func writeValue(sb *strings.Builder, value interface{}, depth int) {
+	indent := strings.Repeat("  ", depth)
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for _, key := range sortedKeys(v) {
+			switch child := v[key].(type) {
+			case map[string]interface{}:
+				if len(child) == 0 {
+					fmt.Fprintf(sb, "%s%s: (none)\n", indent, key)
+					continue
+				}
+				fmt.Fprintf(sb, "%s%s:\n", indent, key)
+				writeValue(sb, child, depth+1)
+			case string:
+				if child == "" {
+					child = "-"
+				}
+				fmt.Fprintf(sb, "%s%s: %s\n", indent, key, child)
+			case []interface{}:
+				if len(child) == 0 {
+					fmt.Fprintf(sb, "%s%s: (none)\n", indent, key)
+					continue
+				}
+				fmt.Fprintf(sb, "%s%s:\n", indent, key)
+				for _, elem := range child {
+					fmt.Fprintf(sb, "%s  - %v\n", indent, elem)
+				}
+			default:
+				fmt.Fprintf(sb, "%s%s: %v\n", indent, key, child)
+			}
+		}
+	default:
+		fmt.Fprintf(sb, "%s%v\n", indent, v)
+	}
+}
+
+// sortedKeys returns map keys in sorted order
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,101 @@
+package morgue
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/yuru-sha/gorogue/internal/game/actor"
+	"github.com/yuru-sha/gorogue/internal/game/dungeon"
+	"github.com/yuru-sha/gorogue/internal/game/item"
+	"github.com/yuru-sha/gorogue/internal/game/save"
+	"github.com/yuru-sha/gorogue/internal/utils/logger"
+)
+
+func init() {
+	// テスト用のログ初期化
+	logger.Setup()
+}
+
+// createTestReport creates a report for a player killed on a small floor
+func createTestReport() *Report {
+	player := actor.NewPlayer(2, 1)
+	player.Gold = 42
+	player.Inventory.AddItem(item.NewItem(0, 0, item.ItemPotion, "Healing", 0))
+	player.Equipment.Weapon = item.NewItem(0, 0, item.ItemWeapon, "Mace", 0)
+
+	level := &dungeon.Level{Width: 5, Height: 3, FloorNumber: 3}
+	level.Tiles = make([][]*dungeon.Tile, level.Height)
+	for y := range level.Tiles {
+		level.Tiles[y] = make([]*dungeon.Tile, level.Width)
+		for x := range level.Tiles[y] {
+			level.Tiles[y][x] = dungeon.NewTile(dungeon.TileFloor)
+		}
+	}
+
+	return &Report{
+		CharName: "Tester",
+		Player:   player,
+		Level:    level,
+		Floor:    3,
+		Stats:    save.NewGameStats(),
+		Messages: []string{"The kobold hits you.", "You die..."},
+		KilledBy: "kobold",
+		Score:    123,
+		Time:     time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+}
+
+func TestReportRender(t *testing.T) {
+	text := createTestReport().Render()
+
+	expected := []string{
+		"Tester: Killed by kobold on floor 3",
+		"Final score: 123",
+		"Weapon:     Mace",
+		"a) Healing",
+		"Statistics",
+		"You die...",
+		"..@..",
+	}
+	for _, want := range expected {
+		if !strings.Contains(text, want) {
+			t.Errorf("Morgue text missing %q:\n%s", want, text)
+		}
+	}
+}
+
+func TestReportRender_Victory(t *testing.T) {
+	report := createTestReport()
+	report.IsVictory = true
+	report.KilledBy = ""
+
+	if !strings.Contains(report.Render(), "Escaped the Dungeons of Doom") {
+		t.Error("Victory report should describe the escape")
+	}
+}
+
+func TestWrite(t *testing.T) {
+	dir := t.TempDir()
+	report := createTestReport()
+	report.CharName = "Sir Test/1"
+
+	path, err := Write(dir, report)
+	if err != nil {
+		t.Fatalf("Write failed: %v", err)
+	}
+
+	if filepath.Base(path) != "Sir_Test_1-20250102-030405.txt" {
+		t.Errorf("Unexpected morgue file name: %s", filepath.Base(path))
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Failed to read morgue file: %v", err)
+	}
+	if !strings.Contains(string(data), "Killed by kobold") {
+		t.Error("Morgue file does not contain the killer")
+	}
+}
@@ -0,0 +1,302 @@
+// Package save 実績システム
+// GameStats が受け取るイベントから実績の解除を判定し、~/.gorogue/achievements.json に保存する
+package save
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/yuru-sha/gorogue/internal/game/actor"
+	"github.com/yuru-sha/gorogue/internal/i18n"
+	"github.com/yuru-sha/gorogue/internal/utils/logger"
+)
+
+const (
+	// AchievementsFileName は実績ファイル名
+	AchievementsFileName = "achievements.json"
+
+	// AchievementsVersion は実績ファイルのバージョン
+	AchievementsVersion = "1.0.0"
+)
+
+// AchievementContext is passed to achievement conditions
+type AchievementContext struct {
+	Event  StatsEvent
+	Stats  Stats
+	Player *actor.Player // nil の場合がある
+}
+
+// Achievement is an unlockable goal
+type Achievement struct {
+	ID          string
+	Name        string
+	Description string
+	Check       func(ctx AchievementContext) bool
+}
+
+// DisplayName returns the achievement name in the selected language
+func (a Achievement) DisplayName() string {
+	if id := "achievement." + a.ID; i18n.Has(id) {
+		return i18n.T(id)
+	}
+	return a.Name
+}
+
+// DisplayDescription returns the achievement description in the selected language
+func (a Achievement) DisplayDescription() string {
+	if id := "achievement." + a.ID + ".desc"; i18n.Has(id) {
+		return i18n.T(id)
+	}
+	return a.Description
+}
+
+// achievementList is the display order of all achievements
+var achievementList = []Achievement{
+	{
+		ID:          "first_blood",
+		Name:        "First Blood",
+		Description: "Kill your first monster",
+		Check: func(ctx AchievementContext) bool {
+			return ctx.Event.Type == EventMonsterKilled
+		},
+	},
+	{
+		ID:          "dragon_slayer",
+		Name:        "Dragon Slayer",
+		Description: "Kill a dragon",
+		Check: func(ctx AchievementContext) bool {
+			return ctx.Event.Type == EventMonsterKilled && ctx.Event.Monster != nil && ctx.Event.Monster.Type.Symbol == 'D'
+		},
+	},
+	{
+		ID:          "exterminator",
+		Name:        "Exterminator",
+		Description: "Kill 100 monsters in a single game",
+		Check: func(ctx AchievementContext) bool {
+			return ctx.Event.Type == EventMonsterKilled && ctx.Stats.MonstersKilled >= 100
+		},
+	},
+	{
+		ID:          "deep_diver",
+		Name:        "Deep Diver",
+		Description: "Reach floor 10",
+		Check: func(ctx AchievementContext) bool {
+			return ctx.Event.Type == EventFloorChange && ctx.Event.Floor >= 10
+		},
+	},
+	{
+		ID:          "skinny_dipper",
+		Name:        "Skinny Dipper",
+		Description: "Reach floor 10 without wearing armor",
+		Check: func(ctx AchievementContext) bool {
+			// 途中で脱いでも着たことがあれば解除しない
+			return ctx.Event.Type == EventFloorChange && ctx.Event.Floor >= 10 && !ctx.Stats.ArmorWorn &&
+				ctx.Player != nil && ctx.Player.Equipment.Armor == nil
+		},
+	},
+	{
+		ID:          "rock_bottom",
+		Name:        "Rock Bottom",
+		Description: "Reach the deepest floor",
+		Check: func(ctx AchievementContext) bool {
+			return ctx.Event.Type == EventFloorChange && ctx.Event.Floor >= 26
+		},
+	},
+	{
+		ID:          "veteran",
+		Name:        "Veteran",
+		Description: "Reach experience level 10",
+		Check: func(ctx AchievementContext) bool {
+			return ctx.Event.Type == EventLevelUp && ctx.Event.Level >= 10
+		},
+	},
+	{
+		ID:          "hoarder",
+		Name:        "Hoarder",
+		Description: "Collect 1000 gold in a single game",
+		Check: func(ctx AchievementContext) bool {
+			return ctx.Event.Type == EventGoldCollected && ctx.Stats.GoldCollected >= 1000
+		},
+	},
+	{
+		ID:          "amulet_bearer",
+		Name:        "Amulet Bearer",
+		Description: "Pick up the Amulet of Yendor",
+		Check: func(ctx AchievementContext) bool {
+			return ctx.Event.Type == EventAmuletFound
+		},
+	},
+	{
+		ID:          "champion",
+		Name:        "Champion",
+		Description: "Escape the dungeon with the Amulet of Yendor",
+		Check: func(ctx AchievementContext) bool {
+			return ctx.Event.Type == EventVictory
+		},
+	},
+	{
+		ID:          "illiterate",
+		Name:        "Illiterate",
+		Description: "Win without reading a scroll",
+		Check: func(ctx AchievementContext) bool {
+			return ctx.Event.Type == EventVictory && ctx.Stats.ScrollsRead == 0
+		},
+	},
+	{
+		ID:          "teetotaler",
+		Name:        "Teetotaler",
+		Description: "Win without drinking a potion",
+		Check: func(ctx AchievementContext) bool {
+			return ctx.Event.Type == EventVictory && ctx.Stats.PotionsQuaffed == 0
+		},
+	},
+}
+
+// GetAchievements returns all achievements in display order
+func GetAchievements() []Achievement {
+	list := make([]Achievement, len(achievementList))
+	copy(list, achievementList)
+	return list
+}
+
+// UnlockedAchievement records when an achievement was unlocked
+type UnlockedAchievement struct {
+	UnlockedAt time.Time `json:"unlocked_at"`
+	CharName   string    `json:"char_name"`
+}
+
+// AchievementFile is the persisted achievement progress
+type AchievementFile struct {
+	Version  string                         `json:"version"`
+	Updated  time.Time                      `json:"updated"`
+	Unlocked map[string]UnlockedAchievement `json:"unlocked"`
+}
+
+// AchievementTracker unlocks achievements from game events
+type AchievementTracker struct {
+	filePath string
+	unlocked map[string]UnlockedAchievement
+	player   *actor.Player
+	charName string
+	onUnlock func(achievement Achievement)
+}
+
+// NewAchievementTracker creates a tracker for ~/.gorogue/achievements.json
+func NewAchievementTracker() *AchievementTracker {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		homeDir = "."
+	}
+	return &AchievementTracker{
+		filePath: filepath.Join(homeDir, ".gorogue", AchievementsFileName),
+		unlocked: make(map[string]UnlockedAchievement),
+	}
+}
+
+// Load reads unlocked achievements (nothing is unlocked when the file does not exist)
+func (at *AchievementTracker) Load() error {
+	data, err := os.ReadFile(at.filePath)
+	if os.IsNotExist(err) {
+		at.unlocked = make(map[string]UnlockedAchievement)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read achievements: %w", err)
+	}
+
+	var file AchievementFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return fmt.Errorf("failed to parse achievements: %w", err)
+	}
+
+	at.unlocked = file.Unlocked
+	if at.unlocked == nil {
+		at.unlocked = make(map[string]UnlockedAchievement)
+	}
+	return nil
+}
+
+// SetPlayer sets the character whose events are tracked
+func (at *AchievementTracker) SetPlayer(player *actor.Player, charName string) {
+	at.player = player
+	at.charName = charName
+}
+
+// SetOnUnlock sets the callback invoked when an achievement is unlocked
+func (at *AchievementTracker) SetOnUnlock(onUnlock func(achievement Achievement)) {
+	at.onUnlock = onUnlock
+}
+
+// IsUnlocked reports whether an achievement has been unlocked
+func (at *AchievementTracker) IsUnlocked(id string) bool {
+	_, exists := at.unlocked[id]
+	return exists
+}
+
+// GetUnlocked returns the unlock record of an achievement
+func (at *AchievementTracker) GetUnlocked(id string) (UnlockedAchievement, bool) {
+	record, exists := at.unlocked[id]
+	return record, exists
+}
+
+// UnlockedCount returns the number of unlocked achievements
+func (at *AchievementTracker) UnlockedCount() int {
+	return len(at.unlocked)
+}
+
+// HandleEvent checks all locked achievements against an event (StatsListener)
+func (at *AchievementTracker) HandleEvent(event StatsEvent, stats Stats) {
+	ctx := AchievementContext{Event: event, Stats: stats, Player: at.player}
+
+	newlyUnlocked := make([]Achievement, 0)
+	for _, achievement := range achievementList {
+		if at.IsUnlocked(achievement.ID) || !achievement.Check(ctx) {
+			continue
+		}
+		at.unlocked[achievement.ID] = UnlockedAchievement{
+			UnlockedAt: time.Now(),
+			CharName:   at.charName,
+		}
+		newlyUnlocked = append(newlyUnlocked, achievement)
+	}
+
+	if len(newlyUnlocked) == 0 {
+		return
+	}
+
+	if err := at.save(); err != nil {
+		logger.Error("Failed to save achievements", "error", err)
+	}
+
+	for _, achievement := range newlyUnlocked {
+		logger.Info("Achievement unlocked", "id", achievement.ID, "char_name", at.charName)
+		if at.onUnlock != nil {
+			at.onUnlock(achievement)
+		}
+	}
+}
+
+// save writes the unlocked achievements
+func (at *AchievementTracker) save() error {
+	if err := os.MkdirAll(filepath.Dir(at.filePath), 0755); err != nil {
+		return fmt.Errorf("failed to create achievements directory: %w", err)
+	}
+
+	file := AchievementFile{
+		Version:  AchievementsVersion,
+		Updated:  time.Now(),
+		Unlocked: at.unlocked,
+	}
+	data, err := json.MarshalIndent(file, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal achievements: %w", err)
+	}
+
+	if err := os.WriteFile(at.filePath, data, 0644); err != nil {
+		return fmt.Errorf("failed to write achievements: %w", err)
+	}
+	return nil
+}
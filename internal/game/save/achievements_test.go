@@ -0,0 +1,148 @@
+// Package save 実績システムのテスト
+package save
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/yuru-sha/gorogue/internal/game/actor"
+	"github.com/yuru-sha/gorogue/internal/utils/logger"
+)
+
+// newTestAchievementTracker creates a tracker writing to a temporary file
+func newTestAchievementTracker(t *testing.T) *AchievementTracker {
+	t.Helper()
+	logger.Setup()
+
+	tracker := NewAchievementTracker()
+	tracker.filePath = filepath.Join(t.TempDir(), AchievementsFileName)
+	return tracker
+}
+
+// TestAchievementTracker_GameEvents tests unlocking achievements through GameStats
+func TestAchievementTracker_GameEvents(t *testing.T) {
+	tracker := newTestAchievementTracker(t)
+	player := actor.NewPlayer(0, 0)
+	tracker.SetPlayer(player, "Tester")
+
+	var notified []string
+	tracker.SetOnUnlock(func(achievement Achievement) {
+		notified = append(notified, achievement.ID)
+	})
+
+	gs := NewGameStats()
+	gs.SetListener(tracker.HandleEvent)
+
+	gs.OnMonsterKilled(actor.NewMonster(0, 0, 'D'))
+	if !tracker.IsUnlocked("first_blood") || !tracker.IsUnlocked("dragon_slayer") {
+		t.Errorf("Expected kill achievements, got %v", notified)
+	}
+
+	// 鎧なしで10階に到達
+	player.Equipment.Armor = nil
+	gs.OnFloorChange(10)
+	if !tracker.IsUnlocked("deep_diver") || !tracker.IsUnlocked("skinny_dipper") {
+		t.Errorf("Expected floor achievements, got %v", notified)
+	}
+
+	// 巻物を読んでから勝利すると Illiterate は解除されない
+	gs.OnScrollRead("Magic Mapping")
+	gs.OnPlayerVictory()
+	if !tracker.IsUnlocked("champion") || !tracker.IsUnlocked("teetotaler") {
+		t.Errorf("Expected victory achievements, got %v", notified)
+	}
+	if tracker.IsUnlocked("illiterate") {
+		t.Error("Illiterate should stay locked after reading a scroll")
+	}
+
+	// 同じ実績は二度通知しない
+	count := len(notified)
+	gs.OnMonsterKilled(actor.NewMonster(0, 0, 'D'))
+	if len(notified) != count {
+		t.Errorf("Expected no new notifications, got %v", notified[count:])
+	}
+
+	record, _ := tracker.GetUnlocked("champion")
+	if record.CharName != "Tester" {
+		t.Errorf("Expected char name Tester, got %q", record.CharName)
+	}
+}
+
+// TestAchievementTracker_SkinnyDipper tests that armor worn at any point keeps Skinny Dipper locked
+func TestAchievementTracker_SkinnyDipper(t *testing.T) {
+	tests := []struct {
+		name      string
+		wornArmor bool
+		expected  bool
+	}{
+		{"never wore armor", false, true},
+		{"took armor off before floor 10", true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tracker := newTestAchievementTracker(t)
+			player := actor.NewPlayer(0, 0)
+			tracker.SetPlayer(player, "Tester")
+
+			gs := NewGameStats()
+			gs.SetListener(tracker.HandleEvent)
+			if tt.wornArmor {
+				gs.OnArmorWorn()
+			}
+
+			// 10階に着いたときは鎧を着ていない
+			player.Equipment.Armor = nil
+			gs.OnFloorChange(10)
+			if got := tracker.IsUnlocked("skinny_dipper"); got != tt.expected {
+				t.Errorf("Expected skinny_dipper unlocked=%v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+// TestAchievementTracker_Persistence tests saving and loading unlocked achievements
+func TestAchievementTracker_Persistence(t *testing.T) {
+	tracker := newTestAchievementTracker(t)
+
+	// ファイルがなければ何も解除されていない
+	if err := tracker.Load(); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if tracker.UnlockedCount() != 0 {
+		t.Errorf("Expected no unlocked achievements, got %d", tracker.UnlockedCount())
+	}
+
+	tracker.HandleEvent(StatsEvent{Type: EventAmuletFound}, Stats{})
+
+	reloaded := NewAchievementTracker()
+	reloaded.filePath = tracker.filePath
+	if err := reloaded.Load(); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if !reloaded.IsUnlocked("amulet_bearer") || reloaded.UnlockedCount() != 1 {
+		t.Errorf("Expected only amulet_bearer to be unlocked, got %d", reloaded.UnlockedCount())
+	}
+}
+
+// TestAchievementTracker_WizardGame tests that a wizard mode game unlocks nothing
+func TestAchievementTracker_WizardGame(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	tracker := newTestAchievementTracker(t)
+
+	sgi := NewSaveGameIntegration()
+	sgi.achievements = tracker
+	tracker.SetPlayer(actor.NewPlayer(0, 0), "Cheater")
+
+	sgi.MarkWizardGame()
+	sgi.OnMonsterKilled(actor.NewMonster(0, 0, 'D'))
+	sgi.OnFloorChange(26)
+	sgi.OnPlayerVictory()
+
+	if !sgi.GetGameInfo().IsWizard {
+		t.Error("The wizard mark should stay on the game")
+	}
+	if tracker.UnlockedCount() != 0 {
+		t.Errorf("Expected no achievements in a wizard mode game, got %d", tracker.UnlockedCount())
+	}
+}
@@ -0,0 +1,192 @@
+// Package save モンスター図鑑
+// 戦ったモンスターについて分かったことを ~/.gorogue/bestiary.json に記録し、プレイをまたいで引き継ぐ
+package save
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/yuru-sha/gorogue/internal/game/actor"
+)
+
+const (
+	// BestiaryFileName はモンスター図鑑のファイル名
+	BestiaryFileName = "bestiary.json"
+
+	// BestiaryVersion はモンスター図鑑ファイルのバージョン
+	BestiaryVersion = "1.0.0"
+)
+
+// legacyAbilityIDs maps the English ability names of older bestiary files to effect ids
+var legacyAbilityIDs = map[string]string{
+	"steals gold":  actor.EffectStealGold.ID(),
+	"steals items": actor.EffectStealItem.ID(),
+}
+
+// MonsterRecord is what the player has learned about a monster type
+type MonsterRecord struct {
+	Encounters  int      `json:"encounters"`   // 攻撃した・された回数
+	MinHP       int      `json:"min_hp"`       // 観察した最大HPの最小値
+	MaxHP       int      `json:"max_hp"`       // 観察した最大HPの最大値
+	Hits        int      `json:"hits"`         // プレイヤーが受けた攻撃の回数
+	MinDamage   int      `json:"min_damage"`   // 1回の攻撃で受けた最小ダメージ
+	MaxDamage   int      `json:"max_damage"`   // 1回の攻撃で受けた最大ダメージ
+	TotalDamage int      `json:"total_damage"` // 受けたダメージの合計
+	Abilities   []string `json:"abilities"`    // 見たことのある特殊攻撃
+	Kills       int      `json:"kills"`
+}
+
+// BestiaryFile is the persisted monster recall
+type BestiaryFile struct {
+	Version  string                    `json:"version"`
+	Updated  time.Time                 `json:"updated"`
+	Monsters map[string]*MonsterRecord `json:"monsters"` // モンスターの記号がキー
+}
+
+// Bestiary records monster knowledge from encounters
+type Bestiary struct {
+	filePath string
+	monsters map[string]*MonsterRecord
+	dirty    bool
+}
+
+// NewBestiary creates a bestiary for ~/.gorogue/bestiary.json
+func NewBestiary() *Bestiary {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		homeDir = "."
+	}
+	return &Bestiary{
+		filePath: filepath.Join(homeDir, ".gorogue", BestiaryFileName),
+		monsters: make(map[string]*MonsterRecord),
+	}
+}
+
+// Load reads the bestiary (empty when the file does not exist)
+func (b *Bestiary) Load() error {
+	data, err := os.ReadFile(b.filePath)
+	if os.IsNotExist(err) {
+		b.monsters = make(map[string]*MonsterRecord)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read bestiary: %w", err)
+	}
+
+	var file BestiaryFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return fmt.Errorf("failed to parse bestiary: %w", err)
+	}
+
+	b.monsters = file.Monsters
+	if b.monsters == nil {
+		b.monsters = make(map[string]*MonsterRecord)
+	}
+	for _, record := range b.monsters {
+		for i, ability := range record.Abilities {
+			if id, legacy := legacyAbilityIDs[ability]; legacy {
+				record.Abilities[i] = id
+			}
+		}
+	}
+	b.dirty = false
+	return nil
+}
+
+// Save writes the bestiary when something new was learned
+func (b *Bestiary) Save() error {
+	if !b.dirty {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(b.filePath), 0755); err != nil {
+		return fmt.Errorf("failed to create bestiary directory: %w", err)
+	}
+
+	file := BestiaryFile{
+		Version:  BestiaryVersion,
+		Updated:  time.Now(),
+		Monsters: b.monsters,
+	}
+	data, err := json.MarshalIndent(file, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal bestiary: %w", err)
+	}
+
+	if err := os.WriteFile(b.filePath, data, 0644); err != nil {
+		return fmt.Errorf("failed to write bestiary: %w", err)
+	}
+	b.dirty = false
+	return nil
+}
+
+// Record returns what is known about a monster type
+func (b *Bestiary) Record(symbol rune) (MonsterRecord, bool) {
+	record, exists := b.monsters[string(symbol)]
+	if !exists {
+		return MonsterRecord{}, false
+	}
+	return *record, true
+}
+
+// KnownCount returns the number of monster types encountered
+func (b *Bestiary) KnownCount() int {
+	return len(b.monsters)
+}
+
+// OnMonsterAttacked records a monster the player attacked
+func (b *Bestiary) OnMonsterAttacked(monster *actor.Monster) {
+	b.encounter(monster).Encounters++
+}
+
+// OnPlayerHit records an attack of a monster on the player
+func (b *Bestiary) OnPlayerHit(monster *actor.Monster, damage int, effect actor.SpecialEffect) {
+	record := b.encounter(monster)
+	record.Encounters++
+	if record.Hits == 0 || damage < record.MinDamage {
+		record.MinDamage = damage
+	}
+	if damage > record.MaxDamage {
+		record.MaxDamage = damage
+	}
+	record.Hits++
+	record.TotalDamage += damage
+
+	if effect != actor.EffectNone {
+		ability := effect.ID()
+		for _, known := range record.Abilities {
+			if known == ability {
+				return
+			}
+		}
+		record.Abilities = append(record.Abilities, ability)
+		sort.Strings(record.Abilities)
+	}
+}
+
+// OnMonsterKilled records a kill
+func (b *Bestiary) OnMonsterKilled(monster *actor.Monster) {
+	b.encounter(monster).Kills++
+}
+
+// encounter returns the record of a monster type, observing the monster's HP
+func (b *Bestiary) encounter(monster *actor.Monster) *MonsterRecord {
+	key := string(monster.Type.Symbol)
+	record, exists := b.monsters[key]
+	if !exists {
+		record = &MonsterRecord{MinHP: monster.MaxHP, MaxHP: monster.MaxHP, Abilities: make([]string, 0)}
+		b.monsters[key] = record
+	}
+
+	if monster.MaxHP < record.MinHP {
+		record.MinHP = monster.MaxHP
+	}
+	if monster.MaxHP > record.MaxHP {
+		record.MaxHP = monster.MaxHP
+	}
+	b.dirty = true
+	return record
+}
@@ -0,0 +1,112 @@
+// Package save モンスター図鑑のテスト
+package save
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/yuru-sha/gorogue/internal/game/actor"
+	"github.com/yuru-sha/gorogue/internal/utils/logger"
+)
+
+// newTestBestiary creates a bestiary writing to a temporary file
+func newTestBestiary(t *testing.T) *Bestiary {
+	t.Helper()
+	logger.Setup()
+
+	bestiary := NewBestiary()
+	bestiary.filePath = filepath.Join(t.TempDir(), BestiaryFileName)
+	return bestiary
+}
+
+// TestBestiary_RecordsEncounters tests filling in a record from fights
+func TestBestiary_RecordsEncounters(t *testing.T) {
+	bestiary := newTestBestiary(t)
+	monster := actor.NewMonster(0, 0, 'R')
+
+	if _, known := bestiary.Record('R'); known {
+		t.Fatal("Expected an unknown monster before any encounter")
+	}
+
+	bestiary.OnMonsterAttacked(monster)
+	bestiary.OnPlayerHit(monster, 3, actor.EffectNone)
+	bestiary.OnPlayerHit(monster, 5, actor.EffectPoison)
+	bestiary.OnPlayerHit(monster, 2, actor.EffectPoison)
+	bestiary.OnMonsterKilled(monster)
+
+	record, known := bestiary.Record('R')
+	if !known {
+		t.Fatal("Expected the monster to be known")
+	}
+	if record.MinHP != monster.MaxHP || record.MaxHP != monster.MaxHP {
+		t.Errorf("Expected HP %d, got %d-%d", monster.MaxHP, record.MinHP, record.MaxHP)
+	}
+	if record.Hits != 3 || record.MinDamage != 2 || record.MaxDamage != 5 || record.TotalDamage != 10 {
+		t.Errorf("Unexpected damage record: %+v", record)
+	}
+	if len(record.Abilities) != 1 || record.Abilities[0] != "poison" {
+		t.Errorf("Expected poison to be recorded once, got %v", record.Abilities)
+	}
+	if record.Kills != 1 || record.Encounters != 4 {
+		t.Errorf("Expected 1 kill in 4 encounters, got %d kills in %d", record.Kills, record.Encounters)
+	}
+}
+
+// TestBestiary_Persistence tests that the recall survives across runs
+func TestBestiary_Persistence(t *testing.T) {
+	bestiary := newTestBestiary(t)
+	bestiary.OnMonsterKilled(actor.NewMonster(0, 0, 'K'))
+	if err := bestiary.Save(); err != nil {
+		t.Fatalf("Failed to save bestiary: %v", err)
+	}
+
+	loaded := NewBestiary()
+	loaded.filePath = bestiary.filePath
+	if err := loaded.Load(); err != nil {
+		t.Fatalf("Failed to load bestiary: %v", err)
+	}
+	record, known := loaded.Record('K')
+	if !known || record.Kills != 1 {
+		t.Errorf("Expected the kill to persist, got %+v (known=%v)", record, known)
+	}
+	if loaded.KnownCount() != 1 {
+		t.Errorf("Expected 1 known monster, got %d", loaded.KnownCount())
+	}
+}
+
+// TestBestiary_LegacyAbilities tests that English ability names of older files load as effect ids
+func TestBestiary_LegacyAbilities(t *testing.T) {
+	bestiary := newTestBestiary(t)
+	data := `{"version":"1.0.0","monsters":{"L":{"encounters":1,"abilities":["steals gold"]},"N":{"encounters":1,"abilities":["steals items"]}}}`
+	if err := os.WriteFile(bestiary.filePath, []byte(data), 0644); err != nil {
+		t.Fatalf("Failed to write bestiary: %v", err)
+	}
+	if err := bestiary.Load(); err != nil {
+		t.Fatalf("Failed to load bestiary: %v", err)
+	}
+
+	for symbol, want := range map[rune]actor.SpecialEffect{'L': actor.EffectStealGold, 'N': actor.EffectStealItem} {
+		record, _ := bestiary.Record(symbol)
+		if len(record.Abilities) != 1 || record.Abilities[0] != want.ID() {
+			t.Errorf("Expected %c to know %q, got %v", symbol, want.ID(), record.Abilities)
+		}
+	}
+}
+
+// TestBestiary_SavedOnAutoSave tests that the auto-save hook runs at every auto-save point
+func TestBestiary_SavedOnAutoSave(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	logger.Setup()
+
+	sgi := NewSaveGameIntegration()
+	sgi.settings.AutoSave = false
+	calls := 0
+	sgi.SetOnAutoSave(func() { calls++ })
+
+	// 自動セーブが無効でも階段では図鑑を保存する
+	sgi.OnFloorChange(2)
+	if calls != 1 {
+		t.Errorf("Expected the hook to run on a floor change, got %d calls", calls)
+	}
+}
@@ -10,10 +10,38 @@ import (
 	"github.com/yuru-sha/gorogue/internal/utils/logger"
 )
 
+// StatsEventType identifies a game event received by GameStats
+type StatsEventType int
+
+const (
+	EventMonsterKilled StatsEventType = iota
+	EventItemUsed
+	EventGoldCollected
+	EventFloorChange
+	EventSecretFound
+	EventAmuletFound
+	EventLevelUp
+	EventVictory
+	EventDeath
+)
+
+// StatsEvent describes a game event and its details
+type StatsEvent struct {
+	Type     StatsEventType
+	Monster  *actor.Monster // EventMonsterKilled
+	ItemName string         // EventItemUsed
+	Floor    int            // EventFloorChange, EventDeath
+	Level    int            // EventLevelUp
+}
+
+// StatsListener is notified after GameStats has applied an event
+type StatsListener func(event StatsEvent, stats Stats)
+
 // GameStats manages game statistics and metrics
 type GameStats struct {
 	startTime time.Time
 	stats     Stats
+	listener  StatsListener
 }
 
 // NewGameStats creates a new game statistics manager
@@ -31,6 +59,18 @@ func (gs *GameStats) Reset() {
 	logger.Debug("Game statistics reset")
 }
 
+// SetListener sets the listener notified of game events (e.g. achievements)
+func (gs *GameStats) SetListener(listener StatsListener) {
+	gs.listener = listener
+}
+
+// emit notifies the listener of an event
+func (gs *GameStats) emit(event StatsEvent) {
+	if gs.listener != nil {
+		gs.listener(event, gs.stats)
+	}
+}
+
 // GetStats returns the current statistics
 func (gs *GameStats) GetStats() Stats {
 	return gs.stats
@@ -65,6 +105,10 @@ func (gs *GameStats) OnTurnEnd() {
 // OnMonsterKilled handles monster death
 func (gs *GameStats) OnMonsterKilled(monster *actor.Monster) {
 	gs.stats.MonstersKilled++
+	if gs.stats.MonsterKills == nil {
+		gs.stats.MonsterKills = make(map[string]int)
+	}
+	gs.stats.MonsterKills[monster.Type.Name]++
 
 	// Add to damage dealt (assuming monster's max HP as damage)
 	gs.stats.DamageDealt += monster.MaxHP
@@ -73,6 +117,8 @@ func (gs *GameStats) OnMonsterKilled(monster *actor.Monster) {
 		"monster", monster.Type.Name,
 		"total_killed", gs.stats.MonstersKilled,
 	)
+
+	gs.emit(StatsEvent{Type: EventMonsterKilled, Monster: monster})
 }
 
 // OnItemFound handles item discovery
@@ -88,11 +134,34 @@ func (gs *GameStats) OnItemFound(itemName string) {
 // OnItemUsed handles item usage
 func (gs *GameStats) OnItemUsed(itemName string) {
 	gs.stats.ItemsUsed++
+	if gs.stats.ItemUses == nil {
+		gs.stats.ItemUses = make(map[string]int)
+	}
+	gs.stats.ItemUses[itemName]++
 
 	logger.Debug("Item used",
 		"item", itemName,
 		"total_used", gs.stats.ItemsUsed,
 	)
+
+	gs.emit(StatsEvent{Type: EventItemUsed, ItemName: itemName})
+}
+
+// OnScrollRead handles reading a scroll
+func (gs *GameStats) OnScrollRead(itemName string) {
+	gs.stats.ScrollsRead++
+	gs.OnItemUsed(itemName)
+}
+
+// OnPotionQuaffed handles drinking a potion
+func (gs *GameStats) OnPotionQuaffed(itemName string) {
+	gs.stats.PotionsQuaffed++
+	gs.OnItemUsed(itemName)
+}
+
+// OnArmorWorn records that the player has worn armor during this game
+func (gs *GameStats) OnArmorWorn() {
+	gs.stats.ArmorWorn = true
 }
 
 // OnItemIdentified handles item identification
@@ -123,6 +192,7 @@ func (gs *GameStats) OnHealing(amount int) {
 // OnGoldCollected handles gold collection
 func (gs *GameStats) OnGoldCollected(amount int) {
 	gs.stats.GoldCollected += amount
+	gs.emit(StatsEvent{Type: EventGoldCollected})
 }
 
 // OnFloorChange handles floor change
@@ -137,6 +207,8 @@ func (gs *GameStats) OnFloorChange(newFloor int) {
 		"floor", newFloor,
 		"deepest", gs.stats.DeepestFloor,
 	)
+
+	gs.emit(StatsEvent{Type: EventFloorChange, Floor: newFloor})
 }
 
 // OnRoomEntered handles room entry
@@ -152,6 +224,7 @@ func (gs *GameStats) OnTileExplored() {
 // OnSecretFound handles secret discovery
 func (gs *GameStats) OnSecretFound() {
 	gs.stats.SecretsFound++
+	gs.emit(StatsEvent{Type: EventSecretFound})
 }
 
 // OnTrapTriggered handles trap triggering
@@ -163,12 +236,16 @@ func (gs *GameStats) OnTrapTriggered() {
 func (gs *GameStats) OnAmuletFound() {
 	gs.stats.AmuletFound = true
 	logger.Info("Amulet of Yendor found!")
+
+	gs.emit(StatsEvent{Type: EventAmuletFound})
 }
 
 // OnPlayerVictory handles player victory
 func (gs *GameStats) OnPlayerVictory() {
 	gs.stats.EscapedWithAmulet = true
 	logger.Info("Player achieved victory!")
+
+	gs.emit(StatsEvent{Type: EventVictory})
 }
 
 // OnPlayerDeath handles player death
@@ -182,6 +259,8 @@ func (gs *GameStats) OnPlayerDeath(reason string, floor int) {
 		"floor", floor,
 		"death_count", gs.stats.DeathCount,
 	)
+
+	gs.emit(StatsEvent{Type: EventDeath, Floor: floor})
 }
 
 // OnLevelUp handles level up
@@ -194,6 +273,8 @@ func (gs *GameStats) OnLevelUp(newLevel int) {
 		"level", newLevel,
 		"highest", gs.stats.HighestLevel,
 	)
+
+	gs.emit(StatsEvent{Type: EventLevelUp, Level: newLevel})
 }
 
 // GetSummary returns a formatted summary of statistics
@@ -0,0 +1,283 @@
+// Package save 通算統計の管理
+// 全プレイの GameStats を ~/.gorogue/lifetime_stats.json に集計し、CSV に書き出す
+package save
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/yuru-sha/gorogue/internal/game/actor"
+	"github.com/yuru-sha/gorogue/internal/utils/logger"
+)
+
+const (
+	// LifetimeStatsFileName は通算統計ファイル名
+	LifetimeStatsFileName = "lifetime_stats.json"
+
+	// LifetimeStatsVersion は通算統計ファイルのバージョン
+	LifetimeStatsVersion = "1.0.0"
+)
+
+// LifetimeStats aggregates statistics over all finished games
+type LifetimeStats struct {
+	Version        string         `json:"version"`
+	Updated        time.Time      `json:"updated"`
+	TotalGames     int            `json:"total_games"`
+	Victories      int            `json:"victories"`
+	Deaths         int            `json:"deaths"`
+	DeathsByCause  map[string]int `json:"deaths_by_cause"`
+	KillsByMonster map[string]int `json:"kills_by_monster"`
+	ItemUses       map[string]int `json:"item_uses"`
+	DeepestFloor   int            `json:"deepest_floor"`
+	HighestLevel   int            `json:"highest_level"`
+	TotalTurns     int            `json:"total_turns"`
+	TotalFloors    int            `json:"total_floors"` // 各プレイの最深階層の合計
+	TotalPlayTime  int64          `json:"total_play_time"`
+	MonstersKilled int            `json:"monsters_killed"`
+	GoldCollected  int            `json:"gold_collected"`
+}
+
+// NamedCount is a name with its count, used for rankings
+type NamedCount struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+// NewLifetimeStats creates empty lifetime statistics
+func NewLifetimeStats() *LifetimeStats {
+	return &LifetimeStats{
+		Version:        LifetimeStatsVersion,
+		DeathsByCause:  make(map[string]int),
+		KillsByMonster: make(map[string]int),
+		ItemUses:       make(map[string]int),
+	}
+}
+
+// AddGame adds the statistics of one finished game
+func (ls *LifetimeStats) AddGame(stats Stats, gameInfo GameInfo, isVictory bool, killedBy string) {
+	ls.TotalGames++
+	if isVictory {
+		ls.Victories++
+	} else {
+		ls.Deaths++
+		if killedBy == "" {
+			killedBy = actor.DeathUnknown
+		}
+		ls.DeathsByCause[killedBy]++
+	}
+
+	for name, count := range stats.MonsterKills {
+		ls.KillsByMonster[name] += count
+	}
+	for name, count := range stats.ItemUses {
+		ls.ItemUses[name] += count
+	}
+
+	if stats.DeepestFloor > ls.DeepestFloor {
+		ls.DeepestFloor = stats.DeepestFloor
+	}
+	if stats.HighestLevel > ls.HighestLevel {
+		ls.HighestLevel = stats.HighestLevel
+	}
+
+	turns := stats.TurnCount
+	if gameInfo.TurnCount > turns {
+		turns = gameInfo.TurnCount
+	}
+	ls.TotalTurns += turns
+	ls.TotalFloors += stats.DeepestFloor
+	ls.TotalPlayTime += gameInfo.PlayTime
+	ls.MonstersKilled += stats.MonstersKilled
+	ls.GoldCollected += stats.GoldCollected
+}
+
+// AverageTurnsPerFloor returns the average number of turns spent per floor
+func (ls *LifetimeStats) AverageTurnsPerFloor() float64 {
+	if ls.TotalFloors == 0 {
+		return 0
+	}
+	return float64(ls.TotalTurns) / float64(ls.TotalFloors)
+}
+
+// WinRate returns the share of games won (0-1)
+func (ls *LifetimeStats) WinRate() float64 {
+	if ls.TotalGames == 0 {
+		return 0
+	}
+	return float64(ls.Victories) / float64(ls.TotalGames)
+}
+
+// TopDeathCauses returns the most common causes of death
+func (ls *LifetimeStats) TopDeathCauses(limit int) []NamedCount {
+	return rankCounts(ls.DeathsByCause, limit)
+}
+
+// DeathCauseNames translates the causes of death in ranking entries for display
+func DeathCauseNames(entries []NamedCount) []NamedCount {
+	named := make([]NamedCount, len(entries))
+	for i, entry := range entries {
+		named[i] = NamedCount{Name: actor.DeathCauseName(entry.Name), Count: entry.Count}
+	}
+	return named
+}
+
+// TopMonsters returns the most killed monster types
+func (ls *LifetimeStats) TopMonsters(limit int) []NamedCount {
+	return rankCounts(ls.KillsByMonster, limit)
+}
+
+// FavoriteItems returns the most used items
+func (ls *LifetimeStats) FavoriteItems(limit int) []NamedCount {
+	return rankCounts(ls.ItemUses, limit)
+}
+
+// WriteCSV writes the statistics as section,name,value rows
+func (ls *LifetimeStats) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+
+	rows := [][]string{
+		{"section", "name", "value"},
+		{"summary", "total_games", strconv.Itoa(ls.TotalGames)},
+		{"summary", "victories", strconv.Itoa(ls.Victories)},
+		{"summary", "deaths", strconv.Itoa(ls.Deaths)},
+		{"summary", "deepest_floor", strconv.Itoa(ls.DeepestFloor)},
+		{"summary", "highest_level", strconv.Itoa(ls.HighestLevel)},
+		{"summary", "total_turns", strconv.Itoa(ls.TotalTurns)},
+		{"summary", "average_turns_per_floor", strconv.FormatFloat(ls.AverageTurnsPerFloor(), 'f', 1, 64)},
+		{"summary", "total_play_time", strconv.FormatInt(ls.TotalPlayTime, 10)},
+		{"summary", "monsters_killed", strconv.Itoa(ls.MonstersKilled)},
+		{"summary", "gold_collected", strconv.Itoa(ls.GoldCollected)},
+	}
+	for _, entry := range ls.TopDeathCauses(0) {
+		rows = append(rows, []string{"deaths_by_cause", entry.Name, strconv.Itoa(entry.Count)})
+	}
+	for _, entry := range ls.TopMonsters(0) {
+		rows = append(rows, []string{"kills_by_monster", entry.Name, strconv.Itoa(entry.Count)})
+	}
+	for _, entry := range ls.FavoriteItems(0) {
+		rows = append(rows, []string{"item_uses", entry.Name, strconv.Itoa(entry.Count)})
+	}
+
+	if err := writer.WriteAll(rows); err != nil {
+		return fmt.Errorf("failed to write lifetime stats csv: %w", err)
+	}
+	return nil
+}
+
+// rankCounts sorts counts in descending order (ties by name); limit 0 means all
+func rankCounts(counts map[string]int, limit int) []NamedCount {
+	ranked := make([]NamedCount, 0, len(counts))
+	for name, count := range counts {
+		ranked = append(ranked, NamedCount{Name: name, Count: count})
+	}
+	sort.Slice(ranked, func(i, j int) bool {
+		if ranked[i].Count != ranked[j].Count {
+			return ranked[i].Count > ranked[j].Count
+		}
+		return ranked[i].Name < ranked[j].Name
+	})
+	if limit > 0 && len(ranked) > limit {
+		ranked = ranked[:limit]
+	}
+	return ranked
+}
+
+// LifetimeStatsManager reads and updates the lifetime statistics file
+type LifetimeStatsManager struct {
+	filePath string
+}
+
+// NewLifetimeStatsManager creates a manager for ~/.gorogue/lifetime_stats.json
+func NewLifetimeStatsManager() *LifetimeStatsManager {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		homeDir = "."
+	}
+	return &LifetimeStatsManager{
+		filePath: filepath.Join(homeDir, ".gorogue", LifetimeStatsFileName),
+	}
+}
+
+// GetFilePath returns the lifetime statistics file path
+func (lm *LifetimeStatsManager) GetFilePath() string {
+	return lm.filePath
+}
+
+// Load reads the lifetime statistics (empty statistics when the file does not exist)
+func (lm *LifetimeStatsManager) Load() (*LifetimeStats, error) {
+	data, err := os.ReadFile(lm.filePath)
+	if os.IsNotExist(err) {
+		return NewLifetimeStats(), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read lifetime stats: %w", err)
+	}
+
+	stats := NewLifetimeStats()
+	if err := json.Unmarshal(data, stats); err != nil {
+		return nil, fmt.Errorf("failed to parse lifetime stats: %w", err)
+	}
+
+	// 古いファイルや手で編集されたファイルでも nil マップにしない
+	if stats.DeathsByCause == nil {
+		stats.DeathsByCause = make(map[string]int)
+	}
+	if stats.KillsByMonster == nil {
+		stats.KillsByMonster = make(map[string]int)
+	}
+	if stats.ItemUses == nil {
+		stats.ItemUses = make(map[string]int)
+	}
+	return stats, nil
+}
+
+// RecordGame adds a finished game to the lifetime statistics file
+// ウィザードモードのゲームは数えず、今の統計をそのまま返す
+func (lm *LifetimeStatsManager) RecordGame(stats Stats, gameInfo GameInfo, isVictory bool, killedBy string) (*LifetimeStats, error) {
+	lifetime, err := lm.Load()
+	if err != nil {
+		return nil, err
+	}
+	if gameInfo.IsWizard {
+		logger.Info("Wizard mode game is not added to lifetime stats")
+		return lifetime, nil
+	}
+
+	lifetime.AddGame(stats, gameInfo, isVictory, killedBy)
+	lifetime.Version = LifetimeStatsVersion
+	lifetime.Updated = time.Now()
+
+	if err := lm.write(lifetime); err != nil {
+		return nil, err
+	}
+
+	logger.Info("Lifetime stats updated",
+		"total_games", lifetime.TotalGames,
+		"victories", lifetime.Victories,
+	)
+	return lifetime, nil
+}
+
+// write saves the lifetime statistics file
+func (lm *LifetimeStatsManager) write(lifetime *LifetimeStats) error {
+	if err := os.MkdirAll(filepath.Dir(lm.filePath), 0755); err != nil {
+		return fmt.Errorf("failed to create lifetime stats directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(lifetime, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal lifetime stats: %w", err)
+	}
+
+	if err := os.WriteFile(lm.filePath, data, 0644); err != nil {
+		return fmt.Errorf("failed to write lifetime stats: %w", err)
+	}
+	return nil
+}
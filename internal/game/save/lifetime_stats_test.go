@@ -0,0 +1,129 @@
+// Package save 通算統計のテスト
+package save
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/yuru-sha/gorogue/internal/game/actor"
+	"github.com/yuru-sha/gorogue/internal/i18n"
+	"github.com/yuru-sha/gorogue/internal/utils/logger"
+)
+
+// TestLifetimeStats_AddGame tests aggregating several games
+func TestLifetimeStats_AddGame(t *testing.T) {
+	lifetime := NewLifetimeStats()
+
+	lifetime.AddGame(Stats{
+		MonstersKilled: 3,
+		MonsterKills:   map[string]int{"kobold": 2, "bat": 1},
+		ItemUses:       map[string]int{"Healing": 2},
+		DeepestFloor:   4,
+		TurnCount:      400,
+	}, GameInfo{PlayTime: 60}, false, "kobold")
+	lifetime.AddGame(Stats{
+		MonsterKills: map[string]int{"kobold": 1},
+		ItemUses:     map[string]int{"Healing": 1, "Magic Mapping": 3},
+		DeepestFloor: 26,
+		TurnCount:    2600,
+	}, GameInfo{PlayTime: 600}, true, "")
+
+	if lifetime.TotalGames != 2 || lifetime.Victories != 1 || lifetime.Deaths != 1 {
+		t.Errorf("Unexpected totals: %+v", lifetime)
+	}
+	if lifetime.DeathsByCause["kobold"] != 1 {
+		t.Errorf("Expected one death by kobold, got %v", lifetime.DeathsByCause)
+	}
+	if lifetime.KillsByMonster["kobold"] != 3 {
+		t.Errorf("Expected 3 kobold kills, got %d", lifetime.KillsByMonster["kobold"])
+	}
+	if lifetime.DeepestFloor != 26 {
+		t.Errorf("Expected deepest floor 26, got %d", lifetime.DeepestFloor)
+	}
+	if avg := lifetime.AverageTurnsPerFloor(); avg != 100 {
+		t.Errorf("Expected 100 turns per floor, got %f", avg)
+	}
+
+	favorites := lifetime.FavoriteItems(1)
+	if len(favorites) != 1 || favorites[0].Name != "Healing" || favorites[0].Count != 3 {
+		t.Errorf("Unexpected favorite items: %v", favorites)
+	}
+}
+
+// TestDeathCauseNames tests that causes are counted by id and translated only for display
+func TestDeathCauseNames(t *testing.T) {
+	defer i18n.SetLanguage(i18n.CurrentLanguage())
+	i18n.SetLanguage(i18n.English)
+
+	lifetime := NewLifetimeStats()
+	orc := actor.MonsterTypes['O'].Name
+	lifetime.AddGame(Stats{}, GameInfo{}, false, orc)
+	lifetime.AddGame(Stats{}, GameInfo{}, false, orc)
+	lifetime.AddGame(Stats{}, GameInfo{}, false, actor.DeathStarvation)
+
+	if lifetime.DeathsByCause[orc] != 2 || lifetime.DeathsByCause[actor.DeathStarvation] != 1 {
+		t.Fatalf("Expected deaths counted by cause id, got %v", lifetime.DeathsByCause)
+	}
+
+	named := DeathCauseNames(lifetime.TopDeathCauses(0))
+	if len(named) != 2 || named[0].Name != "orc" || named[0].Count != 2 || named[1].Name != "starvation" {
+		t.Errorf("Unexpected translated causes: %v", named)
+	}
+}
+
+// TestLifetimeStatsManager_RecordGame tests persisting games and exporting CSV
+func TestLifetimeStatsManager_RecordGame(t *testing.T) {
+	logger.Setup()
+
+	lm := NewLifetimeStatsManager()
+	lm.filePath = filepath.Join(t.TempDir(), LifetimeStatsFileName)
+
+	// ファイルがなければ空の統計
+	empty, err := lm.Load()
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if empty.TotalGames != 0 {
+		t.Errorf("Expected empty stats, got %d games", empty.TotalGames)
+	}
+
+	stats := Stats{MonsterKills: map[string]int{"troll": 1}, DeepestFloor: 10, TurnCount: 500}
+	for i := 0; i < 2; i++ {
+		if _, err := lm.RecordGame(stats, GameInfo{}, false, "troll"); err != nil {
+			t.Fatalf("RecordGame failed: %v", err)
+		}
+	}
+
+	loaded, err := lm.Load()
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if loaded.TotalGames != 2 || loaded.DeathsByCause["troll"] != 2 {
+		t.Errorf("Unexpected loaded stats: %+v", loaded)
+	}
+
+	// ウィザードモードのゲームは数えない
+	wizard, err := lm.RecordGame(stats, GameInfo{IsWizard: true}, true, "")
+	if err != nil {
+		t.Fatalf("RecordGame failed: %v", err)
+	}
+	if wizard.TotalGames != 2 || wizard.Victories != 0 {
+		t.Errorf("Wizard mode game should not be recorded: %+v", wizard)
+	}
+	if loaded, err = lm.Load(); err != nil || loaded.TotalGames != 2 {
+		t.Errorf("Wizard mode game should not be written, got %+v (%v)", loaded, err)
+	}
+
+	var buf bytes.Buffer
+	if err := loaded.WriteCSV(&buf); err != nil {
+		t.Fatalf("WriteCSV failed: %v", err)
+	}
+	csvText := buf.String()
+	for _, want := range []string{"section,name,value", "summary,total_games,2", "deaths_by_cause,troll,2", "kills_by_monster,troll,2"} {
+		if !strings.Contains(csvText, want) {
+			t.Errorf("CSV missing %q:\n%s", want, csvText)
+		}
+	}
+}
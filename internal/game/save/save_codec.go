@@ -0,0 +1,366 @@
+// Package save セーブデータのエンコード形式
+// JSON、gzip圧縮JSON、バイナリ形式を提供し、ファイルヘッダーから形式を判別する
+package save
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+const (
+	// CodecJSON is the name of the plain JSON codec
+	CodecJSON = "json"
+
+	// CodecGzipJSON is the name of the gzip-compressed JSON codec
+	CodecGzipJSON = "gzip"
+
+	// CodecBinary is the name of the compact binary codec
+	CodecBinary = "binary"
+
+	// codecHeaderSize is the number of bytes inspected to detect a codec
+	codecHeaderSize = 8
+)
+
+// binaryMagic is the file header of binary save files
+var binaryMagic = []byte("GRSB")
+
+// binaryFormatVersion is the layout version written after the binary magic
+// 3 から中身はスキーマに依存しない汎用ドキュメントで、スキーマのバージョンはドキュメント内に持つ
+const binaryFormatVersion byte = 3
+
+// SaveCodec encodes and decodes save data
+type SaveCodec interface {
+	// Name returns the codec name used in settings and metadata
+	Name() string
+
+	// Extension returns the file extension of save files written by this codec
+	Extension() string
+
+	// Detect reports whether the file header belongs to this codec
+	Detect(header []byte) bool
+
+	// Encode writes save data to w
+	Encode(w io.Writer, saveData *SaveData) error
+
+	// Decode reads save data from r
+	Decode(r io.Reader) (*SaveData, error)
+
+	// DecodeDocument reads r as a raw document so it can be migrated before decoding
+	DecodeDocument(r io.Reader) (Document, error)
+}
+
+// saveCodecs holds registered codecs by name
+var saveCodecs = map[string]SaveCodec{}
+
+// codecOrder keeps detection order stable (most specific header first)
+var codecOrder []string
+
+func init() {
+	// バイナリ形式のドキュメントに入る値の型（JSON から作ったドキュメントと同じ）
+	gob.Register(map[string]interface{}{})
+	gob.Register([]interface{}{})
+	gob.Register(json.Number(""))
+
+	RegisterSaveCodec(&BinaryCodec{})
+	RegisterSaveCodec(&GzipJSONCodec{})
+	RegisterSaveCodec(&JSONCodec{})
+}
+
+// RegisterSaveCodec registers a codec so it can be selected and detected
+func RegisterSaveCodec(codec SaveCodec) {
+	if _, exists := saveCodecs[codec.Name()]; !exists {
+		codecOrder = append(codecOrder, codec.Name())
+	}
+	saveCodecs[codec.Name()] = codec
+}
+
+// GetSaveCodec returns the codec registered under name
+func GetSaveCodec(name string) (SaveCodec, error) {
+	codec, exists := saveCodecs[name]
+	if !exists {
+		return nil, fmt.Errorf("unknown save codec: %s", name)
+	}
+	return codec, nil
+}
+
+// saveFileExtensions returns the file extensions of all registered codecs
+func saveFileExtensions() []string {
+	extensions := make([]string, 0, len(codecOrder))
+	for _, name := range codecOrder {
+		extensions = append(extensions, saveCodecs[name].Extension())
+	}
+	return extensions
+}
+
+// isSaveFileName reports whether name has the extension of a registered codec
+func isSaveFileName(name string) bool {
+	for _, ext := range saveFileExtensions() {
+		if strings.HasSuffix(name, ext) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetSaveCodecNames returns the names of all registered codecs
+func GetSaveCodecNames() []string {
+	names := make([]string, 0, len(saveCodecs))
+	for name := range saveCodecs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// DetectSaveCodec returns the codec matching the file header
+func DetectSaveCodec(header []byte) (SaveCodec, error) {
+	for _, name := range codecOrder {
+		if codec := saveCodecs[name]; codec.Detect(header) {
+			return codec, nil
+		}
+	}
+	return nil, fmt.Errorf("unrecognized save file format")
+}
+
+// DecodeSaveData detects the codec from the stream header and decodes save data
+func DecodeSaveData(r io.Reader) (*SaveData, SaveCodec, error) {
+	reader := bufio.NewReader(r)
+
+	// 短いファイルでも判別できるよう、読めた分だけで判定する
+	header, err := reader.Peek(codecHeaderSize)
+	if err != nil && err != io.EOF && err != bufio.ErrBufferFull {
+		return nil, nil, err
+	}
+
+	codec, err := DetectSaveCodec(header)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	saveData, err := codec.Decode(reader)
+	if err != nil {
+		return nil, codec, fmt.Errorf("failed to decode %s save data: %w", codec.Name(), err)
+	}
+
+	return saveData, codec, nil
+}
+
+// DecodeSaveDocument detects the codec from the stream header and decodes a raw document
+func DecodeSaveDocument(r io.Reader) (Document, SaveCodec, error) {
+	reader := bufio.NewReader(r)
+
+	header, err := reader.Peek(codecHeaderSize)
+	if err != nil && err != io.EOF && err != bufio.ErrBufferFull {
+		return nil, nil, err
+	}
+
+	codec, err := DetectSaveCodec(header)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	doc, err := codec.DecodeDocument(reader)
+	if err != nil {
+		return nil, codec, fmt.Errorf("failed to decode %s save document: %w", codec.Name(), err)
+	}
+
+	return doc, codec, nil
+}
+
+// JSONCodec stores save data as indented JSON
+type JSONCodec struct{}
+
+// Name returns the codec name
+func (c *JSONCodec) Name() string {
+	return CodecJSON
+}
+
+// Extension returns the save file extension
+func (c *JSONCodec) Extension() string {
+	return ".json"
+}
+
+// Detect reports whether the header starts a JSON object
+func (c *JSONCodec) Detect(header []byte) bool {
+	trimmed := bytes.TrimLeft(header, " \t\r\n")
+	return len(trimmed) > 0 && trimmed[0] == '{'
+}
+
+// Encode writes save data as indented JSON
+func (c *JSONCodec) Encode(w io.Writer, saveData *SaveData) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ") // Pretty print JSON
+	return encoder.Encode(saveData)
+}
+
+// Decode reads save data from JSON
+func (c *JSONCodec) Decode(r io.Reader) (*SaveData, error) {
+	var saveData SaveData
+	if err := json.NewDecoder(r).Decode(&saveData); err != nil {
+		return nil, err
+	}
+	return &saveData, nil
+}
+
+// DecodeDocument reads a raw document from JSON
+func (c *JSONCodec) DecodeDocument(r io.Reader) (Document, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	return decodeDocument(data)
+}
+
+// GzipJSONCodec stores save data as gzip-compressed JSON
+type GzipJSONCodec struct{}
+
+// Name returns the codec name
+func (c *GzipJSONCodec) Name() string {
+	return CodecGzipJSON
+}
+
+// Extension returns the save file extension
+func (c *GzipJSONCodec) Extension() string {
+	return ".json.gz"
+}
+
+// Detect reports whether the header is a gzip header
+func (c *GzipJSONCodec) Detect(header []byte) bool {
+	return len(header) >= 2 && header[0] == 0x1f && header[1] == 0x8b
+}
+
+// Encode writes save data as compact JSON compressed with gzip
+func (c *GzipJSONCodec) Encode(w io.Writer, saveData *SaveData) error {
+	gzipWriter := gzip.NewWriter(w)
+	if err := json.NewEncoder(gzipWriter).Encode(saveData); err != nil {
+		gzipWriter.Close()
+		return err
+	}
+	return gzipWriter.Close()
+}
+
+// Decode reads save data from gzip-compressed JSON
+func (c *GzipJSONCodec) Decode(r io.Reader) (*SaveData, error) {
+	gzipReader, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer gzipReader.Close()
+
+	var saveData SaveData
+	if err := json.NewDecoder(gzipReader).Decode(&saveData); err != nil {
+		return nil, err
+	}
+	return &saveData, nil
+}
+
+// DecodeDocument reads a raw document from gzip-compressed JSON
+func (c *GzipJSONCodec) DecodeDocument(r io.Reader) (Document, error) {
+	gzipReader, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer gzipReader.Close()
+
+	data, err := io.ReadAll(gzipReader)
+	if err != nil {
+		return nil, err
+	}
+	return decodeDocument(data)
+}
+
+// BinaryCodec stores save data as compressed gob behind a magic header
+type BinaryCodec struct{}
+
+// Name returns the codec name
+func (c *BinaryCodec) Name() string {
+	return CodecBinary
+}
+
+// Extension returns the save file extension
+func (c *BinaryCodec) Extension() string {
+	return ".sav"
+}
+
+// Detect reports whether the header carries the binary magic
+func (c *BinaryCodec) Detect(header []byte) bool {
+	return bytes.HasPrefix(header, binaryMagic)
+}
+
+// Encode writes the magic header followed by the save document as gzip-compressed gob
+// SaveData をそのまま gob にすると構造体が変わったときに読めなくなるので、移行できる汎用ドキュメントで書く
+func (c *BinaryCodec) Encode(w io.Writer, saveData *SaveData) error {
+	doc, err := saveDataToDocument(saveData)
+	if err != nil {
+		return err
+	}
+	return encodeBinaryDocument(w, doc)
+}
+
+// encodeBinaryDocument writes a raw document in the binary format
+func encodeBinaryDocument(w io.Writer, doc Document) error {
+	if _, err := w.Write(binaryMagic); err != nil {
+		return err
+	}
+	if _, err := w.Write([]byte{binaryFormatVersion}); err != nil {
+		return err
+	}
+
+	gzipWriter, err := gzip.NewWriterLevel(w, gzip.BestCompression)
+	if err != nil {
+		return err
+	}
+	if err := gob.NewEncoder(gzipWriter).Encode(doc); err != nil {
+		gzipWriter.Close()
+		return err
+	}
+	return gzipWriter.Close()
+}
+
+// Decode reads save data written by Encode
+func (c *BinaryCodec) Decode(r io.Reader) (*SaveData, error) {
+	doc, err := c.DecodeDocument(r)
+	if err != nil {
+		return nil, err
+	}
+	return documentToSaveData(doc)
+}
+
+// DecodeDocument reads the raw document from binary save data so it can be migrated
+func (c *BinaryCodec) DecodeDocument(r io.Reader) (Document, error) {
+	header := make([]byte, len(binaryMagic)+1)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, err
+	}
+	if !c.Detect(header) {
+		return nil, fmt.Errorf("invalid binary save header")
+	}
+	// 3 より前は SaveData を直接 gob にしていたため、移行できない
+	if format := header[len(binaryMagic)]; format < binaryFormatVersion {
+		return nil, fmt.Errorf("binary save format version %d stores a fixed layout and cannot be migrated; load it with the release that wrote it and re-save", format)
+	} else if format != binaryFormatVersion {
+		return nil, fmt.Errorf("unsupported binary save format version: %d", format)
+	}
+
+	gzipReader, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer gzipReader.Close()
+
+	var doc Document
+	if err := gob.NewDecoder(gzipReader).Decode(&doc); err != nil {
+		return nil, err
+	}
+	if doc == nil {
+		return nil, fmt.Errorf("save document is empty")
+	}
+	return doc, nil
+}
@@ -0,0 +1,260 @@
+// Package save セーブコーデックのテスト
+// 各形式での保存・読み込みとヘッダーによる形式判別をテスト
+package save
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/yuru-sha/gorogue/internal/utils/logger"
+)
+
+// TestSaveCodec_SaveAndLoad tests saving and loading with every codec
+func TestSaveCodec_SaveAndLoad(t *testing.T) {
+	logger.Setup()
+
+	for _, codecName := range []string{CodecJSON, CodecGzipJSON, CodecBinary} {
+		t.Run(codecName, func(t *testing.T) {
+			tempDir, err := os.MkdirTemp("", "gorogue_test_*")
+			if err != nil {
+				t.Fatalf("Failed to create temp directory: %v", err)
+			}
+			defer os.RemoveAll(tempDir)
+
+			codec, err := GetSaveCodec(codecName)
+			if err != nil {
+				t.Fatalf("GetSaveCodec failed: %v", err)
+			}
+
+			sm := NewSaveManager()
+			sm.saveDir = tempDir
+			sm.SetCodec(codec)
+			if err := sm.Initialize(); err != nil {
+				t.Fatalf("Initialize failed: %v", err)
+			}
+
+			testSaveData := createTestSaveData(t)
+			if err := sm.SaveGame(testSaveData, 0); err != nil {
+				t.Fatalf("SaveGame failed: %v", err)
+			}
+
+			format, err := sm.GetSaveFormat(0)
+			if err != nil {
+				t.Fatalf("GetSaveFormat failed: %v", err)
+			}
+			if format != codecName {
+				t.Errorf("Detected format mismatch: expected %s, got %s", codecName, format)
+			}
+
+			loadedData, err := sm.LoadGame(0)
+			if err != nil {
+				t.Fatalf("LoadGame failed: %v", err)
+			}
+
+			if loadedData.GameInfo.CharName != testSaveData.GameInfo.CharName {
+				t.Errorf("Character name mismatch: expected %s, got %s",
+					testSaveData.GameInfo.CharName, loadedData.GameInfo.CharName)
+			}
+			if len(loadedData.DungeonData.Floors) != len(testSaveData.DungeonData.Floors) {
+				t.Errorf("Floor count mismatch: expected %d, got %d",
+					len(testSaveData.DungeonData.Floors), len(loadedData.DungeonData.Floors))
+			}
+
+			// Metadata and checksum keep working for every codec
+			metadata, err := sm.GetSaveMetadata(0)
+			if err != nil {
+				t.Fatalf("GetSaveMetadata failed: %v", err)
+			}
+			if metadata.Format != codecName {
+				t.Errorf("Metadata format mismatch: expected %s, got %s", codecName, metadata.Format)
+			}
+
+			info, err := sm.GetSaveInfo(0)
+			if err != nil {
+				t.Fatalf("GetSaveInfo failed: %v", err)
+			}
+			if info["checksum"] == "unknown" {
+				t.Error("Checksum could not be calculated")
+			}
+			if filepath.Ext(info["file_path"].(string)) != filepath.Ext(codec.Extension()) {
+				t.Errorf("Expected a %s file, got %v", codec.Extension(), info["file_path"])
+			}
+		})
+	}
+}
+
+// TestSaveCodec_ChangeFormat tests that saving in another format replaces the file of the old format
+func TestSaveCodec_ChangeFormat(t *testing.T) {
+	logger.Setup()
+
+	sm := NewSaveManager()
+	sm.saveDir = t.TempDir()
+	if err := sm.Initialize(); err != nil {
+		t.Fatalf("Initialize failed: %v", err)
+	}
+
+	testSaveData := createTestSaveData(t)
+	if err := sm.SaveGame(testSaveData, 0); err != nil {
+		t.Fatalf("SaveGame failed: %v", err)
+	}
+	jsonFile := filepath.Join(sm.saveDir, "save_0.json")
+
+	// A file written by another codec is still found
+	sm.SetCodec(&BinaryCodec{})
+	if !sm.FileExists(0) {
+		t.Fatal("The JSON save should be found after switching codecs")
+	}
+	if _, err := sm.LoadGame(0); err != nil {
+		t.Fatalf("LoadGame of the JSON save failed: %v", err)
+	}
+
+	if err := sm.SaveGame(testSaveData, 0); err != nil {
+		t.Fatalf("SaveGame failed: %v", err)
+	}
+	if _, err := os.Stat(jsonFile); !os.IsNotExist(err) {
+		t.Error("The JSON save should be replaced by the binary save")
+	}
+	if _, err := os.Stat(filepath.Join(sm.saveDir, "save_0.sav")); err != nil {
+		t.Errorf("Expected a binary save file: %v", err)
+	}
+	if format, err := sm.GetSaveFormat(0); err != nil || format != CodecBinary {
+		t.Errorf("Expected format %s, got %s (%v)", CodecBinary, format, err)
+	}
+}
+
+// TestSaveCodec_ExportWithCodec tests exporting a save in a different format
+func TestSaveCodec_ExportWithCodec(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "gorogue_test_*")
+	if err != nil {
+		t.Fatalf("Failed to create temp directory: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	sm := NewSaveManager()
+	sm.saveDir = tempDir
+	if err := sm.Initialize(); err != nil {
+		t.Fatalf("Initialize failed: %v", err)
+	}
+
+	testSaveData := createTestSaveData(t)
+	if err := sm.SaveGame(testSaveData, 0); err != nil {
+		t.Fatalf("SaveGame failed: %v", err)
+	}
+
+	exportPath := filepath.Join(tempDir, "exported.sav")
+	if err := sm.ExportSave(0, exportPath, CodecBinary); err != nil {
+		t.Fatalf("ExportSave failed: %v", err)
+	}
+
+	jsonInfo, _ := os.Stat(sm.getSaveFilePath(0))
+	binaryInfo, _ := os.Stat(exportPath)
+	if binaryInfo.Size() >= jsonInfo.Size() {
+		t.Errorf("Binary export is not smaller than JSON: %d >= %d", binaryInfo.Size(), jsonInfo.Size())
+	}
+
+	// Imported files are detected by header
+	if err := sm.ImportSave(exportPath, 1); err != nil {
+		t.Fatalf("ImportSave failed: %v", err)
+	}
+
+	loadedData, err := sm.LoadGame(1)
+	if err != nil {
+		t.Fatalf("LoadGame failed: %v", err)
+	}
+	if loadedData.PlayerData.Gold != testSaveData.PlayerData.Gold {
+		t.Errorf("Gold mismatch: expected %d, got %d", testSaveData.PlayerData.Gold, loadedData.PlayerData.Gold)
+	}
+
+	if err := sm.ExportSave(0, exportPath, "unknown"); err == nil {
+		t.Error("ExportSave should fail for unknown codec")
+	}
+}
+
+// TestDetectSaveCodec tests codec detection by file header
+func TestDetectSaveCodec(t *testing.T) {
+	testCases := []struct {
+		header   []byte
+		expected string
+		hasError bool
+	}{
+		{[]byte("{\n  \"version\""), CodecJSON, false},
+		{[]byte("  {"), CodecJSON, false},
+		{[]byte{0x1f, 0x8b, 0x08}, CodecGzipJSON, false},
+		{[]byte("GRSB\x01"), CodecBinary, false},
+		{[]byte("garbage"), "", true},
+		{[]byte{}, "", true},
+	}
+
+	for _, tc := range testCases {
+		codec, err := DetectSaveCodec(tc.header)
+		if tc.hasError {
+			if err == nil {
+				t.Errorf("DetectSaveCodec(%q) should have returned error", tc.header)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("DetectSaveCodec(%q) returned unexpected error: %v", tc.header, err)
+			continue
+		}
+		if codec.Name() != tc.expected {
+			t.Errorf("DetectSaveCodec(%q) = %s, expected %s", tc.header, codec.Name(), tc.expected)
+		}
+	}
+}
+
+// TestBinaryCodec_MigratesLegacySave tests that a 1.0.0 binary save goes through the migration chain
+func TestBinaryCodec_MigratesLegacySave(t *testing.T) {
+	logger.Setup()
+
+	sm := NewSaveManager()
+	sm.saveDir = t.TempDir()
+
+	var buf bytes.Buffer
+	if err := encodeBinaryDocument(&buf, createLegacyDocument(t)); err != nil {
+		t.Fatalf("Failed to encode legacy document: %v", err)
+	}
+	path := filepath.Join(sm.saveDir, "legacy.sav")
+	if err := os.WriteFile(path, buf.Bytes(), 0644); err != nil {
+		t.Fatalf("Failed to write save: %v", err)
+	}
+
+	doc, _, err := sm.readSaveDocument(path)
+	if err != nil {
+		t.Fatalf("readSaveDocument failed: %v", err)
+	}
+	if doc["version"] != "1.0.0" {
+		t.Fatalf("Expected the binary document to keep version 1.0.0, got %v", doc["version"])
+	}
+
+	saveData, err := sm.readSaveData(path)
+	if err != nil {
+		t.Fatalf("readSaveData failed: %v", err)
+	}
+	if saveData.Version != SaveVersion {
+		t.Errorf("Expected version %s, got %s", SaveVersion, saveData.Version)
+	}
+	if _, exists := saveData.PlayerData.IdentifiedItems["identify"]; exists {
+		t.Error("The 1.0.0 -> 1.1.0 migration was not applied")
+	}
+	if saveData.GameInfo.CharName != createTestSaveData(t).GameInfo.CharName {
+		t.Errorf("Character name changed: %s", saveData.GameInfo.CharName)
+	}
+}
+
+// TestBinaryCodec_OldFormat tests that binary saves from the fixed-layout formats are refused
+func TestBinaryCodec_OldFormat(t *testing.T) {
+	sm := NewSaveManager()
+	path := filepath.Join(t.TempDir(), "old.sav")
+	if err := os.WriteFile(path, append(append([]byte{}, binaryMagic...), 2), 0644); err != nil {
+		t.Fatalf("Failed to write save: %v", err)
+	}
+
+	_, err := sm.readSaveData(path)
+	if err == nil || !strings.Contains(err.Error(), "binary save format version 2 stores a fixed layout") {
+		t.Errorf("Expected the old binary format to be refused, got %v", err)
+	}
+}
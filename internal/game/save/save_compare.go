@@ -0,0 +1,149 @@
+// Package save セーブデータの構造比較
+// セーブ→ロード→セーブの往復で状態が失われていないことを検証する
+package save
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// MaxReportedDifferences is the maximum number of differences listed by CompareSaveData
+const MaxReportedDifferences = 50
+
+// volatileFields are document keys that legitimately change on every save
+var volatileFields = map[string]bool{
+	"saved_at": true,
+}
+
+// CompareSaveData compares two save documents structurally and returns the differing paths
+func CompareSaveData(a, b *SaveData) ([]string, error) {
+	docA, err := toDocument(a)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode first save data: %w", err)
+	}
+
+	docB, err := toDocument(b)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode second save data: %w", err)
+	}
+
+	diffs := make([]string, 0)
+	total := 0
+	compareDocuments("", docA, docB, &diffs, &total)
+
+	if total > len(diffs) {
+		diffs = append(diffs, fmt.Sprintf("... and %d more differences", total-len(diffs)))
+	}
+
+	return diffs, nil
+}
+
+// VerifyRoundTrip loads save data into game objects, saves them again and compares both documents
+func (sc *SaveConverter) VerifyRoundTrip(saveData *SaveData) ([]string, error) {
+	player, dungeonManager, err := sc.FromSaveData(saveData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load save data: %w", err)
+	}
+
+	resaved := ToSaveData(player, dungeonManager, saveData.GameInfo, saveData.GameStats, saveData.Settings)
+	resaved.Version = saveData.Version
+
+	return CompareSaveData(saveData, resaved)
+}
+
+// toDocument converts save data to its generic JSON document form
+func toDocument(saveData *SaveData) (interface{}, error) {
+	data, err := json.Marshal(saveData)
+	if err != nil {
+		return nil, err
+	}
+
+	var doc interface{}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+
+	return doc, nil
+}
+
+// compareDocuments walks two JSON documents and records differences
+func compareDocuments(path string, a, b interface{}, diffs *[]string, total *int) {
+	addDiff := func(format string, args ...interface{}) {
+		*total++
+		if len(*diffs) < MaxReportedDifferences {
+			*diffs = append(*diffs, fmt.Sprintf("%s: ", displayPath(path))+fmt.Sprintf(format, args...))
+		}
+	}
+
+	switch av := a.(type) {
+	case map[string]interface{}:
+		bv, ok := b.(map[string]interface{})
+		if !ok {
+			addDiff("object != %T", b)
+			return
+		}
+
+		keys := make([]string, 0, len(av)+len(bv))
+		for key := range av {
+			keys = append(keys, key)
+		}
+		for key := range bv {
+			if _, exists := av[key]; !exists {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			if volatileFields[key] {
+				continue
+			}
+
+			childPath := key
+			if path != "" {
+				childPath = path + "." + key
+			}
+
+			aChild, aExists := av[key]
+			bChild, bExists := bv[key]
+			switch {
+			case !aExists:
+				compareDocuments(childPath, nil, bChild, diffs, total)
+			case !bExists:
+				compareDocuments(childPath, aChild, nil, diffs, total)
+			default:
+				compareDocuments(childPath, aChild, bChild, diffs, total)
+			}
+		}
+
+	case []interface{}:
+		bv, ok := b.([]interface{})
+		if !ok {
+			addDiff("array != %T", b)
+			return
+		}
+
+		if len(av) != len(bv) {
+			addDiff("length %d != %d", len(av), len(bv))
+		}
+
+		for i := 0; i < len(av) && i < len(bv); i++ {
+			compareDocuments(fmt.Sprintf("%s[%d]", path, i), av[i], bv[i], diffs, total)
+		}
+
+	default:
+		if !reflect.DeepEqual(a, b) {
+			addDiff("%v != %v", a, b)
+		}
+	}
+}
+
+// displayPath returns a readable path for the document root
+func displayPath(path string) string {
+	if path == "" {
+		return "(root)"
+	}
+	return path
+}
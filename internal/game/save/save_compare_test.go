@@ -0,0 +1,130 @@
+// Package save セーブデータ構造比較のテスト
+// セーブ→ロード→セーブの往復が同一ドキュメントになることをテスト
+package save
+
+import (
+	"testing"
+
+	"github.com/yuru-sha/gorogue/internal/core/entity"
+	"github.com/yuru-sha/gorogue/internal/game/actor"
+	"github.com/yuru-sha/gorogue/internal/game/dungeon"
+	"github.com/yuru-sha/gorogue/internal/game/item"
+	"github.com/yuru-sha/gorogue/internal/utils/logger"
+)
+
+// createRoundTripSaveData creates save data from a live game world with non-default state
+func createRoundTripSaveData(t *testing.T) *SaveData {
+	t.Helper()
+
+	player, dungeonManager := createRoundTripWorld(t)
+	return ToSaveData(player, dungeonManager,
+		GameInfo{Seed: 42, TurnCount: 100, CharName: "Tester"},
+		Stats{MonstersKilled: 3, DeepestFloor: 1},
+		GetDefaultSettings(),
+	)
+}
+
+// createRoundTripWorld creates a live game world with non-default state
+func createRoundTripWorld(t *testing.T) (*actor.Player, *dungeon.DungeonManager) {
+	t.Helper()
+	logger.Setup()
+
+	player := actor.NewPlayer(0, 0)
+	dungeonManager := dungeon.NewDungeonManager(player)
+	level := dungeonManager.GetCurrentLevel()
+	if level == nil {
+		t.Fatal("Current level is nil")
+	}
+
+	// Player state
+	player.Level = 4
+	player.HP = 17
+	player.Gold = 321
+	potion := item.NewItem(0, 0, item.ItemPotion, "healing", 50)
+	potion.IsCursed = true
+	player.Inventory.AddItem(potion)
+	player.IdentifyMgr.CallItem(potion, "heal?")
+	player.Equipment.RingLeft = item.NewItem(0, 0, item.ItemRing, "protection", 200)
+	player.KilledBy = "starvation"
+	player.StatusEffects = []actor.StatusEffect{
+		{Type: "confused", Duration: 5, Intensity: 1, Source: "potion"},
+	}
+
+	// Tile visibility, exploration and door state
+	level.GetTile(1, 1).Explored = true
+	level.GetTile(2, 1).Visible = false
+	level.SetTile(3, 1, dungeon.TileDoorOpen)
+	level.SetTile(4, 1, dungeon.TileWater)
+
+	// Room types (a dark room leaves its tiles unlit)
+	if len(level.Rooms) > 0 {
+		level.Rooms[0].Type = dungeon.RoomTypeLibrary
+	}
+	if len(level.Rooms) > 1 {
+		level.Rooms[1].Type = dungeon.RoomTypeDark
+	}
+
+	// Monster AI state
+	monster := actor.NewMonster(5, 5, 'O')
+	monster.AIState = actor.StateSearch
+	monster.AlertLevel = 7
+	monster.SearchTurns = 3
+	monster.LastPlayerPos = entity.Position{X: 9, Y: 8}
+	monster.LastEffect = actor.EffectStealGold
+	level.Monsters = append(level.Monsters, monster)
+
+	// Floor item curse and blessing
+	scroll := item.NewItem(6, 6, item.ItemScroll, "identify", 100)
+	scroll.IsBlessed = true
+	level.Items = append(level.Items, scroll)
+
+	return player, dungeonManager
+}
+
+// TestSaveData_RoundTrip tests that save -> load -> save produces an identical document
+func TestSaveData_RoundTrip(t *testing.T) {
+	converter := NewSaveConverter()
+	saveData := createRoundTripSaveData(t)
+
+	diffs, err := converter.VerifyRoundTrip(saveData)
+	if err != nil {
+		t.Fatalf("VerifyRoundTrip failed: %v", err)
+	}
+
+	for _, diff := range diffs {
+		t.Errorf("Round trip difference: %s", diff)
+	}
+}
+
+// TestCompareSaveData tests that differences are reported with their paths
+func TestCompareSaveData(t *testing.T) {
+	saveData := createRoundTripSaveData(t)
+
+	// Identical documents
+	diffs, err := CompareSaveData(saveData, saveData)
+	if err != nil {
+		t.Fatalf("CompareSaveData failed: %v", err)
+	}
+	if len(diffs) != 0 {
+		t.Errorf("Expected no differences, got %v", diffs)
+	}
+
+	// Modified copy
+	modified := *saveData
+	modified.PlayerData.Gold++
+	modified.GameInfo.CharName = "Other"
+
+	diffs, err = CompareSaveData(saveData, &modified)
+	if err != nil {
+		t.Fatalf("CompareSaveData failed: %v", err)
+	}
+	if len(diffs) != 2 {
+		t.Fatalf("Expected 2 differences, got %d: %v", len(diffs), diffs)
+	}
+	if diffs[0] != "game_info.char_name: Tester != Other" {
+		t.Errorf("Unexpected difference: %s", diffs[0])
+	}
+	if diffs[1] != "player.gold: 321 != 322" {
+		t.Errorf("Unexpected difference: %s", diffs[1])
+	}
+}
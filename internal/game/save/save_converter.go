@@ -86,6 +86,8 @@ func (sc *SaveConverter) convertSavePlayer(savePlayer Player) (*actor.Player, er
 	player.Hunger = savePlayer.Hunger
 	player.Exp = savePlayer.Exp
 	player.Gold = savePlayer.Gold
+	player.Class = actor.Class(savePlayer.Class)
+	player.KilledBy = savePlayer.KilledBy
 
 	// Convert inventory
 	if err := sc.convertInventory(savePlayer.Inventory, player.Inventory); err != nil {
@@ -98,11 +100,19 @@ func (sc *SaveConverter) convertSavePlayer(savePlayer Player) (*actor.Player, er
 	}
 
 	// Convert identified items
-	if err := sc.convertIdentifiedItems(savePlayer.IdentifiedItems, player.IdentifyMgr); err != nil {
+	if err := sc.convertIdentifiedItems(savePlayer, player.IdentifyMgr); err != nil {
 		return nil, fmt.Errorf("failed to convert identified items: %w", err)
 	}
 
-	// TODO: Convert status effects when implemented
+	// Convert status effects
+	for _, effect := range savePlayer.StatusEffects {
+		player.StatusEffects = append(player.StatusEffects, actor.StatusEffect{
+			Type:      effect.Type,
+			Duration:  effect.Duration,
+			Intensity: effect.Intensity,
+			Source:    effect.Source,
+		})
+	}
 
 	if sc.validateData {
 		if err := sc.validatePlayer(player); err != nil {
@@ -205,6 +215,12 @@ func (sc *SaveConverter) convertSaveItemToGameItem(saveItem InventoryItem) (*ite
 		IsBlessed:    saveItem.IsBlessed,
 	}
 
+	// 旧セーブには記号と色がないため種別から決める
+	if saveItem.Symbol != 0 {
+		gameItem.Symbol = saveItem.Symbol
+		gameItem.Color = gruid.Color(saveItem.Color)
+	}
+
 	return gameItem, nil
 }
 
@@ -256,18 +272,53 @@ func (sc *SaveConverter) convertStringToItemType(itemTypeStr string) (item.ItemT
 	}
 }
 
-// convertIdentifiedItems converts identified items map to identification manager
-func (sc *SaveConverter) convertIdentifiedItems(identifiedItems map[string]bool, identifyMgr *identification.IdentificationManager) error {
-	// The identification manager would need methods to load identified items
-	// For now, we'll just log this as a placeholder
-	logger.Debug("Loading identified items",
-		"count", len(identifiedItems),
+// convertIdentifiedItems restores identification state into the identification manager
+func (sc *SaveConverter) convertIdentifiedItems(savePlayer Player, identifyMgr *identification.IdentificationManager) error {
+	if identifyMgr == nil {
+		return fmt.Errorf("identification manager is nil")
+	}
+
+	if savePlayer.Appearances != nil {
+		identifyMgr.SetAppearances(identification.AppearanceTable{
+			Scrolls: savePlayer.Appearances.Scrolls,
+			Potions: savePlayer.Appearances.Potions,
+			Rings:   savePlayer.Appearances.Rings,
+			Wands:   savePlayer.Appearances.Wands,
+		})
+	} else {
+		sc.migrateLegacyAppearances(&savePlayer)
+	}
+
+	identifyMgr.SetIdentifiedItems(savePlayer.IdentifiedItems)
+
+	if len(savePlayer.CalledItems) > 0 {
+		identifyMgr.SetCalledNames(savePlayer.CalledItems)
+	}
+
+	logger.Debug("Loaded identified items",
+		"identified", len(savePlayer.IdentifiedItems),
+		"called", len(savePlayer.CalledItems),
+		"has_appearances", savePlayer.Appearances != nil,
 	)
 
-	// TODO: Implement identification manager loading
 	return nil
 }
 
+// migrateLegacyAppearances handles saves written before appearance tables were stored
+func (sc *SaveConverter) migrateLegacyAppearances(savePlayer *Player) {
+	// 旧セーブには外見テーブルがないため、新しく生成されたテーブルをそのまま使う
+	// 次回セーブ時に保存されるので、以降は外見が固定される
+	logger.Warn("Save data has no item appearance tables, keeping newly generated appearances")
+
+	// ラベルは外見に紐づくため、外見が変わると別の種類に付いてしまう
+	if len(savePlayer.CalledItems) > 0 {
+		logger.Warn("Discarding item labels from legacy save",
+			"count", len(savePlayer.CalledItems),
+		)
+		savePlayer.CalledItems = nil
+	}
+}
+
 // convertSaveDungeon converts save dungeon to dungeon manager
 func (sc *SaveConverter) convertSaveDungeon(saveDungeon Dungeon, player *actor.Player) (*dungeon.DungeonManager, error) {
 	// Create dungeon manager
@@ -307,6 +358,7 @@ func (sc *SaveConverter) convertSaveFloor(saveFloor Floor) (*dungeon.Level, erro
 		Width:       saveFloor.Width,
 		Height:      saveFloor.Height,
 		FloorNumber: saveFloor.FloorNumber,
+		Seed:        saveFloor.Seed,
 		Tiles:       make([][]*dungeon.Tile, saveFloor.Height),
 		Rooms:       make([]*dungeon.Room, 0),
 		Monsters:    make([]*actor.Monster, 0),
@@ -329,7 +381,10 @@ func (sc *SaveConverter) convertSaveFloor(saveFloor Floor) (*dungeon.Level, erro
 					)
 					tileType = dungeon.TileWall // Default to wall
 				}
-				level.Tiles[y][x] = dungeon.NewTile(tileType)
+				tile := dungeon.NewTile(tileType)
+				tile.Visible = saveTile.Visible
+				tile.Explored = saveTile.Explored
+				level.Tiles[y][x] = tile
 			} else {
 				level.Tiles[y][x] = dungeon.NewTile(dungeon.TileWall)
 			}
@@ -345,6 +400,7 @@ func (sc *SaveConverter) convertSaveFloor(saveFloor Floor) (*dungeon.Level, erro
 			Height:    saveRoom.Height,
 			IsSpecial: saveRoom.IsSpecial,
 			Connected: saveRoom.Connected,
+			Type:      saveRoom.RoomType,
 		}
 		level.Rooms = append(level.Rooms, room)
 	}
@@ -387,6 +443,16 @@ func (sc *SaveConverter) convertStringToTileType(tileTypeStr string) (dungeon.Ti
 		return dungeon.TileFloor, nil
 	case "door":
 		return dungeon.TileDoor, nil
+	case "door_closed":
+		return dungeon.TileDoorClosed, nil
+	case "door_open":
+		return dungeon.TileDoorOpen, nil
+	case "open_door":
+		return dungeon.TileOpenDoor, nil
+	case "water":
+		return dungeon.TileWater, nil
+	case "lava":
+		return dungeon.TileLava, nil
 	case "secret_door":
 		return dungeon.TileSecretDoor, nil
 	case "stairs_up":
@@ -434,6 +500,16 @@ func (sc *SaveConverter) convertSaveMonster(saveMonster Monster) (*actor.Monster
 	}
 	monster.AIState = aiState
 
+	// Convert last special effect
+	lastEffect, err := sc.convertStringToSpecialEffect(saveMonster.LastEffect)
+	if err != nil {
+		logger.Warn("Failed to convert special effect",
+			"effect", saveMonster.LastEffect,
+			"error", err,
+		)
+	}
+	monster.LastEffect = lastEffect
+
 	// Convert positions
 	monster.LastPlayerPos = entity.Position{X: saveMonster.LastPlayerPosX, Y: saveMonster.LastPlayerPosY}
 	monster.OriginalPos = entity.Position{X: saveMonster.OriginalPosX, Y: saveMonster.OriginalPosY}
@@ -467,6 +543,24 @@ func (sc *SaveConverter) convertStringToAIState(aiStateStr string) (actor.AIStat
 	}
 }
 
+// convertStringToSpecialEffect converts string to monster special effect
+func (sc *SaveConverter) convertStringToSpecialEffect(effectStr string) (actor.SpecialEffect, error) {
+	switch effectStr {
+	case "":
+		return actor.EffectNone, nil
+	case "poison":
+		return actor.EffectPoison, nil
+	case "drain":
+		return actor.EffectDrain, nil
+	case "steal_gold":
+		return actor.EffectStealGold, nil
+	case "steal_item":
+		return actor.EffectStealItem, nil
+	default:
+		return actor.EffectNone, fmt.Errorf("unknown special effect: %s", effectStr)
+	}
+}
+
 // validatePlayer validates player data
 func (sc *SaveConverter) validatePlayer(player *actor.Player) error {
 	if player.Level < 1 || player.Level > 50 {
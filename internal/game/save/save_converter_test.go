@@ -3,6 +3,8 @@
 package save
 
 import (
+	"bytes"
+	"reflect"
 	"testing"
 
 	"github.com/yuru-sha/gorogue/internal/game/actor"
@@ -80,6 +82,126 @@ func TestSaveConverter_ConvertPlayerToSave(t *testing.T) {
 	}
 }
 
+// TestSaveConverter_CalledItems tests player item labels survive a round trip
+func TestSaveConverter_CalledItems(t *testing.T) {
+	converter := NewSaveConverter()
+
+	player := actor.NewPlayer(10, 10)
+	potion := item.NewItem(0, 0, item.ItemPotion, "healing", 50)
+	player.Inventory.AddItem(potion)
+
+	if !player.IdentifyMgr.CallItem(potion, "heal?") {
+		t.Fatal("CallItem failed for unidentified potion")
+	}
+
+	if name := player.IdentifyMgr.GetDisplayName(potion); name != `potion called "heal?"` {
+		t.Errorf("Display name mismatch: expected %q, got %q", `potion called "heal?"`, name)
+	}
+
+	savePlayer := ConvertPlayerToSave(player)
+	if len(savePlayer.CalledItems) != 1 {
+		t.Fatalf("CalledItems size mismatch: expected 1, got %d", len(savePlayer.CalledItems))
+	}
+
+	restored, err := converter.convertSavePlayer(savePlayer)
+	if err != nil {
+		t.Fatalf("convertSavePlayer failed: %v", err)
+	}
+
+	labels := restored.IdentifyMgr.GetCalledNames()
+	for key, label := range savePlayer.CalledItems {
+		if labels[key] != label {
+			t.Errorf("Label mismatch for %s: expected %q, got %q", key, label, labels[key])
+		}
+	}
+
+	// Identified items no longer use the label
+	player.IdentifyMgr.IdentifyItem(potion)
+	if name := player.IdentifyMgr.GetDisplayName(potion); name != "potion of healing" {
+		t.Errorf("Identified display name mismatch: expected %q, got %q", "potion of healing", name)
+	}
+}
+
+// TestSaveConverter_Class tests the character class and starting kit survive a round trip
+func TestSaveConverter_Class(t *testing.T) {
+	logger.Setup()
+	converter := NewSaveConverter()
+
+	player := actor.NewPlayerWithClass(5, 5, actor.ClassWizard)
+	savePlayer := ConvertPlayerToSave(player)
+	if savePlayer.Class != "wizard" {
+		t.Errorf("Saved class = %q, expected wizard", savePlayer.Class)
+	}
+
+	restored, err := converter.convertSavePlayer(savePlayer)
+	if err != nil {
+		t.Fatalf("convertSavePlayer failed: %v", err)
+	}
+	if restored.Class != actor.ClassWizard {
+		t.Errorf("Restored class = %q, expected wizard", restored.Class)
+	}
+	if restored.Equipment.Armor == nil || restored.Inventory.Size() != player.Inventory.Size() {
+		t.Error("The starting kit should be restored")
+	}
+
+	// 職業のない古いセーブはそのまま読める
+	savePlayer.Class = ""
+	legacy, err := converter.convertSavePlayer(savePlayer)
+	if err != nil {
+		t.Fatalf("convertSavePlayer failed for legacy save: %v", err)
+	}
+	if legacy.Class != "" {
+		t.Errorf("Legacy class = %q, expected empty", legacy.Class)
+	}
+}
+
+// TestSaveConverter_Appearances tests item appearances and identification survive a round trip
+func TestSaveConverter_Appearances(t *testing.T) {
+	converter := NewSaveConverter()
+
+	player := actor.NewPlayer(10, 10)
+	potion := item.NewItem(0, 0, item.ItemPotion, "healing", 50)
+	scroll := item.NewItem(0, 0, item.ItemScroll, "identify", 100)
+	player.IdentifyMgr.IdentifyItem(scroll)
+
+	potionName := player.IdentifyMgr.GetDisplayName(potion)
+
+	savePlayer := ConvertPlayerToSave(player)
+	if savePlayer.Appearances == nil {
+		t.Fatal("Appearances not saved")
+	}
+	if !savePlayer.IdentifiedItems["scroll:identify"] {
+		t.Error("Identified scroll not saved")
+	}
+
+	restored, err := converter.convertSavePlayer(savePlayer)
+	if err != nil {
+		t.Fatalf("convertSavePlayer failed: %v", err)
+	}
+
+	if name := restored.IdentifyMgr.GetDisplayName(potion); name != potionName {
+		t.Errorf("Potion appearance changed: expected %q, got %q", potionName, name)
+	}
+	if !restored.IdentifyMgr.IsIdentified(scroll) {
+		t.Error("Scroll identification not restored")
+	}
+
+	// Legacy saves without appearance tables drop labels tied to old appearances
+	savePlayer.Appearances = nil
+	savePlayer.CalledItems = map[string]string{"potion:blue": "heal?"}
+
+	legacy, err := converter.convertSavePlayer(savePlayer)
+	if err != nil {
+		t.Fatalf("convertSavePlayer failed for legacy save: %v", err)
+	}
+	if len(legacy.IdentifyMgr.GetCalledNames()) != 0 {
+		t.Error("Legacy labels should be discarded")
+	}
+	if !legacy.IdentifyMgr.IsIdentified(scroll) {
+		t.Error("Scroll identification not restored for legacy save")
+	}
+}
+
 // TestSaveConverter_ConvertItemTypeToString tests item type conversion
 func TestSaveConverter_ConvertItemTypeToString(t *testing.T) {
 	testCases := []struct {
@@ -305,6 +427,112 @@ func TestSaveConverter_FromSaveData(t *testing.T) {
 	}
 }
 
+// TestSaveConverter_WorldRoundTrip tests that a reloaded world matches the saved one field by field
+func TestSaveConverter_WorldRoundTrip(t *testing.T) {
+	player, dungeonManager := createRoundTripWorld(t)
+	saveData := ToSaveData(player, dungeonManager, GameInfo{CharName: "Tester"}, Stats{}, GetDefaultSettings())
+
+	// Go through an encoded file like a real save
+	var buf bytes.Buffer
+	codec := &JSONCodec{}
+	if err := codec.Encode(&buf, saveData); err != nil {
+		t.Fatalf("Encode failed: %v", err)
+	}
+	decoded, err := codec.Decode(&buf)
+	if err != nil {
+		t.Fatalf("Decode failed: %v", err)
+	}
+
+	loadedPlayer, loadedDungeon, err := NewSaveConverter().FromSaveData(decoded)
+	if err != nil {
+		t.Fatalf("FromSaveData failed: %v", err)
+	}
+
+	// Player
+	if loadedPlayer.Level != player.Level || loadedPlayer.HP != player.HP || loadedPlayer.Gold != player.Gold {
+		t.Errorf("Player stats mismatch: expected %d/%d/%d, got %d/%d/%d",
+			player.Level, player.HP, player.Gold, loadedPlayer.Level, loadedPlayer.HP, loadedPlayer.Gold)
+	}
+	if loadedPlayer.KilledBy != player.KilledBy {
+		t.Errorf("KilledBy mismatch: expected %q, got %q", player.KilledBy, loadedPlayer.KilledBy)
+	}
+	if !reflect.DeepEqual(loadedPlayer.StatusEffects, player.StatusEffects) {
+		t.Errorf("Status effects mismatch: expected %+v, got %+v", player.StatusEffects, loadedPlayer.StatusEffects)
+	}
+
+	// Floor
+	level := dungeonManager.GetCurrentLevel()
+	loadedLevel := loadedDungeon.GetFloorLevel(level.FloorNumber)
+	if loadedLevel == nil {
+		t.Fatal("Reloaded floor is missing")
+	}
+	if level.Seed == 0 || loadedLevel.Seed != level.Seed {
+		t.Errorf("Floor seed mismatch: expected %d, got %d", level.Seed, loadedLevel.Seed)
+	}
+	if decoded.DungeonData.FloorSeeds[level.FloorNumber] != level.Seed {
+		t.Errorf("Floor seeds mismatch: expected %d, got %d", level.Seed, decoded.DungeonData.FloorSeeds[level.FloorNumber])
+	}
+	if loadedLevel.Width != level.Width || loadedLevel.Height != level.Height {
+		t.Fatalf("Floor size mismatch: expected %dx%d, got %dx%d", level.Width, level.Height, loadedLevel.Width, loadedLevel.Height)
+	}
+
+	sawUnlit := false
+	for y := 0; y < level.Height; y++ {
+		for x := 0; x < level.Width; x++ {
+			tile, loadedTile := level.GetTile(x, y), loadedLevel.GetTile(x, y)
+			if loadedTile.Type != tile.Type || loadedTile.Explored != tile.Explored || loadedTile.Visible != tile.Visible {
+				t.Fatalf("Tile (%d,%d) mismatch: expected %+v, got %+v", x, y, *tile, *loadedTile)
+			}
+			if lit := level.IsLit(x, y); decoded.DungeonData.Floors[level.FloorNumber].Tiles[y][x].Lit != lit || loadedLevel.IsLit(x, y) != lit {
+				t.Fatalf("Tile (%d,%d) lit mismatch: expected %v", x, y, lit)
+			} else if !lit {
+				sawUnlit = true
+			}
+		}
+	}
+	if !sawUnlit {
+		t.Error("Expected the world to contain unlit tiles")
+	}
+
+	if len(loadedLevel.Rooms) != len(level.Rooms) {
+		t.Fatalf("Room count mismatch: expected %d, got %d", len(level.Rooms), len(loadedLevel.Rooms))
+	}
+	for i := range level.Rooms {
+		if *loadedLevel.Rooms[i] != *level.Rooms[i] {
+			t.Errorf("Room %d mismatch: expected %+v, got %+v", i, *level.Rooms[i], *loadedLevel.Rooms[i])
+		}
+	}
+
+	// Monsters
+	if len(loadedLevel.Monsters) != len(level.Monsters) {
+		t.Fatalf("Monster count mismatch: expected %d, got %d", len(level.Monsters), len(loadedLevel.Monsters))
+	}
+	for i, monster := range level.Monsters {
+		loaded := loadedLevel.Monsters[i]
+		if *loaded.Position != *monster.Position || loaded.HP != monster.HP || loaded.Type.Symbol != monster.Type.Symbol {
+			t.Errorf("Monster %d mismatch: expected %c at %v with %d HP, got %c at %v with %d HP", i,
+				monster.Type.Symbol, *monster.Position, monster.HP, loaded.Type.Symbol, *loaded.Position, loaded.HP)
+		}
+		if loaded.AIState != monster.AIState || loaded.AlertLevel != monster.AlertLevel || loaded.LastPlayerPos != monster.LastPlayerPos {
+			t.Errorf("Monster %d AI state mismatch", i)
+		}
+		if loaded.LastEffect != monster.LastEffect {
+			t.Errorf("Monster %d last effect mismatch: expected %v, got %v", i, monster.LastEffect, loaded.LastEffect)
+		}
+	}
+
+	// Items
+	if len(loadedLevel.Items) != len(level.Items) {
+		t.Fatalf("Item count mismatch: expected %d, got %d", len(level.Items), len(loadedLevel.Items))
+	}
+	for i, itm := range level.Items {
+		loaded := loadedLevel.Items[i]
+		if loaded.Name != itm.Name || *loaded.Position != *itm.Position || loaded.IsCursed != itm.IsCursed || loaded.IsBlessed != itm.IsBlessed {
+			t.Errorf("Item %d mismatch: expected %s at %v, got %s at %v", i, itm.Name, *itm.Position, loaded.Name, *loaded.Position)
+		}
+	}
+}
+
 // TestSaveConverter_ConvertSaveItem tests save item conversion
 func TestSaveConverter_ConvertSaveItem(t *testing.T) {
 	converter := NewSaveConverter()
@@ -12,7 +12,8 @@ import (
 )
 
 // SaveVersion represents the save file format version
-const SaveVersion = "1.0.0"
+// 1.1.0: item appearance tables are stored with the player
+const SaveVersion = "1.1.0"
 
 // SaveData represents the complete game state
 type SaveData struct {
@@ -55,15 +56,31 @@ type Player struct {
 	Exp     int `json:"exp"`
 	Gold    int `json:"gold"`
 
+	// Character class (empty in saves made before classes existed)
+	Class string `json:"class,omitempty"`
+
 	// Inventory
 	Inventory []InventoryItem `json:"inventory"`
 	Equipment Equipment       `json:"equipment"`
 
 	// Identification system
-	IdentifiedItems map[string]bool `json:"identified_items"`
+	IdentifiedItems map[string]bool   `json:"identified_items"`
+	CalledItems     map[string]string `json:"called_items,omitempty"`
+	Appearances     *ItemAppearances  `json:"appearances,omitempty"`
 
-	// Status effects (for future expansion)
+	// Status effects
 	StatusEffects []StatusEffect `json:"status_effects"`
+
+	// Cause of death (empty while alive)
+	KilledBy string `json:"killed_by,omitempty"`
+}
+
+// ItemAppearances represents the randomized appearance of each item kind
+type ItemAppearances struct {
+	Scrolls map[string]string `json:"scrolls"`
+	Potions map[string]string `json:"potions"`
+	Rings   map[string]string `json:"rings"`
+	Wands   map[string]string `json:"wands,omitempty"`
 }
 
 // InventoryItem represents an item in the player's inventory
@@ -77,6 +94,8 @@ type InventoryItem struct {
 	IsCursed     bool   `json:"is_cursed"`
 	IsBlessed    bool   `json:"is_blessed"`
 	Slot         int    `json:"slot"` // Inventory slot (0-25 for a-z)
+	Symbol       rune   `json:"symbol,omitempty"`
+	Color        int    `json:"color,omitempty"`
 }
 
 // Equipment represents the player's equipped items
@@ -169,6 +188,7 @@ type Monster struct {
 	OriginalPosY   int    `json:"original_pos_y"`
 	ViewRange      int    `json:"view_range"`
 	DetectionRange int    `json:"detection_range"`
+	LastEffect     string `json:"last_effect,omitempty"` // Special effect of the last attack
 }
 
 // Pos represents a position coordinate
@@ -230,6 +250,15 @@ type Stats struct {
 
 	// Turn count
 	TurnCount int `json:"turn_count"`
+
+	// Item usage by kind (achievements)
+	ScrollsRead    int  `json:"scrolls_read"`
+	PotionsQuaffed int  `json:"potions_quaffed"`
+	ArmorWorn      bool `json:"armor_worn"` // 一度でも鎧を着たか
+
+	// Per-kind counters (lifetime statistics)
+	MonsterKills map[string]int `json:"monster_kills,omitempty"` // モンスター名ごとの撃破数
+	ItemUses     map[string]int `json:"item_uses,omitempty"`     // アイテム名ごとの使用回数
 }
 
 // Settings represents game settings
@@ -264,6 +293,7 @@ type SaveMetadata struct {
 	IsVictory   bool      `json:"is_victory"`
 	Seed        int64     `json:"seed"`
 	SlotNumber  int       `json:"slot_number"`
+	Format      string    `json:"format,omitempty"` // Save codec name
 }
 
 // ConversionHelpers for converting between save format and game objects
@@ -300,88 +330,85 @@ func ConvertPlayerToSave(player *actor.Player) Player {
 		Hunger:          player.Hunger,
 		Exp:             player.Exp,
 		Gold:            player.Gold,
+		Class:           string(player.Class),
 		Inventory:       make([]InventoryItem, 0),
 		Equipment:       Equipment{},
 		IdentifiedItems: make(map[string]bool),
+		CalledItems:     make(map[string]string),
 		StatusEffects:   make([]StatusEffect, 0),
+		KilledBy:        player.KilledBy,
+	}
+
+	// Convert status effects
+	for _, effect := range player.StatusEffects {
+		savePlayer.StatusEffects = append(savePlayer.StatusEffects, StatusEffect{
+			Type:      effect.Type,
+			Duration:  effect.Duration,
+			Intensity: effect.Intensity,
+			Source:    effect.Source,
+		})
+	}
+
+	// Convert identification state
+	if player.IdentifyMgr != nil {
+		savePlayer.IdentifiedItems = player.IdentifyMgr.GetIdentifiedItems()
+		savePlayer.CalledItems = player.IdentifyMgr.GetCalledNames()
+
+		appearances := player.IdentifyMgr.GetAppearances()
+		savePlayer.Appearances = &ItemAppearances{
+			Scrolls: appearances.Scrolls,
+			Potions: appearances.Potions,
+			Rings:   appearances.Rings,
+			Wands:   appearances.Wands,
+		}
 	}
 
 	// Convert inventory
 	for i, item := range player.Inventory.Items {
-		saveItem := InventoryItem{
-			Type:         ConvertItemTypeToString(item.Type),
-			Name:         item.Name,
-			RealName:     item.RealName,
-			Value:        item.Value,
-			Quantity:     item.Quantity,
-			IsIdentified: item.IsIdentified,
-			IsCursed:     item.IsCursed,
-			IsBlessed:    item.IsBlessed,
-			Slot:         i,
-		}
-		savePlayer.Inventory = append(savePlayer.Inventory, saveItem)
+		savePlayer.Inventory = append(savePlayer.Inventory, ConvertItemToInventoryItem(item, i))
 	}
 
 	// Convert equipment
 	if player.Equipment.Weapon != nil {
-		savePlayer.Equipment.Weapon = &InventoryItem{
-			Type:         ConvertItemTypeToString(player.Equipment.Weapon.Type),
-			Name:         player.Equipment.Weapon.Name,
-			RealName:     player.Equipment.Weapon.RealName,
-			Value:        player.Equipment.Weapon.Value,
-			Quantity:     player.Equipment.Weapon.Quantity,
-			IsIdentified: player.Equipment.Weapon.IsIdentified,
-			IsCursed:     player.Equipment.Weapon.IsCursed,
-			IsBlessed:    player.Equipment.Weapon.IsBlessed,
-		}
+		weapon := ConvertItemToInventoryItem(player.Equipment.Weapon, 0)
+		savePlayer.Equipment.Weapon = &weapon
 	}
 
 	if player.Equipment.Armor != nil {
-		savePlayer.Equipment.Armor = &InventoryItem{
-			Type:         ConvertItemTypeToString(player.Equipment.Armor.Type),
-			Name:         player.Equipment.Armor.Name,
-			RealName:     player.Equipment.Armor.RealName,
-			Value:        player.Equipment.Armor.Value,
-			Quantity:     player.Equipment.Armor.Quantity,
-			IsIdentified: player.Equipment.Armor.IsIdentified,
-			IsCursed:     player.Equipment.Armor.IsCursed,
-			IsBlessed:    player.Equipment.Armor.IsBlessed,
-		}
+		armor := ConvertItemToInventoryItem(player.Equipment.Armor, 0)
+		savePlayer.Equipment.Armor = &armor
 	}
 
 	if player.Equipment.RingLeft != nil {
-		savePlayer.Equipment.RingLeft = &InventoryItem{
-			Type:         ConvertItemTypeToString(player.Equipment.RingLeft.Type),
-			Name:         player.Equipment.RingLeft.Name,
-			RealName:     player.Equipment.RingLeft.RealName,
-			Value:        player.Equipment.RingLeft.Value,
-			Quantity:     player.Equipment.RingLeft.Quantity,
-			IsIdentified: player.Equipment.RingLeft.IsIdentified,
-			IsCursed:     player.Equipment.RingLeft.IsCursed,
-			IsBlessed:    player.Equipment.RingLeft.IsBlessed,
-		}
+		ring := ConvertItemToInventoryItem(player.Equipment.RingLeft, 0)
+		savePlayer.Equipment.RingLeft = &ring
 	}
 
 	if player.Equipment.RingRight != nil {
-		savePlayer.Equipment.RingRight = &InventoryItem{
-			Type:         ConvertItemTypeToString(player.Equipment.RingRight.Type),
-			Name:         player.Equipment.RingRight.Name,
-			RealName:     player.Equipment.RingRight.RealName,
-			Value:        player.Equipment.RingRight.Value,
-			Quantity:     player.Equipment.RingRight.Quantity,
-			IsIdentified: player.Equipment.RingRight.IsIdentified,
-			IsCursed:     player.Equipment.RingRight.IsCursed,
-			IsBlessed:    player.Equipment.RingRight.IsBlessed,
-		}
+		ring := ConvertItemToInventoryItem(player.Equipment.RingRight, 0)
+		savePlayer.Equipment.RingRight = &ring
 	}
 
-	// Convert identified items
-	// This would need to be implemented based on the identification system
-	// For now, we'll leave it as an empty map
-
 	return savePlayer
 }
 
+// ConvertItemToInventoryItem converts a carried item to save format
+func ConvertItemToInventoryItem(itm *item.Item, slot int) InventoryItem {
+	return InventoryItem{
+		Type:         ConvertItemTypeToString(itm.Type),
+		Name:         itm.Name,
+		RealName:     itm.RealName,
+		Value:        itm.Value,
+		Quantity:     itm.Quantity,
+		IsIdentified: itm.IsIdentified,
+		IsCursed:     itm.IsCursed,
+		IsBlessed:    itm.IsBlessed,
+		Slot:         slot,
+		Symbol:       itm.Symbol,
+		Color:        int(itm.Color),
+	}
+}
+
 // ConvertDungeonToSave converts dungeon manager to save format
 func ConvertDungeonToSave(dungeonManager *dungeon.DungeonManager) Dungeon {
 	saveDungeon := Dungeon{
@@ -396,9 +423,7 @@ func ConvertDungeonToSave(dungeonManager *dungeon.DungeonManager) Dungeon {
 		if level := dungeonManager.GetFloorLevel(floorNum); level != nil {
 			saveDungeon.Floors[floorNum] = ConvertLevelToSave(level)
 			saveDungeon.VisitedFloors[floorNum] = true
-			// Floor seeds would need to be stored in the dungeon manager
-			// For now, we'll generate a placeholder
-			saveDungeon.FloorSeeds[floorNum] = int64(floorNum * 1000)
+			saveDungeon.FloorSeeds[floorNum] = level.Seed
 		}
 	}
 
@@ -416,7 +441,7 @@ func ConvertLevelToSave(level *dungeon.Level) *Floor {
 		Monsters:    make([]Monster, 0),
 		Items:       make([]Item, 0),
 		Visited:     true,
-		Seed:        int64(level.FloorNumber * 1000), // Placeholder
+		Seed:        level.Seed,
 		IsGenerated: true,
 		IsMaze:      level.FloorNumber == 7 || level.FloorNumber == 13 || level.FloorNumber == 19,
 		IsSpecial:   level.FloorNumber%5 == 0,
@@ -428,11 +453,12 @@ func ConvertLevelToSave(level *dungeon.Level) *Floor {
 		for x := 0; x < level.Width; x++ {
 			tile := level.GetTile(x, y)
 			if tile != nil {
+				// Rune, Color, IsWalkable はタイル種別から復元できる
 				saveFloor.Tiles[y][x] = Tile{
 					Type:     ConvertTileTypeToString(tile.Type),
-					Explored: true, // Placeholder - would need visibility system
-					Lit:      true, // Placeholder - would need lighting system
-					Visible:  true, // Placeholder - would need FOV system
+					Explored: tile.Explored,
+					Lit:      level.IsLit(x, y),
+					Visible:  tile.Visible,
 				}
 			}
 		}
@@ -447,7 +473,7 @@ func ConvertLevelToSave(level *dungeon.Level) *Floor {
 			Height:    room.Height,
 			IsSpecial: room.IsSpecial,
 			Connected: room.Connected,
-			RoomType:  "", // Placeholder for room type
+			RoomType:  room.Type,
 		}
 		saveFloor.Rooms = append(saveFloor.Rooms, saveRoom)
 	}
@@ -458,14 +484,14 @@ func ConvertLevelToSave(level *dungeon.Level) *Floor {
 			X:              monster.Position.X,
 			Y:              monster.Position.Y,
 			Type:           string(monster.Type.Symbol),
-			Symbol:         monster.Type.Symbol,
+			Symbol:         monster.Symbol,
 			Name:           monster.Type.Name,
 			HP:             monster.HP,
 			MaxHP:          monster.MaxHP,
 			Attack:         monster.Attack,
 			Defense:        monster.Defense,
 			Speed:          monster.Type.Speed,
-			Color:          int(monster.Type.Color),
+			Color:          int(monster.Color),
 			TurnCount:      monster.TurnCount,
 			IsActive:       monster.IsActive,
 			AIState:        ConvertAIStateToString(monster.AIState),
@@ -479,6 +505,7 @@ func ConvertLevelToSave(level *dungeon.Level) *Floor {
 			OriginalPosY:   monster.OriginalPos.Y,
 			ViewRange:      monster.ViewRange,
 			DetectionRange: monster.DetectionRange,
+			LastEffect:     ConvertSpecialEffectToString(monster.LastEffect),
 		}
 		saveFloor.Monsters = append(saveFloor.Monsters, saveMonster)
 	}
@@ -540,6 +567,16 @@ func ConvertTileTypeToString(tileType dungeon.TileType) string {
 		return "floor"
 	case dungeon.TileDoor:
 		return "door"
+	case dungeon.TileDoorClosed:
+		return "door_closed"
+	case dungeon.TileDoorOpen:
+		return "door_open"
+	case dungeon.TileOpenDoor:
+		return "open_door"
+	case dungeon.TileWater:
+		return "water"
+	case dungeon.TileLava:
+		return "lava"
 	case dungeon.TileSecretDoor:
 		return "secret_door"
 	case dungeon.TileStairsUp:
@@ -571,6 +608,11 @@ func ConvertAIStateToString(aiState actor.AIState) string {
 	}
 }
 
+// ConvertSpecialEffectToString converts a monster special effect to string (empty for none)
+func ConvertSpecialEffectToString(effect actor.SpecialEffect) string {
+	return effect.ID()
+}
+
 // ConvertPatrolPath converts patrol path to save format
 func ConvertPatrolPath(patrolPath []entity.Position) []Pos {
 	savePath := make([]Pos, len(patrolPath))
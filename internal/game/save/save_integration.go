@@ -7,6 +7,7 @@ import (
 
 	"github.com/yuru-sha/gorogue/internal/game/actor"
 	"github.com/yuru-sha/gorogue/internal/game/dungeon"
+	"github.com/yuru-sha/gorogue/internal/i18n"
 	"github.com/yuru-sha/gorogue/internal/utils/logger"
 )
 
@@ -16,23 +17,29 @@ type SaveGameIntegration struct {
 	saveConverter *SaveConverter
 	gameStats     *GameStats
 	autoSave      *AutoSaveManager
+	achievements  *AchievementTracker
 
 	// Game state
 	player         *actor.Player
 	dungeonManager *dungeon.DungeonManager
 	gameInfo       GameInfo
 	settings       Settings
+
+	onAutoSave func() // 自動セーブの機会ごとに呼ばれる
 }
 
 // NewSaveGameIntegration creates a new save game integration
 func NewSaveGameIntegration() *SaveGameIntegration {
-	return &SaveGameIntegration{
+	sgi := &SaveGameIntegration{
 		saveManager:   NewSaveManager(),
 		saveConverter: NewSaveConverter(),
 		gameStats:     NewGameStats(),
 		autoSave:      NewAutoSaveManager(),
+		achievements:  NewAchievementTracker(),
 		settings:      GetDefaultSettings(),
 	}
+	sgi.gameStats.SetListener(sgi.onStatsEvent)
+	return sgi
 }
 
 // Initialize initializes the save game integration
@@ -45,6 +52,11 @@ func (sgi *SaveGameIntegration) Initialize() error {
 		return fmt.Errorf("failed to initialize auto-save: %w", err)
 	}
 
+	// 実績ファイルが壊れていてもゲームは続行する
+	if err := sgi.achievements.Load(); err != nil {
+		logger.Warn("Failed to load achievements", "error", err)
+	}
+
 	logger.Info("Save game integration initialized")
 	return nil
 }
@@ -105,6 +117,7 @@ func (sgi *SaveGameIntegration) LoadGame(slot int) error {
 
 	// Update game stats
 	sgi.gameStats.LoadStats(saveData.GameStats)
+	sgi.achievements.SetPlayer(sgi.player, sgi.gameInfo.CharName)
 
 	logger.Info("Game loaded successfully",
 		"slot", slot,
@@ -131,12 +144,26 @@ func (sgi *SaveGameIntegration) QuickLoad() error {
 
 // AutoSave performs an automatic save
 func (sgi *SaveGameIntegration) AutoSave() error {
+	// ゲーム外の記録（モンスター図鑑など）は自動セーブが無効でも保存する
+	if sgi.onAutoSave != nil {
+		sgi.onAutoSave()
+	}
 	if !sgi.settings.AutoSave {
 		return nil
 	}
 
+	saveData, err := sgi.currentSaveData()
+	if err != nil {
+		return err
+	}
+
+	return sgi.autoSave.AutoSave(saveData)
+}
+
+// currentSaveData builds save data from the live game state
+func (sgi *SaveGameIntegration) currentSaveData() (*SaveData, error) {
 	if sgi.player == nil || sgi.dungeonManager == nil {
-		return fmt.Errorf("game state not set")
+		return nil, fmt.Errorf("game state not set")
 	}
 
 	// Update game info
@@ -144,15 +171,13 @@ func (sgi *SaveGameIntegration) AutoSave() error {
 	sgi.gameInfo.TurnCount = sgi.gameStats.GetTurnCount()
 
 	// Create save data
-	saveData := ToSaveData(
+	return ToSaveData(
 		sgi.player,
 		sgi.dungeonManager,
 		sgi.gameInfo,
 		sgi.gameStats.GetStats(),
 		sgi.settings,
-	)
-
-	return sgi.autoSave.AutoSave(saveData)
+	), nil
 }
 
 // HasAutoSave checks if an auto-save exists
@@ -160,6 +185,17 @@ func (sgi *SaveGameIntegration) HasAutoSave() bool {
 	return sgi.autoSave.HasAutoSave()
 }
 
+// IsAutoSaveCompleted reports whether the auto-save is of a finished game, without loading it
+func (sgi *SaveGameIntegration) IsAutoSaveCompleted() bool {
+	metadata, err := sgi.saveManager.GetSaveMetadata(AutoSaveSlot)
+	return err == nil && metadata.IsCompleted
+}
+
+// HasAnySave checks if any manual save slot is used
+func (sgi *SaveGameIntegration) HasAnySave() bool {
+	return sgi.saveManager.HasAnySave()
+}
+
 // LoadAutoSave loads the auto-save
 func (sgi *SaveGameIntegration) LoadAutoSave() error {
 	// Load auto-save data
@@ -182,6 +218,7 @@ func (sgi *SaveGameIntegration) LoadAutoSave() error {
 
 	// Update game stats
 	sgi.gameStats.LoadStats(saveData.GameStats)
+	sgi.achievements.SetPlayer(sgi.player, sgi.gameInfo.CharName)
 
 	logger.Info("Auto-save loaded successfully",
 		"char_name", sgi.gameInfo.CharName,
@@ -196,6 +233,7 @@ func (sgi *SaveGameIntegration) LoadAutoSave() error {
 func (sgi *SaveGameIntegration) SetGameState(player *actor.Player, dungeonManager *dungeon.DungeonManager) {
 	sgi.player = player
 	sgi.dungeonManager = dungeonManager
+	sgi.achievements.SetPlayer(player, sgi.gameInfo.CharName)
 }
 
 // GetGameState returns the current game state
@@ -206,6 +244,7 @@ func (sgi *SaveGameIntegration) GetGameState() (*actor.Player, *dungeon.DungeonM
 // SetGameInfo sets the game information
 func (sgi *SaveGameIntegration) SetGameInfo(gameInfo GameInfo) {
 	sgi.gameInfo = gameInfo
+	sgi.achievements.SetPlayer(sgi.player, gameInfo.CharName)
 }
 
 // GetGameInfo returns the game information
@@ -228,6 +267,40 @@ func (sgi *SaveGameIntegration) GetGameStats() *GameStats {
 	return sgi.gameStats
 }
 
+// GetAchievementTracker returns the achievement tracker
+func (sgi *SaveGameIntegration) GetAchievementTracker() *AchievementTracker {
+	return sgi.achievements
+}
+
+// SetOnAchievementUnlocked sets the callback invoked when an achievement is unlocked
+func (sgi *SaveGameIntegration) SetOnAchievementUnlocked(onUnlock func(achievement Achievement)) {
+	sgi.achievements.SetOnUnlock(onUnlock)
+}
+
+// SetOnAutoSave sets the callback invoked at every auto-save point
+func (sgi *SaveGameIntegration) SetOnAutoSave(onAutoSave func()) {
+	sgi.onAutoSave = onAutoSave
+}
+
+// MarkWizardGame records that wizard mode or a cheat was used in this game
+// 一度付いた印は外れないので、以後のスコア・通算統計・実績の対象にならない
+func (sgi *SaveGameIntegration) MarkWizardGame() {
+	if sgi.gameInfo.IsWizard {
+		return
+	}
+	sgi.gameInfo.IsWizard = true
+	logger.Info("Game marked as a wizard mode game", "char_name", sgi.gameInfo.CharName)
+}
+
+// onStatsEvent forwards game events to the achievement tracker
+func (sgi *SaveGameIntegration) onStatsEvent(event StatsEvent, stats Stats) {
+	// ウィザードモードのプレイでは実績を解除しない
+	if sgi.gameInfo.IsWizard {
+		return
+	}
+	sgi.achievements.HandleEvent(event, stats)
+}
+
 // DeleteSave deletes a save file
 func (sgi *SaveGameIntegration) DeleteSave(slot int) error {
 	return sgi.saveManager.DeleteSave(slot)
@@ -251,16 +324,26 @@ func (sgi *SaveGameIntegration) GetAllSaveInfo() map[int]string {
 		if info, err := sgi.saveManager.GetSaveSlotInfo(slot); err == nil {
 			result[slot] = info
 		} else {
-			result[slot] = "Empty"
+			result[slot] = i18n.T("save.empty")
 		}
 	}
 
 	return result
 }
 
-// ExportSave exports a save file
-func (sgi *SaveGameIntegration) ExportSave(slot int, path string) error {
-	return sgi.saveManager.ExportSave(slot, path)
+// ExportSave exports a save file (an empty codec name keeps the stored format)
+func (sgi *SaveGameIntegration) ExportSave(slot int, path string, codecName string) error {
+	return sgi.saveManager.ExportSave(slot, path, codecName)
+}
+
+// SetSaveCodec selects the codec used for new save files
+func (sgi *SaveGameIntegration) SetSaveCodec(codecName string) error {
+	codec, err := GetSaveCodec(codecName)
+	if err != nil {
+		return err
+	}
+	sgi.saveManager.SetCodec(codec)
+	return nil
 }
 
 // ImportSave imports a save file
@@ -300,11 +383,22 @@ func (sgi *SaveGameIntegration) OnPlayerVictory() {
 	sgi.gameInfo.IsCompleted = true
 	sgi.gameInfo.IsVictory = true
 
-	if err := sgi.AutoSave(); err != nil {
+	saveData, err := sgi.currentSaveData()
+	if err != nil {
+		logger.Error("Failed to save victory state", "error", err)
+		return
+	}
+
+	if err := sgi.autoSave.SaveOnVictory(saveData); err != nil {
 		logger.Error("Failed to save victory state", "error", err)
 	}
 }
 
+// OnAmuletFound handles picking up the Amulet of Yendor
+func (sgi *SaveGameIntegration) OnAmuletFound() {
+	sgi.gameStats.OnAmuletFound()
+}
+
 // OnTurnEnd handles end of turn processing
 func (sgi *SaveGameIntegration) OnTurnEnd() {
 	sgi.gameStats.OnTurnEnd()
@@ -321,11 +415,9 @@ func (sgi *SaveGameIntegration) OnTurnEnd() {
 func (sgi *SaveGameIntegration) OnFloorChange(newFloor int) {
 	sgi.gameStats.OnFloorChange(newFloor)
 
-	// Auto-save on floor change if enabled
-	if sgi.settings.AutoSave {
-		if err := sgi.AutoSave(); err != nil {
-			logger.Error("Auto-save on floor change failed", "error", err)
-		}
+	// Auto-save on floor change (AutoSave checks the setting)
+	if err := sgi.AutoSave(); err != nil {
+		logger.Error("Auto-save on floor change failed", "error", err)
 	}
 }
 
@@ -344,6 +436,31 @@ func (sgi *SaveGameIntegration) OnItemUsed(item string) {
 	sgi.gameStats.OnItemUsed(item)
 }
 
+// OnScrollRead handles reading a scroll
+func (sgi *SaveGameIntegration) OnScrollRead(item string) {
+	sgi.gameStats.OnScrollRead(item)
+}
+
+// OnPotionQuaffed handles drinking a potion
+func (sgi *SaveGameIntegration) OnPotionQuaffed(item string) {
+	sgi.gameStats.OnPotionQuaffed(item)
+}
+
+// OnLevelUp handles the player gaining an experience level
+func (sgi *SaveGameIntegration) OnLevelUp(newLevel int) {
+	sgi.gameStats.OnLevelUp(newLevel)
+}
+
+// OnArmorWorn handles the player putting on armor
+func (sgi *SaveGameIntegration) OnArmorWorn() {
+	sgi.gameStats.OnArmorWorn()
+}
+
+// OnSecretFound handles finding a secret door or passage
+func (sgi *SaveGameIntegration) OnSecretFound() {
+	sgi.gameStats.OnSecretFound()
+}
+
 // OnDamageDealt handles damage dealt
 func (sgi *SaveGameIntegration) OnDamageDealt(damage int) {
 	sgi.gameStats.OnDamageDealt(damage)
@@ -430,9 +547,9 @@ func (sgi *SaveGameIntegration) RepairSave(slot int) error {
 }
 
 // CreateNewGame creates a new game with the specified parameters
-func (sgi *SaveGameIntegration) CreateNewGame(charName string, seed int64) error {
-	// Create new player
-	player := actor.NewPlayer(0, 0)
+func (sgi *SaveGameIntegration) CreateNewGame(charName string, class actor.Class, seed int64) error {
+	// Create new player with the class's stats and starting kit
+	player := actor.NewPlayerWithClass(0, 0, class)
 
 	// Create new dungeon manager
 	dungeonManager := dungeon.NewDungeonManager(player)
@@ -464,9 +581,15 @@ func (sgi *SaveGameIntegration) CreateNewGame(charName string, seed int64) error
 
 	// Reset game stats
 	sgi.gameStats.Reset()
+	sgi.achievements.SetPlayer(player, charName)
+	if player.Equipment.Armor != nil {
+		// 職業の初期装備の鎧も着たうちに入る
+		sgi.gameStats.OnArmorWorn()
+	}
 
 	logger.Info("New game created",
 		"char_name", charName,
+		"class", class,
 		"seed", seed,
 		"player_pos", fmt.Sprintf("(%d,%d)", player.Position.X, player.Position.Y),
 	)
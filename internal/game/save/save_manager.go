@@ -1,5 +1,5 @@
 // Package save セーブファイル管理システム
-// JSON形式でのセーブデータ永続化、バージョン管理、整合性チェック機能を提供
+// セーブデータの永続化（JSON/gzip/バイナリ）、バージョン管理、整合性チェック機能を提供
 package save
 
 import (
@@ -13,6 +13,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/yuru-sha/gorogue/internal/i18n"
 	"github.com/yuru-sha/gorogue/internal/utils/logger"
 )
 
@@ -23,9 +24,6 @@ const (
 	// MaxSaveSlots is the maximum number of save slots
 	MaxSaveSlots = 3
 
-	// SaveFileExtension is the file extension for save files
-	SaveFileExtension = ".json"
-
 	// MetadataExtension is the file extension for metadata files
 	MetadataExtension = ".meta"
 
@@ -38,10 +36,10 @@ const (
 
 // SaveManager manages save file operations
 type SaveManager struct {
-	saveDir            string
-	compressionEnabled bool
-	backupEnabled      bool
-	maxBackups         int
+	saveDir       string
+	codec         SaveCodec
+	backupEnabled bool
+	maxBackups    int
 }
 
 // NewSaveManager creates a new save manager
@@ -54,10 +52,10 @@ func NewSaveManager() *SaveManager {
 	saveDir := filepath.Join(homeDir, ".gorogue", SaveDirectory)
 
 	return &SaveManager{
-		saveDir:            saveDir,
-		compressionEnabled: false, // JSON is readable, no compression for now
-		backupEnabled:      true,
-		maxBackups:         3,
+		saveDir:       saveDir,
+		codec:         &JSONCodec{}, // JSON is readable by default
+		backupEnabled: true,
+		maxBackups:    3,
 	}
 }
 
@@ -74,7 +72,7 @@ func (sm *SaveManager) Initialize() error {
 
 	logger.Info("Save manager initialized",
 		"save_dir", sm.saveDir,
-		"compression", sm.compressionEnabled,
+		"codec", sm.codec.Name(),
 		"backup", sm.backupEnabled,
 	)
 
@@ -92,13 +90,16 @@ func (sm *SaveManager) SaveGame(saveData *SaveData, slot int) error {
 	saveData.GameInfo.SaveSlot = slot
 
 	// Generate file paths
-	saveFile := sm.getSaveFilePath(slot)
+	// 形式を変えたときは拡張子も変わるので、既存のファイルとは別のパスになる
+	existingFile := sm.getSaveFilePath(slot)
+	saveFile := sm.getSaveFilePathWithCodec(slot, sm.codec)
 	metadataFile := sm.getMetadataFilePath(slot)
 	backupFile := sm.getBackupFilePath(slot)
+	exists := sm.FileExists(slot)
 
 	// Create backup if enabled and file exists
-	if sm.backupEnabled && sm.FileExists(slot) {
-		if err := sm.createBackup(saveFile, backupFile); err != nil {
+	if sm.backupEnabled && exists {
+		if err := sm.createBackup(existingFile, backupFile); err != nil {
 			logger.Warn("Failed to create backup",
 				"slot", slot,
 				"error", err,
@@ -111,8 +112,19 @@ func (sm *SaveManager) SaveGame(saveData *SaveData, slot int) error {
 		return fmt.Errorf("failed to write save data: %w", err)
 	}
 
+	// Remove the file written in the previous format
+	if exists && existingFile != saveFile {
+		if err := os.Remove(existingFile); err != nil {
+			logger.Warn("Failed to remove previous save file",
+				"file", existingFile,
+				"error", err,
+			)
+		}
+	}
+
 	// Write metadata
 	metadata := sm.createMetadata(saveData)
+	metadata.Format = sm.codec.Name()
 	if err := sm.writeMetadata(metadata, metadataFile); err != nil {
 		logger.Warn("Failed to write metadata",
 			"slot", slot,
@@ -275,7 +287,7 @@ func (sm *SaveManager) GetAllSaveMetadata() map[int]*SaveMetadata {
 // GetSaveSlotInfo returns formatted information about a save slot
 func (sm *SaveManager) GetSaveSlotInfo(slot int) (string, error) {
 	if !sm.FileExists(slot) {
-		return "Empty", nil
+		return i18n.T("save.empty"), nil
 	}
 
 	metadata, err := sm.GetSaveMetadata(slot)
@@ -287,16 +299,16 @@ func (sm *SaveManager) GetSaveSlotInfo(slot int) (string, error) {
 	savedTime := metadata.SavedAt.Format("2006-01-02 15:04")
 
 	// Create status string
-	status := "Active"
+	status := i18n.T("save.status_active")
 	if metadata.IsCompleted {
 		if metadata.IsVictory {
-			status = "Victory"
+			status = i18n.T("save.status_victory")
 		} else {
-			status = "Defeated"
+			status = i18n.T("save.status_defeat")
 		}
 	}
 
-	return fmt.Sprintf("%s - Level %d, Floor %d - %s - %s",
+	return i18n.T("save.slot_info",
 		metadata.CharName,
 		metadata.Level,
 		metadata.Floor,
@@ -336,21 +348,39 @@ func (sm *SaveManager) GetSaveFileSize(slot int) (int64, error) {
 }
 
 // ExportSave exports a save file to the specified path
-func (sm *SaveManager) ExportSave(slot int, exportPath string) error {
+// An empty codec name copies the file in its stored format
+func (sm *SaveManager) ExportSave(slot int, exportPath string, codecName string) error {
 	if !sm.FileExists(slot) {
 		return fmt.Errorf("save file does not exist for slot %d", slot)
 	}
 
 	saveFile := sm.getSaveFilePath(slot)
 
-	// Copy file
-	if err := sm.copyFile(saveFile, exportPath); err != nil {
-		return fmt.Errorf("failed to export save file: %w", err)
+	if codecName == "" {
+		// Copy file
+		if err := sm.copyFile(saveFile, exportPath); err != nil {
+			return fmt.Errorf("failed to export save file: %w", err)
+		}
+	} else {
+		codec, err := GetSaveCodec(codecName)
+		if err != nil {
+			return err
+		}
+
+		saveData, err := sm.readSaveData(saveFile)
+		if err != nil {
+			return fmt.Errorf("failed to read save file: %w", err)
+		}
+
+		if err := sm.writeSaveDataWithCodec(saveData, exportPath, codec); err != nil {
+			return fmt.Errorf("failed to export save file: %w", err)
+		}
 	}
 
 	logger.Info("Save exported successfully",
 		"slot", slot,
 		"export_path", exportPath,
+		"codec", codecName,
 	)
 
 	return nil
@@ -393,8 +423,26 @@ func (sm *SaveManager) ImportSave(importPath string, slot int) error {
 // Private methods
 
 // getSaveFilePath returns the full path to the save file
+// An existing file is found whatever codec wrote it; otherwise the path uses the current codec
 func (sm *SaveManager) getSaveFilePath(slot int) string {
-	filename := fmt.Sprintf("save_%d%s", slot, SaveFileExtension)
+	current := sm.getSaveFilePathWithCodec(slot, sm.codec)
+	if _, err := os.Stat(current); err == nil {
+		return current
+	}
+
+	for _, ext := range saveFileExtensions() {
+		path := filepath.Join(sm.saveDir, fmt.Sprintf("save_%d%s", slot, ext))
+		if _, err := os.Stat(path); err == nil {
+			return path
+		}
+	}
+
+	return current
+}
+
+// getSaveFilePathWithCodec returns the path of the save file written by codec
+func (sm *SaveManager) getSaveFilePathWithCodec(slot int, codec SaveCodec) string {
+	filename := fmt.Sprintf("save_%d%s", slot, codec.Extension())
 	return filepath.Join(sm.saveDir, filename)
 }
 
@@ -411,8 +459,13 @@ func (sm *SaveManager) getBackupFilePath(slot int) string {
 	return filepath.Join(sm.saveDir, filename)
 }
 
-// writeSaveData writes save data to file
+// writeSaveData writes save data to file using the configured codec
 func (sm *SaveManager) writeSaveData(saveData *SaveData, filename string) error {
+	return sm.writeSaveDataWithCodec(saveData, filename, sm.codec)
+}
+
+// writeSaveDataWithCodec writes save data to file using the given codec
+func (sm *SaveManager) writeSaveDataWithCodec(saveData *SaveData, filename string, codec SaveCodec) error {
 	// Create directory if it doesn't exist
 	if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
 		return err
@@ -425,12 +478,15 @@ func (sm *SaveManager) writeSaveData(saveData *SaveData, filename string) error
 	if err != nil {
 		return err
 	}
-	defer file.Close()
 
-	// Write JSON data
-	encoder := json.NewEncoder(file)
-	encoder.SetIndent("", "  ") // Pretty print JSON
-	if err := encoder.Encode(saveData); err != nil {
+	// Encode save data
+	if err := codec.Encode(file, saveData); err != nil {
+		file.Close()
+		os.Remove(tempFile)
+		return err
+	}
+
+	if err := file.Close(); err != nil {
 		os.Remove(tempFile)
 		return err
 	}
@@ -452,13 +508,29 @@ func (sm *SaveManager) readSaveData(filename string) (*SaveData, error) {
 	}
 	defer file.Close()
 
-	var saveData SaveData
-	decoder := json.NewDecoder(file)
-	if err := decoder.Decode(&saveData); err != nil {
+	// Detect format from the file header
+	doc, _, err := DecodeSaveDocument(file)
+	if err != nil {
 		return nil, err
 	}
 
-	return &saveData, nil
+	// 旧バージョンのドキュメントはデコード前に移行する
+	if _, err := MigrateDocument(doc, false); err != nil {
+		return nil, fmt.Errorf("failed to migrate save data: %w", err)
+	}
+
+	return documentToSaveData(doc)
+}
+
+// readSaveDocument reads a raw save document and the codec it was stored with, without migrating it
+func (sm *SaveManager) readSaveDocument(filename string) (Document, SaveCodec, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer file.Close()
+
+	return DecodeSaveDocument(file)
 }
 
 // writeMetadata writes metadata to file
@@ -555,21 +627,28 @@ func (sm *SaveManager) verifySaveData(saveData *SaveData) error {
 
 // checkVersionCompatibility checks if the save file version is compatible
 func (sm *SaveManager) checkVersionCompatibility(saveData *SaveData) error {
-	// Simple version check - in a real implementation, this would be more sophisticated
+	// readSaveData migrates older documents, so any mismatch left here cannot be loaded
 	if saveData.Version != SaveVersion {
-		// For now, we'll accept any version and attempt to load
-		logger.Warn("Save file version mismatch",
-			"save_version", saveData.Version,
-			"current_version", SaveVersion,
-		)
-
-		// Future: implement version migration logic here
-		return nil
+		return fmt.Errorf("unsupported save version %s (current version %s)", saveData.Version, SaveVersion)
 	}
 
 	return nil
 }
 
+// PreviewMigration reports the migrations a slot would need without changing the file
+func (sm *SaveManager) PreviewMigration(slot int) (*MigrationReport, error) {
+	if !sm.FileExists(slot) {
+		return nil, fmt.Errorf("save file does not exist for slot %d", slot)
+	}
+
+	doc, _, err := sm.readSaveDocument(sm.getSaveFilePath(slot))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read save document: %w", err)
+	}
+
+	return MigrateDocument(doc, true)
+}
+
 // createBackup creates a backup of the save file
 func (sm *SaveManager) createBackup(source, backup string) error {
 	return sm.copyFile(source, backup)
@@ -656,6 +735,40 @@ func (sm *SaveManager) calculateChecksum(filename string) (string, error) {
 	return fmt.Sprintf("%x", hash.Sum(nil)), nil
 }
 
+// SetCodec sets the codec used for writing save files
+func (sm *SaveManager) SetCodec(codec SaveCodec) {
+	if codec != nil {
+		sm.codec = codec
+	}
+}
+
+// GetCodec returns the codec used for writing save files
+func (sm *SaveManager) GetCodec() SaveCodec {
+	return sm.codec
+}
+
+// GetSaveFormat returns the codec name of the stored save file
+func (sm *SaveManager) GetSaveFormat(slot int) (string, error) {
+	file, err := os.Open(sm.getSaveFilePath(slot))
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	header := make([]byte, codecHeaderSize)
+	n, err := io.ReadFull(file, header)
+	if err != nil && err != io.ErrUnexpectedEOF {
+		return "", err
+	}
+
+	codec, err := DetectSaveCodec(header[:n])
+	if err != nil {
+		return "", err
+	}
+
+	return codec.Name(), nil
+}
+
 // GetSaveDirectory returns the save directory path
 func (sm *SaveManager) GetSaveDirectory() string {
 	return sm.saveDir
@@ -683,6 +796,16 @@ func (sm *SaveManager) GetUsedSlots() []int {
 	return used
 }
 
+// HasAnySave reports whether any manual save slot holds a save file
+func (sm *SaveManager) HasAnySave() bool {
+	for slot := 0; slot < MaxSaveSlots; slot++ {
+		if sm.FileExists(slot) {
+			return true
+		}
+	}
+	return false
+}
+
 // ValidateSlot validates a save slot number
 func (sm *SaveManager) ValidateSlot(slot int) error {
 	if slot < 0 || (slot >= MaxSaveSlots && slot != AutoSaveSlot) {
@@ -714,6 +837,11 @@ func (sm *SaveManager) GetSaveInfo(slot int) (map[string]interface{}, error) {
 		checksum = "unknown"
 	}
 
+	format, err := sm.GetSaveFormat(slot)
+	if err != nil {
+		format = "unknown"
+	}
+
 	return map[string]interface{}{
 		"slot":         slot,
 		"char_name":    metadata.CharName,
@@ -727,12 +855,20 @@ func (sm *SaveManager) GetSaveInfo(slot int) (map[string]interface{}, error) {
 		"saved_at":     metadata.SavedAt,
 		"file_size":    info.Size(),
 		"checksum":     checksum,
+		"format":       format,
 		"file_path":    saveFile,
 	}, nil
 }
 
-// RepairSave attempts to repair a corrupted save file using backup
+// RepairSave attempts to repair a save file by migrating it, falling back to the latest backup
 func (sm *SaveManager) RepairSave(slot int) error {
+	// 旧バージョンのファイルは移行だけで読めるようになることがある
+	err := sm.repairByMigration(slot)
+	if err == nil {
+		return nil
+	}
+	logger.Debug("Save migration did not repair file", "slot", slot, "error", err)
+
 	if !sm.backupEnabled {
 		return fmt.Errorf("backup is disabled, cannot repair save")
 	}
@@ -780,6 +916,52 @@ func (sm *SaveManager) RepairSave(slot int) error {
 	return nil
 }
 
+// repairByMigration migrates an older save file and rewrites it in the current version.
+// A file that is already current is only verified, and a migrated file keeps its format.
+func (sm *SaveManager) repairByMigration(slot int) error {
+	saveFile := sm.getSaveFilePath(slot)
+
+	doc, codec, err := sm.readSaveDocument(saveFile)
+	if err != nil {
+		return err
+	}
+
+	report, err := MigrateDocument(doc, false)
+	if err != nil {
+		return fmt.Errorf("failed to migrate save data: %w", err)
+	}
+
+	saveData, err := documentToSaveData(doc)
+	if err != nil {
+		return err
+	}
+
+	if err := sm.verifySaveData(saveData); err != nil {
+		return err
+	}
+
+	if !report.NeedsMigration() {
+		return nil
+	}
+
+	if err := sm.writeSaveDataWithCodec(saveData, saveFile, codec); err != nil {
+		return fmt.Errorf("failed to rewrite migrated save: %w", err)
+	}
+
+	metadata := sm.createMetadata(saveData)
+	metadata.Format = codec.Name()
+	if err := sm.writeMetadata(metadata, sm.getMetadataFilePath(slot)); err != nil {
+		logger.Warn("Failed to update metadata after migration", "slot", slot, "error", err)
+	}
+
+	logger.Info("Save file repaired by migration",
+		"slot", slot,
+		"version", saveData.Version,
+	)
+
+	return nil
+}
+
 // GetDiskUsage returns the total disk usage of save files
 func (sm *SaveManager) GetDiskUsage() (int64, error) {
 	var totalSize int64
@@ -795,7 +977,7 @@ func (sm *SaveManager) GetDiskUsage() (int64, error) {
 		}
 
 		name := entry.Name()
-		if strings.HasSuffix(name, SaveFileExtension) ||
+		if isSaveFileName(name) ||
 			strings.HasSuffix(name, MetadataExtension) ||
 			strings.HasSuffix(name, BackupExtension) {
 
@@ -344,7 +344,7 @@ func TestSaveManager_ExportImport(t *testing.T) {
 
 	// Export save
 	exportPath := filepath.Join(tempDir, "exported_save.json")
-	if err := sm.ExportSave(slot, exportPath); err != nil {
+	if err := sm.ExportSave(slot, exportPath, ""); err != nil {
 		t.Errorf("ExportSave failed: %v", err)
 	}
 
@@ -866,3 +866,67 @@ func TestSaveManager_ConcurrentAccess(t *testing.T) {
 		}
 	}
 }
+
+// TestSaveManager_HasAnySave tests that only used manual slots count as saves
+func TestSaveManager_HasAnySave(t *testing.T) {
+	logger.Setup()
+	sm := NewSaveManager()
+	sm.saveDir = t.TempDir()
+	if err := sm.Initialize(); err != nil {
+		t.Fatalf("Initialize failed: %v", err)
+	}
+
+	if sm.HasAnySave() {
+		t.Error("Expected no saves in an empty directory")
+	}
+
+	// オートセーブだけではロードできるセーブとは数えない
+	testSaveData := createTestSaveData(t)
+	if err := sm.AutoSave(testSaveData); err != nil {
+		t.Fatalf("AutoSave failed: %v", err)
+	}
+	if sm.HasAnySave() {
+		t.Error("The auto-save should not count as a manual save")
+	}
+
+	if err := sm.SaveGame(testSaveData, 1); err != nil {
+		t.Fatalf("SaveGame failed: %v", err)
+	}
+	if !sm.HasAnySave() {
+		t.Error("Expected slot 1 to count as a save")
+	}
+}
+
+// TestSaveGameIntegration_IsAutoSaveCompleted tests reading the finished flag without loading the auto-save
+func TestSaveGameIntegration_IsAutoSaveCompleted(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	logger.Setup()
+
+	sgi := NewSaveGameIntegration()
+	if err := sgi.Initialize(); err != nil {
+		t.Fatalf("Initialize failed: %v", err)
+	}
+	if sgi.IsAutoSaveCompleted() {
+		t.Error("Expected no completed auto-save before saving")
+	}
+
+	testSaveData := createTestSaveData(t)
+	if err := sgi.saveManager.AutoSave(testSaveData); err != nil {
+		t.Fatalf("AutoSave failed: %v", err)
+	}
+	if sgi.IsAutoSaveCompleted() {
+		t.Error("An auto-save of a game in progress should not be completed")
+	}
+
+	testSaveData.GameInfo.IsCompleted = true
+	testSaveData.GameInfo.IsVictory = true
+	if err := sgi.saveManager.AutoSave(testSaveData); err != nil {
+		t.Fatalf("AutoSave failed: %v", err)
+	}
+	if !sgi.IsAutoSaveCompleted() {
+		t.Error("Expected the victorious auto-save to be completed")
+	}
+	if sgi.GetGameInfo().IsCompleted {
+		t.Error("Checking the auto-save should not load it")
+	}
+}
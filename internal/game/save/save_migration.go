@@ -0,0 +1,279 @@
+// Package save セーブデータのスキーマ移行
+// 旧バージョンのセーブドキュメントを段階的に現行バージョンへ変換する
+package save
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/yuru-sha/gorogue/internal/utils/logger"
+)
+
+// Document is a raw save document before it is decoded into SaveData
+type Document map[string]interface{}
+
+// Migration transforms a raw save document from one version to the next
+type Migration struct {
+	From        string
+	To          string
+	Description string
+	Apply       func(doc Document, step *MigrationStep) error
+}
+
+// MigrationStep records what a single migration changed
+type MigrationStep struct {
+	From        string   `json:"from"`
+	To          string   `json:"to"`
+	Description string   `json:"description"`
+	Changes     []string `json:"changes"`
+}
+
+// AddChange records a change made by the migration
+func (ms *MigrationStep) AddChange(format string, args ...interface{}) {
+	ms.Changes = append(ms.Changes, fmt.Sprintf(format, args...))
+}
+
+// MigrationReport summarizes the migrations applied to a document
+type MigrationReport struct {
+	FromVersion string          `json:"from_version"`
+	ToVersion   string          `json:"to_version"`
+	DryRun      bool            `json:"dry_run"`
+	Steps       []MigrationStep `json:"steps"`
+}
+
+// NeedsMigration reports whether any migration was (or would be) applied
+func (mr *MigrationReport) NeedsMigration() bool {
+	return len(mr.Steps) > 0
+}
+
+// String returns a human readable report
+func (mr *MigrationReport) String() string {
+	if !mr.NeedsMigration() {
+		return fmt.Sprintf("Save version %s is up to date", mr.FromVersion)
+	}
+
+	var sb strings.Builder
+	mode := "Migrated"
+	if mr.DryRun {
+		mode = "Would migrate"
+	}
+	sb.WriteString(fmt.Sprintf("%s save from %s to %s\n", mode, mr.FromVersion, mr.ToVersion))
+	for _, step := range mr.Steps {
+		sb.WriteString(fmt.Sprintf("  %s -> %s: %s\n", step.From, step.To, step.Description))
+		for _, change := range step.Changes {
+			sb.WriteString(fmt.Sprintf("    - %s\n", change))
+		}
+	}
+	return strings.TrimRight(sb.String(), "\n")
+}
+
+// migrations holds registered migrations keyed by source version
+var migrations = map[string]Migration{}
+
+func init() {
+	RegisterMigration(Migration{
+		From:        "1.0.0",
+		To:          "1.1.0",
+		Description: "identification keys use type:name and appearance tables are stored",
+		Apply:       migrate100To110,
+	})
+}
+
+// RegisterMigration registers a migration step
+func RegisterMigration(migration Migration) {
+	if _, exists := migrations[migration.From]; exists {
+		logger.Warn("Replacing save migration", "from", migration.From, "to", migration.To)
+	}
+	migrations[migration.From] = migration
+}
+
+// GetMigrations returns registered migrations ordered by source version
+func GetMigrations() []Migration {
+	list := make([]Migration, 0, len(migrations))
+	for _, migration := range migrations {
+		list = append(list, migration)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return CompareVersions(list[i].From, list[j].From) < 0
+	})
+	return list
+}
+
+// MigrateDocument upgrades a document to SaveVersion step by step
+// In dry-run mode the document is left untouched and only the report is produced
+func MigrateDocument(doc Document, dryRun bool) (*MigrationReport, error) {
+	version, _ := doc["version"].(string)
+	if version == "" {
+		return nil, fmt.Errorf("save document has no version")
+	}
+
+	report := &MigrationReport{
+		FromVersion: version,
+		ToVersion:   version,
+		DryRun:      dryRun,
+		Steps:       make([]MigrationStep, 0),
+	}
+
+	if CompareVersions(version, SaveVersion) > 0 {
+		return report, fmt.Errorf("save version %s is newer than supported version %s", version, SaveVersion)
+	}
+
+	target := doc
+	if dryRun {
+		target = deepCopyDocument(doc)
+	}
+
+	for version != SaveVersion {
+		migration, exists := migrations[version]
+		if !exists {
+			return report, fmt.Errorf("no migration path from save version %s to %s", version, SaveVersion)
+		}
+
+		step := MigrationStep{
+			From:        migration.From,
+			To:          migration.To,
+			Description: migration.Description,
+			Changes:     make([]string, 0),
+		}
+		if err := migration.Apply(target, &step); err != nil {
+			return report, fmt.Errorf("migration %s -> %s failed: %w", migration.From, migration.To, err)
+		}
+
+		target["version"] = migration.To
+		step.AddChange("version set to %s", migration.To)
+		report.Steps = append(report.Steps, step)
+		report.ToVersion = migration.To
+		version = migration.To
+	}
+
+	if report.NeedsMigration() && !dryRun {
+		logger.Info("Save document migrated",
+			"from", report.FromVersion,
+			"to", report.ToVersion,
+			"steps", len(report.Steps),
+		)
+	}
+
+	return report, nil
+}
+
+// CompareVersions compares dotted version strings (-1, 0 or 1)
+func CompareVersions(a, b string) int {
+	partsA := strings.Split(a, ".")
+	partsB := strings.Split(b, ".")
+	for i := 0; i < len(partsA) || i < len(partsB); i++ {
+		var numA, numB int
+		if i < len(partsA) {
+			numA, _ = strconv.Atoi(partsA[i])
+		}
+		if i < len(partsB) {
+			numB, _ = strconv.Atoi(partsB[i])
+		}
+		if numA != numB {
+			if numA < numB {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+// migrate100To110 converts 1.0.0 documents to the 1.1.0 layout
+func migrate100To110(doc Document, step *MigrationStep) error {
+	player, ok := doc["player"].(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("player data is missing")
+	}
+
+	// 1.0.0 では識別済みキーに種別が付いていなかったので復元できない
+	if identified, ok := player["identified_items"].(map[string]interface{}); ok {
+		for key := range identified {
+			if !strings.Contains(key, ":") {
+				delete(identified, key)
+				step.AddChange("dropped identified item key %q without item type", key)
+			}
+		}
+	}
+
+	// 外見テーブルがないため、外見に紐づくラベルは別の種類に付いてしまう
+	if called, ok := player["called_items"].(map[string]interface{}); ok && len(called) > 0 {
+		delete(player, "called_items")
+		step.AddChange("dropped %d item labels tied to unsaved appearances", len(called))
+	}
+
+	if _, exists := player["appearances"]; !exists {
+		step.AddChange("item appearances will be regenerated on load")
+	}
+
+	return nil
+}
+
+// decodeDocument parses JSON into a raw document, keeping numbers exact
+func decodeDocument(data []byte) (Document, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var doc Document
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, err
+	}
+	if doc == nil {
+		return nil, fmt.Errorf("save document is empty")
+	}
+	return doc, nil
+}
+
+// saveDataToDocument converts save data into a raw document
+func saveDataToDocument(saveData *SaveData) (Document, error) {
+	data, err := json.Marshal(saveData)
+	if err != nil {
+		return nil, err
+	}
+	return decodeDocument(data)
+}
+
+// documentToSaveData decodes a raw document into save data
+func documentToSaveData(doc Document) (*SaveData, error) {
+	data, err := json.Marshal(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	var saveData SaveData
+	if err := json.Unmarshal(data, &saveData); err != nil {
+		return nil, err
+	}
+	return &saveData, nil
+}
+
+// deepCopyDocument returns a deep copy of a raw document
+func deepCopyDocument(doc Document) Document {
+	return deepCopyValue(map[string]interface{}(doc)).(map[string]interface{})
+}
+
+// deepCopyValue copies maps and slices of a raw document recursively
+func deepCopyValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		copied := make(map[string]interface{}, len(v))
+		for key, child := range v {
+			copied[key] = deepCopyValue(child)
+		}
+		return copied
+	case Document:
+		return Document(deepCopyValue(map[string]interface{}(v)).(map[string]interface{}))
+	case []interface{}:
+		copied := make([]interface{}, len(v))
+		for i, child := range v {
+			copied[i] = deepCopyValue(child)
+		}
+		return copied
+	default:
+		return v
+	}
+}
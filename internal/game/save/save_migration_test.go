@@ -0,0 +1,221 @@
+// Package save セーブスキーマ移行のテスト
+// 旧バージョンのドキュメントが段階的に現行バージョンへ変換されることをテスト
+package save
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/yuru-sha/gorogue/internal/utils/logger"
+)
+
+// createLegacyDocument creates a 1.0.0 save document from current save data
+func createLegacyDocument(t *testing.T) Document {
+	t.Helper()
+
+	saveData := createTestSaveData(t)
+	saveData.Version = "1.0.0"
+	saveData.PlayerData.Appearances = nil
+	saveData.PlayerData.IdentifiedItems = map[string]bool{
+		"identify":     true,
+		"potion:sleep": true,
+	}
+	saveData.PlayerData.CalledItems = map[string]string{"potion:blue": "heal?"}
+
+	doc, err := saveDataToDocument(saveData)
+	if err != nil {
+		t.Fatalf("Failed to convert save data to document: %v", err)
+	}
+	return doc
+}
+
+// TestMigrateDocument tests migrating a 1.0.0 document to the current version
+func TestMigrateDocument(t *testing.T) {
+	logger.Setup()
+	doc := createLegacyDocument(t)
+
+	report, err := MigrateDocument(doc, false)
+	if err != nil {
+		t.Fatalf("MigrateDocument failed: %v", err)
+	}
+
+	if report.FromVersion != "1.0.0" || report.ToVersion != SaveVersion {
+		t.Errorf("Unexpected report versions: %s -> %s", report.FromVersion, report.ToVersion)
+	}
+	if !report.NeedsMigration() {
+		t.Fatal("Expected migration steps to be reported")
+	}
+
+	saveData, err := documentToSaveData(doc)
+	if err != nil {
+		t.Fatalf("Failed to decode migrated document: %v", err)
+	}
+
+	if saveData.Version != SaveVersion {
+		t.Errorf("Expected version %s, got %s", SaveVersion, saveData.Version)
+	}
+	if _, exists := saveData.PlayerData.IdentifiedItems["identify"]; exists {
+		t.Error("Identified item key without type should be dropped")
+	}
+	if !saveData.PlayerData.IdentifiedItems["potion:sleep"] {
+		t.Error("Typed identified item key should be kept")
+	}
+	if len(saveData.PlayerData.CalledItems) != 0 {
+		t.Errorf("Expected called items to be dropped, got %v", saveData.PlayerData.CalledItems)
+	}
+	if saveData.GameInfo.Seed != createTestSaveData(t).GameInfo.Seed {
+		t.Error("Seed changed during migration")
+	}
+}
+
+// TestMigrateDocument_DryRun tests that a dry run reports changes without modifying the document
+func TestMigrateDocument_DryRun(t *testing.T) {
+	logger.Setup()
+	doc := createLegacyDocument(t)
+
+	report, err := MigrateDocument(doc, true)
+	if err != nil {
+		t.Fatalf("MigrateDocument failed: %v", err)
+	}
+
+	if !report.DryRun {
+		t.Error("Report should be marked as dry run")
+	}
+	if doc["version"] != "1.0.0" {
+		t.Errorf("Dry run modified document version: %v", doc["version"])
+	}
+
+	player := doc["player"].(map[string]interface{})
+	if _, exists := player["called_items"]; !exists {
+		t.Error("Dry run removed called items from the document")
+	}
+
+	text := report.String()
+	if !strings.Contains(text, "Would migrate save from 1.0.0") {
+		t.Errorf("Unexpected report text: %s", text)
+	}
+	if !strings.Contains(text, `dropped identified item key "identify" without item type`) {
+		t.Errorf("Report does not list dropped key: %s", text)
+	}
+}
+
+// TestMigrateDocument_UnsupportedVersions tests versions without a migration path
+func TestMigrateDocument_UnsupportedVersions(t *testing.T) {
+	logger.Setup()
+
+	for _, version := range []string{"0.9.0", "99.0.0", ""} {
+		doc := Document{"version": version, "player": map[string]interface{}{}}
+		if _, err := MigrateDocument(doc, false); err == nil {
+			t.Errorf("Expected error for version %q", version)
+		}
+	}
+
+	// Current version needs no steps
+	report, err := MigrateDocument(Document{"version": SaveVersion}, false)
+	if err != nil {
+		t.Fatalf("MigrateDocument failed for current version: %v", err)
+	}
+	if report.NeedsMigration() {
+		t.Error("Current version should not need migration")
+	}
+}
+
+// TestSaveManager_LegacySave tests loading, previewing and repairing a 1.0.0 save file
+func TestSaveManager_LegacySave(t *testing.T) {
+	logger.Setup()
+
+	tempDir, err := os.MkdirTemp("", "gorogue_test_*")
+	if err != nil {
+		t.Fatalf("Failed to create temp directory: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	sm := NewSaveManager()
+	sm.saveDir = tempDir
+	if err := sm.Initialize(); err != nil {
+		t.Fatalf("Initialize failed: %v", err)
+	}
+
+	legacy, err := documentToSaveData(createLegacyDocument(t))
+	if err != nil {
+		t.Fatalf("Failed to decode legacy document: %v", err)
+	}
+	if err := sm.writeSaveDataWithCodec(legacy, sm.getSaveFilePath(0), &GzipJSONCodec{}); err != nil {
+		t.Fatalf("Failed to write legacy save: %v", err)
+	}
+	sm.codec = &BinaryCodec{}
+
+	// Preview does not touch the file
+	report, err := sm.PreviewMigration(0)
+	if err != nil {
+		t.Fatalf("PreviewMigration failed: %v", err)
+	}
+	if !report.NeedsMigration() || !report.DryRun {
+		t.Errorf("Unexpected preview report: %+v", report)
+	}
+
+	// Load migrates in memory
+	loadedData, err := sm.LoadGame(0)
+	if err != nil {
+		t.Fatalf("LoadGame failed: %v", err)
+	}
+	if loadedData.Version != SaveVersion {
+		t.Errorf("Expected loaded version %s, got %s", SaveVersion, loadedData.Version)
+	}
+
+	// Repair rewrites the file in the current version without a backup
+	if err := sm.RepairSave(0); err != nil {
+		t.Fatalf("RepairSave failed: %v", err)
+	}
+	report, err = sm.PreviewMigration(0)
+	if err != nil {
+		t.Fatalf("PreviewMigration after repair failed: %v", err)
+	}
+	if report.NeedsMigration() {
+		t.Errorf("Repaired save should be up to date, got %s", report.String())
+	}
+
+	// The rewritten file keeps the format it was stored in
+	_, codec, err := sm.readSaveDocument(sm.getSaveFilePath(0))
+	if err != nil {
+		t.Fatalf("Failed to read repaired save: %v", err)
+	}
+	if codec.Name() != CodecGzipJSON {
+		t.Errorf("Expected repaired save to stay %s, got %s", CodecGzipJSON, codec.Name())
+	}
+}
+
+// TestSaveManager_RepairCurrentSave tests that repairing a healthy current save leaves the file alone
+func TestSaveManager_RepairCurrentSave(t *testing.T) {
+	logger.Setup()
+
+	sm := NewSaveManager()
+	sm.saveDir = t.TempDir()
+	if err := sm.Initialize(); err != nil {
+		t.Fatalf("Initialize failed: %v", err)
+	}
+
+	saveFile := sm.getSaveFilePath(0)
+	if err := sm.writeSaveData(createTestSaveData(t), saveFile); err != nil {
+		t.Fatalf("Failed to write save: %v", err)
+	}
+	before, err := os.ReadFile(saveFile)
+	if err != nil {
+		t.Fatalf("Failed to read save: %v", err)
+	}
+
+	sm.codec = &BinaryCodec{}
+	if err := sm.RepairSave(0); err != nil {
+		t.Fatalf("RepairSave failed: %v", err)
+	}
+
+	after, err := os.ReadFile(saveFile)
+	if err != nil {
+		t.Fatalf("Failed to read save after repair: %v", err)
+	}
+	if !bytes.Equal(before, after) {
+		t.Error("Repairing a current save should not rewrite it")
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/yuru-sha/gorogue/internal/game/actor"
 	"github.com/yuru-sha/gorogue/internal/game/save"
+	"github.com/yuru-sha/gorogue/internal/i18n"
 )
 
 // ScoreCalculator はスコア計算を行う
@@ -282,6 +283,7 @@ func (sc *ScoreCalculator) CreateScoreEntry(playerName string, player *actor.Pla
 	
 	return ScoreEntry{
 		PlayerName:     playerName,
+		Class:          string(player.Class),
 		Score:          score,
 		Level:          player.Level,
 		DeepestFloor:   stats.DeepestFloor,
@@ -322,16 +324,16 @@ func (sc *ScoreCalculator) IsTimePenaltyEnabled() bool {
 // FormatPlayTime はプレイ時間を見やすい形式でフォーマットする
 func FormatPlayTime(seconds int64) string {
 	if seconds < 60 {
-		return fmt.Sprintf("%d秒", seconds)
+		return i18n.T("score.seconds", seconds)
 	} else if seconds < 3600 {
 		minutes := seconds / 60
 		secs := seconds % 60
-		return fmt.Sprintf("%d分%d秒", minutes, secs)
+		return i18n.T("score.minutes", minutes, secs)
 	} else {
 		hours := seconds / 3600
 		minutes := (seconds % 3600) / 60
 		secs := seconds % 60
-		return fmt.Sprintf("%d時間%d分%d秒", hours, minutes, secs)
+		return i18n.T("score.hours", hours, minutes, secs)
 	}
 }
 
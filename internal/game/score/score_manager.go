@@ -27,6 +27,7 @@ const (
 // ScoreEntry はスコア情報を表す構造体
 type ScoreEntry struct {
 	PlayerName     string    `json:"player_name"`
+	Class          string    `json:"class,omitempty"` // 職業（古い記録では空）
 	Score          int       `json:"score"`
 	Level          int       `json:"level"`
 	DeepestFloor   int       `json:"deepest_floor"`
@@ -39,6 +40,7 @@ type ScoreEntry struct {
 	GameSeed       int64     `json:"game_seed"`
 	Timestamp      time.Time `json:"timestamp"`
 	Version        string    `json:"version"`
+	Breakdown      *ScoreBreakdown `json:"breakdown,omitempty"` // 記録時のスコア内訳
 }
 
 // ScoreFile はスコアファイルの構造体
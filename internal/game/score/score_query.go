@@ -0,0 +1,120 @@
+// Package score スコアの検索
+// 勝利のみ・プレイヤー名・バージョンでスコアを絞り込む
+package score
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/yuru-sha/gorogue/internal/game/actor"
+	"github.com/yuru-sha/gorogue/internal/game/save"
+	"github.com/yuru-sha/gorogue/internal/i18n"
+)
+
+// ScoreFilter はスコア検索の条件
+type ScoreFilter struct {
+	VictoryOnly bool   `json:"victory_only,omitempty"`
+	PlayerName  string `json:"player_name,omitempty"` // 大文字小文字を区別しない部分一致
+	Version     string `json:"version,omitempty"`
+	Limit       int    `json:"limit,omitempty"` // 0 は無制限
+}
+
+// IsEmpty reports whether the filter has no conditions
+func (f ScoreFilter) IsEmpty() bool {
+	return !f.VictoryOnly && f.PlayerName == "" && f.Version == ""
+}
+
+// Matches reports whether the entry satisfies the filter
+func (f ScoreFilter) Matches(entry ScoreEntry) bool {
+	if f.VictoryOnly && !entry.IsVictory {
+		return false
+	}
+	if f.PlayerName != "" && !strings.Contains(strings.ToLower(entry.PlayerName), strings.ToLower(f.PlayerName)) {
+		return false
+	}
+	if f.Version != "" && entry.Version != f.Version {
+		return false
+	}
+	return true
+}
+
+// String returns a short description of the filter
+func (f ScoreFilter) String() string {
+	if f.IsEmpty() {
+		return "all"
+	}
+
+	parts := make([]string, 0, 3)
+	if f.VictoryOnly {
+		parts = append(parts, "victories")
+	}
+	if f.PlayerName != "" {
+		parts = append(parts, fmt.Sprintf("player=%s", f.PlayerName))
+	}
+	if f.Version != "" {
+		parts = append(parts, fmt.Sprintf("version=%s", f.Version))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// ResultText describes how the game ended
+func (e ScoreEntry) ResultText() string {
+	if e.IsVictory {
+		return i18n.T("score.escaped")
+	}
+	if e.DeathReason == "" {
+		return i18n.T("score.died")
+	}
+	return i18n.T("score.killed_by", actor.DeathCauseName(e.DeathReason))
+}
+
+// RankedEntry はスコア表全体での順位付きのスコア
+type RankedEntry struct {
+	Rank  int        `json:"rank"`
+	Entry ScoreEntry `json:"entry"`
+}
+
+// QueryScores はフィルタに一致するスコアを全体順位付きで返す
+func (sm *ScoreManager) QueryScores(filter ScoreFilter) ([]RankedEntry, error) {
+	scoreFile, err := sm.readScoreFile()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read score file: %w", err)
+	}
+
+	results := make([]RankedEntry, 0)
+	for i, entry := range scoreFile.Entries {
+		if !filter.Matches(entry) {
+			continue
+		}
+		results = append(results, RankedEntry{Rank: i + 1, Entry: entry})
+		if filter.Limit > 0 && len(results) >= filter.Limit {
+			break
+		}
+	}
+
+	return results, nil
+}
+
+// GetVersions はスコアファイルに含まれるバージョンを新しい順に返す
+func (sm *ScoreManager) GetVersions() ([]string, error) {
+	scoreFile, err := sm.readScoreFile()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read score file: %w", err)
+	}
+
+	seen := make(map[string]bool)
+	versions := make([]string, 0)
+	for _, entry := range scoreFile.Entries {
+		if entry.Version == "" || seen[entry.Version] {
+			continue
+		}
+		seen[entry.Version] = true
+		versions = append(versions, entry.Version)
+	}
+
+	sort.Slice(versions, func(i, j int) bool {
+		return save.CompareVersions(versions[i], versions[j]) > 0
+	})
+	return versions, nil
+}
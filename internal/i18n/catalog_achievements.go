@@ -0,0 +1,31 @@
+package i18n
+
+// 実績の名前と説明（キーは実績の ID）
+func init() {
+	register(map[string]text{
+		"achievement.first_blood":        {ja: "初陣", en: "First Blood"},
+		"achievement.first_blood.desc":   {ja: "モンスターを初めて倒す", en: "Kill your first monster"},
+		"achievement.dragon_slayer":      {ja: "竜殺し", en: "Dragon Slayer"},
+		"achievement.dragon_slayer.desc": {ja: "ドラゴンを倒す", en: "Kill a dragon"},
+		"achievement.exterminator":       {ja: "殲滅者", en: "Exterminator"},
+		"achievement.exterminator.desc":  {ja: "1回のゲームでモンスターを100体倒す", en: "Kill 100 monsters in a single game"},
+		"achievement.deep_diver":         {ja: "深淵へ", en: "Deep Diver"},
+		"achievement.deep_diver.desc":    {ja: "10階に到達する", en: "Reach floor 10"},
+		"achievement.skinny_dipper":      {ja: "裸一貫", en: "Skinny Dipper"},
+		"achievement.skinny_dipper.desc": {ja: "鎧を着ずに10階に到達する", en: "Reach floor 10 without wearing armor"},
+		"achievement.rock_bottom":        {ja: "最深部", en: "Rock Bottom"},
+		"achievement.rock_bottom.desc":   {ja: "最下層に到達する", en: "Reach the deepest floor"},
+		"achievement.veteran":            {ja: "歴戦の勇士", en: "Veteran"},
+		"achievement.veteran.desc":       {ja: "経験レベル10に到達する", en: "Reach experience level 10"},
+		"achievement.hoarder":            {ja: "蓄財家", en: "Hoarder"},
+		"achievement.hoarder.desc":       {ja: "1回のゲームで1000ゴールドを集める", en: "Collect 1000 gold in a single game"},
+		"achievement.amulet_bearer":      {ja: "魔除けの担い手", en: "Amulet Bearer"},
+		"achievement.amulet_bearer.desc": {ja: "イェンダーの魔除けを拾う", en: "Pick up the Amulet of Yendor"},
+		"achievement.champion":           {ja: "覇者", en: "Champion"},
+		"achievement.champion.desc":      {ja: "イェンダーの魔除けを持ってダンジョンから脱出する", en: "Escape the dungeon with the Amulet of Yendor"},
+		"achievement.illiterate":         {ja: "読まずの勝利", en: "Illiterate"},
+		"achievement.illiterate.desc":    {ja: "巻物を読まずに勝利する", en: "Win without reading a scroll"},
+		"achievement.teetotaler":         {ja: "下戸", en: "Teetotaler"},
+		"achievement.teetotaler.desc":    {ja: "薬を飲まずに勝利する", en: "Win without drinking a potion"},
+	})
+}
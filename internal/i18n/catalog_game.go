@@ -0,0 +1,120 @@
+package i18n
+
+// ゲーム進行中のメッセージ（行動・戦闘・階層移動・調べるモード）
+func init() {
+	register(map[string]text{
+		// 行動
+		"game.rest":                  {ja: "休憩した。", en: "You rest."},
+		"game.search":                {ja: "辺りを調べた。", en: "You search the area."},
+		"game.cannot_drink":          {ja: "それは飲めない！", en: "You can't drink that!"},
+		"game.cannot_read":           {ja: "それは読めない！", en: "You can't read that!"},
+		"game.cannot_eat":            {ja: "それは食べられない！", en: "You can't eat that!"},
+		"game.no_door":               {ja: "そこに閉じた扉はない。", en: "There is no closed door there."},
+		"game.open_door":             {ja: "扉を開けた。", en: "You open the door."},
+		"game.nothing_to_pick_up":    {ja: "ここには拾うものがない。", en: "There is nothing here to pick up."},
+		"game.pack_full":             {ja: "荷物がいっぱいだ！", en: "Your pack is full!"},
+		"game.invalid_selection":     {ja: "その選択は無効だ。", en: "Invalid selection."},
+		"game.eat_food":              {ja: "食料を食べて満腹になった。", en: "You eat the food and feel satisfied."},
+		"game.cannot_equip":          {ja: "そのアイテムは装備できない。", en: "You can't equip that item."},
+		"game.equipped":              {ja: "%sを装備した。", en: "You equipped %s."},
+		"game.not_equipped":          {ja: "%sを装備していない。", en: "You have no %s equipped."},
+		"game.took_off":              {ja: "%sを外した。", en: "You took off %s."},
+		"game.no_dungeon":            {ja: "ダンジョンが利用できない", en: "Dungeon manager not available"},
+		"game.escape_needs_stairs":   {ja: "地上へ出るには階段の上に立つ必要がある", en: "You must stand on the stairs to return to the surface."},
+		"game.no_upstairs":           {ja: "ここには上り階段がない", en: "There are no up stairs here."},
+		"game.no_downstairs":         {ja: "ここには下り階段がない", en: "There are no down stairs here."},
+		"game.final_floor":           {ja: "この階層には強力な魔力を感じる...", en: "You sense powerful magic on this floor..."},
+		"game.floor_down":            {ja: "階層 %d へ下りた", en: "You descend to floor %d."},
+		"game.floor_up":              {ja: "階層 %d へ上がった", en: "You climb up to floor %d."},
+		"game.level_up":              {ja: "レベル%dに上がった！", en: "Welcome to level %d!"},
+		"game.nothing_to_repeat":     {ja: "繰り返すコマンドがない。", en: "Nothing to repeat."},
+		"game.count_canceled":        {ja: "回数指定を取り消した。", en: "Count canceled."},
+		"game.unknown_command":       {ja: "不明なコマンド: %s", en: "Unknown command: %s"},
+		"game.not_implemented":       {ja: "%sはまだ実装されていない。", en: "%s is not implemented yet."},
+		"game.welcome":               {ja: "PyRogue へようこそ！", en: "Welcome to PyRogue!"},
+		"game.welcome_move":          {ja: "viキー (hjkl)、矢印キー、テンキー (1-9) で移動する。", en: "Use vi keys (hjkl), arrow keys, or numpad (1-9) to move."},
+		"game.welcome_class":         {ja: "%[2]sの%[1]sよ、ようこそ。", en: "Hello %[1]s, welcome to the dungeon as a %[2]s."},
+		"game.welcome_equipment":     {ja: "武器は%s、鎧は%sを身に着けている。", en: "You are wielding %s and wearing %s."},
+		"game.welcome_pack":          {ja: "荷物には%d個の品が入っている（i で確認）。", en: "Your pack holds %d items (press i to look)."},
+		"game.welcome_room":          {ja: "明るい部屋にいる。", en: "You see a lit room."},
+		"game.welcome_quest":         {ja: "ダンジョンに入った。冒険の始まりだ！", en: "You enter the dungeon. Your quest begins!"},
+		"game.amulet_power":          {ja: "不思議な力が流れ込んでくる。地上へ戻れ！", en: "You feel a strange power flowing through you. Now return to the surface!"},
+		"game.achievement_unlocked":  {ja: "実績解除: %s！", en: "Achievement unlocked: %s!"},
+		"game.saved_to_slot":         {ja: "スロット%dにセーブした。", en: "Game saved to slot %d."},
+		"game.loaded_from_slot":      {ja: "スロット%dからロードした。", en: "Game loaded from slot %d."},
+		"game.welcome_back":          {ja: "おかえりなさい！オートセーブから再開する。", en: "Welcome back! Continuing from the auto-save."},
+		"slot.weapon":                {ja: "武器", en: "weapon"},
+		"slot.armor":                 {ja: "鎧", en: "armor"},
+		"slot.ring_left":             {ja: "左手の指輪", en: "left ring"},
+		"slot.ring_right":            {ja: "右手の指輪", en: "right ring"},
+		"combat.player_hits":         {ja: "%sに%dのダメージを与えた！", en: "You hit the %s for %d damage!"},
+		"combat.monster_killed":      {ja: "%sを倒した！", en: "You defeated the %s!"},
+		"combat.rewards":             {ja: "%d経験値、%dゴールドを得た", en: "You gain %d experience and %d gold."},
+		"combat.player_damaged":      {ja: "%sの攻撃で%dのダメージを受けた！", en: "The %s hits you for %d damage!"},
+		"combat.player_damaged_bare": {ja: "%dのダメージを受けた！", en: "You take %d damage!"},
+
+		// モンスター以外の死因（KilledBy に保存する識別名）
+		"death.starvation": {ja: "飢え", en: "starvation"},
+		"death.poison":     {ja: "毒", en: "poison"},
+		"death.unknown":    {ja: "不明な原因", en: "unknown causes"},
+
+		// 自動探索・移動先への移動・走る・繰り返しの停止理由
+		"stop.explored":    {ja: "行ける場所はすべて探索した。", en: "Explored everything reachable."},
+		"stop.monster":     {ja: "モンスターが見える。", en: "You see a monster."},
+		"stop.damaged":     {ja: "傷を負った！", en: "You are hurt!"},
+		"stop.item":        {ja: "アイテムを見つけた。", en: "You found an item."},
+		"stop.blocked":     {ja: "道がふさがっている。", en: "Your way is blocked."},
+		"stop.limit":       {ja: "少し立ち止まった。", en: "You stop for a moment."},
+		"stop.dead":        {ja: "途中で力尽きた。", en: "You died on the way."},
+		"stop.arrived":     {ja: "到着した。", en: "You arrive."},
+		"stop.no_path":     {ja: "そこへの道が分からない。", en: "You don't know a way there."},
+		"stop.junction":    {ja: "通路が分かれている。", en: "The corridor branches."},
+		"stop.door":        {ja: "出入口に着いた。", en: "You reach a doorway."},
+		"stop.stairs":      {ja: "階段に着いた。", en: "You reach a staircase."},
+		"stop.finished":    {ja: "終わった。", en: "Done."},
+		"stop.interrupted": {ja: "邪魔が入った。", en: "You are interrupted."},
+		"stop.other":       {ja: "立ち止まった。", en: "You stop."},
+
+		// 調べるモード
+		"look.you_are_here":    {ja: "あなたがいる。", en: "You are here."},
+		"look.monster":         {ja: "モンスター: %s（%s、%s）", en: "Monster: %s (%s, %s)"},
+		"look.item":            {ja: "アイテム: %s", en: "Item: %s"},
+		"look.unhurt":          {ja: "無傷", en: "unhurt"},
+		"look.lightly_wounded": {ja: "軽傷", en: "lightly wounded"},
+		"look.wounded":         {ja: "負傷", en: "wounded"},
+		"look.badly_wounded":   {ja: "重傷", en: "badly wounded"},
+		"look.almost_dead":     {ja: "瀕死", en: "almost dead"},
+		"look.sleeping":        {ja: "眠っている", en: "sleeping"},
+		"look.hunting":         {ja: "追ってくる", en: "hunting"},
+		"look.fleeing":         {ja: "逃げている", en: "fleeing"},
+		"look.wandering":       {ja: "うろついている", en: "wandering"},
+		"look.prompt":          {ja: "どこを調べる？（カーソル移動、Tab/+ 次の対象、- 前の対象、ESC で終了）", en: "Look at what? (move cursor, Tab/+ next target, - previous, ESC to stop)"},
+		"look.nothing":         {ja: "調べる価値のあるものはない。", en: "There is nothing interesting to look at."},
+		"look.recall":          {ja: "図鑑: %s", en: "Recall: %s"},
+		"look.killed":          {ja: "倒した数: %d", en: "Killed: %d"},
+		"terrain.floor":        {ja: "床", en: "floor"},
+		"terrain.wall":         {ja: "壁", en: "wall"},
+		"terrain.closed_door":  {ja: "閉じた扉", en: "closed door"},
+		"terrain.open_door":    {ja: "開いた扉", en: "open door"},
+		"terrain.stairs_up":    {ja: "上り階段", en: "staircase up"},
+		"terrain.stairs_down":  {ja: "下り階段", en: "staircase down"},
+		"terrain.water":        {ja: "水", en: "water"},
+		"terrain.lava":         {ja: "溶岩", en: "lava"},
+		"terrain.unknown":      {ja: "不明", en: "unknown"},
+
+		// ウィザードモード
+		"wizard.unknown_command": {ja: "不明なウィザードコマンド", en: "Unknown wizard command"},
+		"wizard.help":            {ja: "ウィザードモード: h=ヘルプ g=ゴールド l=レベルアップ r=回復 f=満腹 k=全モンスター撃破 s=ステータス i=アイテム作成 t=テレポート v=視界切替 w=壁通り抜け", en: "Wizard mode: h=help g=gold l=level up r=heal f=food k=kill all s=stats i=create item t=teleport v=toggle vision w=walk through walls"},
+		"wizard.gold":            {ja: "1000ゴールドを取得しました", en: "You receive 1000 gold."},
+		"wizard.level_up":        {ja: "レベルアップしました", en: "You gain a level."},
+		"wizard.healed":          {ja: "完全回復しました", en: "You are fully healed."},
+		"wizard.fed":             {ja: "満腹になりました", en: "You are no longer hungry."},
+		"wizard.killed_all":      {ja: "すべてのモンスター（%d体）を倒しました", en: "Killed all monsters (%d)."},
+		"wizard.stats":           {ja: "Lv:%d HP:%d/%d 攻:%d 防:%d 経験:%d 金:%d 満腹:%d", en: "Lv:%d HP:%d/%d Atk:%d Def:%d Exp:%d Gold:%d Hunger:%d"},
+		"wizard.created":         {ja: "%sを作成しました", en: "Created %s."},
+		"wizard.no_teleport":     {ja: "テレポート先がありません", en: "There is nowhere to teleport to."},
+		"wizard.teleported":      {ja: "テレポートしました", en: "You teleport."},
+		"wizard.vision":          {ja: "視界を切り替えました", en: "Vision toggled."},
+		"wizard.walls":           {ja: "壁通り抜けモード（未実装）", en: "Walk through walls (not implemented yet)"},
+	})
+}
@@ -0,0 +1,28 @@
+package i18n_test
+
+import (
+	"testing"
+
+	"github.com/yuru-sha/gorogue/internal/game/actor"
+	"github.com/yuru-sha/gorogue/internal/i18n"
+)
+
+// TestCatalogCoversGameIDs tests that every identifier the game stores has a catalog key
+func TestCatalogCoversGameIDs(t *testing.T) {
+	var ids []string
+	for _, effect := range []actor.SpecialEffect{actor.EffectPoison, actor.EffectDrain, actor.EffectStealGold, actor.EffectStealItem} {
+		ids = append(ids, "ability."+effect.ID())
+	}
+	for _, cause := range []string{actor.DeathStarvation, actor.DeathPoison, actor.DeathUnknown} {
+		ids = append(ids, "death."+cause)
+	}
+	for symbol := range actor.MonsterTypes {
+		ids = append(ids, "monster."+string(symbol))
+	}
+
+	for _, id := range ids {
+		if !i18n.Has(id) {
+			t.Errorf("Missing catalog key %s", id)
+		}
+	}
+}
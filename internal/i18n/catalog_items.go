@@ -0,0 +1,210 @@
+package i18n
+
+// アイテムの名前と見た目（キーはアイテムの識別名）
+// 識別名は保存データや効果の判定に使うため、表示するときだけ翻訳する
+func init() {
+	register(map[string]text{
+		// 表示名の書式
+		"item.scroll_of":      {ja: "%sの巻物", en: "scroll of %s"},
+		"item.scroll_called":  {ja: "「%s」と名付けた巻物", en: "scroll called \"%s\""},
+		"item.scroll_titled":  {ja: "「%s」と書かれた巻物", en: "scroll titled \"%s\""},
+		"item.potion_of":      {ja: "%sの薬", en: "potion of %s"},
+		"item.potion_called":  {ja: "「%s」と名付けた薬", en: "potion called \"%s\""},
+		"item.potion_colored": {ja: "%s薬", en: "%s potion"},
+		"item.unknown_potion": {ja: "正体不明の薬", en: "unknown potion"},
+		"item.ring_of":        {ja: "%sの指輪", en: "ring of %s"},
+		"item.ring_called":    {ja: "「%s」と名付けた指輪", en: "ring called \"%s\""},
+		"item.ring_material":  {ja: "%s指輪", en: "%s ring"},
+		"item.unknown_ring":   {ja: "正体不明の指輪", en: "unknown ring"},
+		"item.gold_pieces":    {ja: "%d枚の金貨", en: "%d gold pieces"},
+		"item.none":           {ja: "なし", en: "None"},
+
+		// 巻物
+		"item.identify":                   {ja: "識別", en: "identify"},
+		"item.teleportation":              {ja: "テレポート", en: "teleportation"},
+		"item.sleep":                      {ja: "睡眠", en: "sleep"},
+		"item.enchant armor":              {ja: "鎧強化", en: "enchant armor"},
+		"item.enchant weapon":             {ja: "武器強化", en: "enchant weapon"},
+		"item.create monster":             {ja: "モンスター召喚", en: "create monster"},
+		"item.remove curse":               {ja: "解呪", en: "remove curse"},
+		"item.aggravate monster":          {ja: "モンスター刺激", en: "aggravate monster"},
+		"item.magic mapping":              {ja: "地図", en: "magic mapping"},
+		"item.hold monster":               {ja: "モンスター束縛", en: "hold monster"},
+		"item.confuse monster":            {ja: "モンスター混乱", en: "confuse monster"},
+		"item.scare monster":              {ja: "モンスター退散", en: "scare monster"},
+		"item.blank paper":                {ja: "白紙", en: "blank paper"},
+		"item.genocide":                   {ja: "根絶", en: "genocide"},
+		"item.light":                      {ja: "明かり", en: "light"},
+		"item.food detection":             {ja: "食料感知", en: "food detection"},
+		"item.gold detection":             {ja: "金貨感知", en: "gold detection"},
+		"item.potion detection":           {ja: "薬感知", en: "potion detection"},
+		"item.magic detection":            {ja: "魔力感知", en: "magic detection"},
+		"item.monster detection":          {ja: "モンスター感知", en: "monster detection"},
+		"item.trap detection":             {ja: "罠感知", en: "trap detection"},
+		"item.strength":                   {ja: "筋力", en: "strength"},
+		"item.hit point maximum increase": {ja: "最大HP増加", en: "hit point maximum increase"},
+		"item.monster confusion":          {ja: "モンスター幻惑", en: "monster confusion"},
+		"item.destroy armor":              {ja: "鎧破壊", en: "destroy armor"},
+		"item.fire":                       {ja: "炎", en: "fire"},
+		"item.ice":                        {ja: "氷", en: "ice"},
+		"item.charging":                   {ja: "充填", en: "charging"},
+		"item.polymorph":                  {ja: "変身", en: "polymorph"},
+		"item.fake":                       {ja: "偽物", en: "fake"},
+
+		// 薬
+		"item.healing":           {ja: "回復", en: "healing"},
+		"item.extra healing":     {ja: "特製回復", en: "extra healing"},
+		"item.haste self":        {ja: "加速", en: "haste self"},
+		"item.restore strength":  {ja: "筋力回復", en: "restore strength"},
+		"item.blindness":         {ja: "盲目", en: "blindness"},
+		"item.paralysis":         {ja: "麻痺", en: "paralysis"},
+		"item.confusion":         {ja: "混乱", en: "confusion"},
+		"item.hallucination":     {ja: "幻覚", en: "hallucination"},
+		"item.poison":            {ja: "毒", en: "poison"},
+		"item.gain strength":     {ja: "筋力増強", en: "gain strength"},
+		"item.see invisible":     {ja: "透視", en: "see invisible"},
+		"item.gain experience":   {ja: "経験", en: "gain experience"},
+		"item.thirst quenching":  {ja: "渇き癒し", en: "thirst quenching"},
+		"item.object detection":  {ja: "物体感知", en: "object detection"},
+		"item.raise level":       {ja: "レベル上昇", en: "raise level"},
+		"item.gain dexterity":    {ja: "器用さ増強", en: "gain dexterity"},
+		"item.gain constitution": {ja: "体力増強", en: "gain constitution"},
+		"item.gain intelligence": {ja: "知力増強", en: "gain intelligence"},
+		"item.gain wisdom":       {ja: "賢さ増強", en: "gain wisdom"},
+		"item.gain charisma":     {ja: "魅力増強", en: "gain charisma"},
+		"item.cure disease":      {ja: "病気治療", en: "cure disease"},
+		"item.speed":             {ja: "高速", en: "speed"},
+		"item.levitation":        {ja: "浮遊", en: "levitation"},
+		"item.invisibility":      {ja: "透明", en: "invisibility"},
+
+		// 指輪
+		"item.protection":            {ja: "守り", en: "protection"},
+		"item.add strength":          {ja: "筋力増加", en: "add strength"},
+		"item.sustain strength":      {ja: "筋力維持", en: "sustain strength"},
+		"item.searching":             {ja: "探索", en: "searching"},
+		"item.adornment":             {ja: "装飾", en: "adornment"},
+		"item.stealth":               {ja: "忍び足", en: "stealth"},
+		"item.regeneration":          {ja: "再生", en: "regeneration"},
+		"item.slow digestion":        {ja: "消化遅延", en: "slow digestion"},
+		"item.dexterity":             {ja: "器用さ", en: "dexterity"},
+		"item.increase damage":       {ja: "ダメージ増加", en: "increase damage"},
+		"item.protection from magic": {ja: "魔法防御", en: "protection from magic"},
+		"item.hunger":                {ja: "空腹", en: "hunger"},
+		"item.maintain armor":        {ja: "鎧維持", en: "maintain armor"},
+		"item.teleport control":      {ja: "テレポート制御", en: "teleport control"},
+
+		// 食料
+		"item.food ration": {ja: "携帯食料", en: "food ration"},
+		"item.slime-mold":  {ja: "粘菌", en: "slime-mold"},
+		"item.fruit":       {ja: "果物", en: "fruit"},
+
+		// 日本語の識別名で生成されるアイテム
+		"item.短剣":        {ja: "短剣", en: "dagger"},
+		"item.剣":         {ja: "剣", en: "sword"},
+		"item.メイス":       {ja: "メイス", en: "mace"},
+		"item.斧":         {ja: "斧", en: "axe"},
+		"item.弓":         {ja: "弓", en: "bow"},
+		"item.革鎧":        {ja: "革鎧", en: "leather armor"},
+		"item.鎖帷子":       {ja: "鎖帷子", en: "chain mail"},
+		"item.板金鎧":       {ja: "板金鎧", en: "plate mail"},
+		"item.ローブ":       {ja: "ローブ", en: "robe"},
+		"item.盾":         {ja: "盾", en: "shield"},
+		"item.力の指輪":      {ja: "力", en: "strength"},
+		"item.知恵の指輪":     {ja: "知恵", en: "wisdom"},
+		"item.体力の指輪":     {ja: "体力", en: "constitution"},
+		"item.敏捷の指輪":     {ja: "敏捷", en: "agility"},
+		"item.テレポートの巻物":  {ja: "テレポート", en: "teleportation"},
+		"item.識別の巻物":     {ja: "識別", en: "identify"},
+		"item.治療の巻物":     {ja: "治療", en: "healing"},
+		"item.魔法の巻物":     {ja: "魔法", en: "magic"},
+		"item.体力回復薬":     {ja: "体力回復", en: "healing"},
+		"item.魔力回復薬":     {ja: "魔力回復", en: "restore magic"},
+		"item.力強化薬":      {ja: "力強化", en: "gain strength"},
+		"item.敏捷強化薬":     {ja: "敏捷強化", en: "gain agility"},
+		"item.パン":        {ja: "パン", en: "bread"},
+		"item.肉":         {ja: "肉", en: "meat"},
+		"item.果物":        {ja: "果物", en: "fruit"},
+		"item.チーズ":       {ja: "チーズ", en: "cheese"},
+		"item.干し肉":       {ja: "干し肉", en: "jerky"},
+		"item.イェンダーの魔除け": {ja: "イェンダーの魔除け", en: "Amulet of Yendor"},
+		"item.ウィザードアイテム": {ja: "ウィザードアイテム", en: "wizard item"},
+
+		// 未識別の薬の色（日本語は「〜薬」に続く形）
+		"color.red":         {ja: "赤い", en: "red"},
+		"color.blue":        {ja: "青い", en: "blue"},
+		"color.green":       {ja: "緑の", en: "green"},
+		"color.yellow":      {ja: "黄色い", en: "yellow"},
+		"color.black":       {ja: "黒い", en: "black"},
+		"color.brown":       {ja: "茶色の", en: "brown"},
+		"color.orange":      {ja: "橙色の", en: "orange"},
+		"color.pink":        {ja: "桃色の", en: "pink"},
+		"color.purple":      {ja: "紫の", en: "purple"},
+		"color.white":       {ja: "白い", en: "white"},
+		"color.clear":       {ja: "透明な", en: "clear"},
+		"color.grey":        {ja: "灰色の", en: "grey"},
+		"color.dark":        {ja: "暗い色の", en: "dark"},
+		"color.light blue":  {ja: "水色の", en: "light blue"},
+		"color.magenta":     {ja: "赤紫の", en: "magenta"},
+		"color.amber":       {ja: "琥珀色の", en: "amber"},
+		"color.bubbly":      {ja: "泡立つ", en: "bubbly"},
+		"color.cloudy":      {ja: "濁った", en: "cloudy"},
+		"color.dark green":  {ja: "深緑の", en: "dark green"},
+		"color.dark blue":   {ja: "紺色の", en: "dark blue"},
+		"color.emerald":     {ja: "エメラルド色の", en: "emerald"},
+		"color.fizzy":       {ja: "発泡する", en: "fizzy"},
+		"color.glowing":     {ja: "光る", en: "glowing"},
+		"color.golden":      {ja: "金色の", en: "golden"},
+		"color.icy":         {ja: "冷たい", en: "icy"},
+		"color.luminescent": {ja: "蛍光色の", en: "luminescent"},
+		"color.metallic":    {ja: "金属光沢の", en: "metallic"},
+		"color.milky":       {ja: "乳白色の", en: "milky"},
+		"color.murky":       {ja: "どんよりした", en: "murky"},
+		"color.oily":        {ja: "油っぽい", en: "oily"},
+		"color.puce":        {ja: "暗褐色の", en: "puce"},
+		"color.ruby":        {ja: "ルビー色の", en: "ruby"},
+		"color.silver":      {ja: "銀色の", en: "silver"},
+		"color.smoky":       {ja: "煙る", en: "smoky"},
+		"color.swirling":    {ja: "渦巻く", en: "swirling"},
+		"color.viscous":     {ja: "どろりとした", en: "viscous"},
+		"color.ecru":        {ja: "生成り色の", en: "ecru"},
+		"color.ochre":       {ja: "黄土色の", en: "ochre"},
+
+		// 未識別の指輪の素材（日本語は「〜指輪」に続く形）
+		"material.wooden":     {ja: "木の", en: "wooden"},
+		"material.granite":    {ja: "花崗岩の", en: "granite"},
+		"material.opal":       {ja: "オパールの", en: "opal"},
+		"material.clay":       {ja: "粘土の", en: "clay"},
+		"material.coral":      {ja: "珊瑚の", en: "coral"},
+		"material.black onyx": {ja: "黒瑪瑙の", en: "black onyx"},
+		"material.moonstone":  {ja: "月長石の", en: "moonstone"},
+		"material.tiger eye":  {ja: "虎目石の", en: "tiger eye"},
+		"material.jade":       {ja: "翡翠の", en: "jade"},
+		"material.bronze":     {ja: "青銅の", en: "bronze"},
+		"material.agate":      {ja: "瑪瑙の", en: "agate"},
+		"material.topaz":      {ja: "トパーズの", en: "topaz"},
+		"material.sapphire":   {ja: "サファイアの", en: "sapphire"},
+		"material.ruby":       {ja: "ルビーの", en: "ruby"},
+		"material.diamond":    {ja: "ダイヤモンドの", en: "diamond"},
+		"material.pearl":      {ja: "真珠の", en: "pearl"},
+		"material.iron":       {ja: "鉄の", en: "iron"},
+		"material.brass":      {ja: "真鍮の", en: "brass"},
+		"material.copper":     {ja: "銅の", en: "copper"},
+		"material.twisted":    {ja: "ねじれた", en: "twisted"},
+		"material.steel":      {ja: "鋼の", en: "steel"},
+		"material.silver":     {ja: "銀の", en: "silver"},
+		"material.gold":       {ja: "金の", en: "gold"},
+		"material.ivory":      {ja: "象牙の", en: "ivory"},
+		"material.emerald":    {ja: "エメラルドの", en: "emerald"},
+		"material.wire":       {ja: "針金の", en: "wire"},
+		"material.engagement": {ja: "婚約", en: "engagement"},
+		"material.shining":    {ja: "輝く", en: "shining"},
+		"material.fluorite":   {ja: "蛍石の", en: "fluorite"},
+		"material.obsidian":   {ja: "黒曜石の", en: "obsidian"},
+		"material.plastic":    {ja: "プラスチックの", en: "plastic"},
+
+		// 持ち物の一覧
+		"inventory.empty":       {ja: "何も持っていない。", en: "Your pack is empty."},
+		"inventory.header":      {ja: "持ち物:", en: "Current inventory:"},
+		"inventory.gold_pieces": {ja: "%c) %d枚の金貨", en: "%c) %d gold pieces"},
+	})
+}
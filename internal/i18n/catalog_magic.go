@@ -0,0 +1,41 @@
+package i18n
+
+// 薬と巻物の効果のメッセージ
+func init() {
+	register(map[string]text{
+		"magic.blank":              {ja: "この巻物は白紙だ。", en: "This scroll is blank."},
+		"magic.nothing":            {ja: "何も起こらなかった。", en: "Nothing happens."},
+		"magic.refreshed":          {ja: "さわやかな気分になった。", en: "You feel refreshed."},
+		"magic.identify":           {ja: "悟りを開いた気がする。（TODO: 識別するアイテムの選択）", en: "You feel enlightened. (TODO: Select item to identify)"},
+		"magic.teleported":         {ja: "気が付くと別の場所にいた！", en: "You suddenly find yourself somewhere else!"},
+		"magic.crumbles":           {ja: "巻物は崩れて塵になった。", en: "The scroll crumbles to dust."},
+		"magic.monsters_yawn":      {ja: "%d体のモンスターがあくびをするのが聞こえた。", en: "You hear %d monster(s) yawn."},
+		"magic.snoring":            {ja: "かすかないびきが聞こえる。", en: "You hear a faint snoring sound."},
+		"magic.armor_glows":        {ja: "鎧が一瞬輝いた。", en: "Your armor glows momentarily."},
+		"magic.no_armor":           {ja: "鎧を着ていない。", en: "You are not wearing any armor."},
+		"magic.weapon_glows":       {ja: "武器が一瞬輝いた。", en: "Your weapon glows momentarily."},
+		"magic.no_weapon":          {ja: "武器を持っていない。", en: "You are not wielding any weapon."},
+		"magic.watched_over":       {ja: "誰かに見守られている気がする。", en: "You feel as if someone is watching over you."},
+		"magic.watching":           {ja: "誰かに見られている気がする。", en: "You feel like someone is watching over you."},
+		"magic.mapping":            {ja: "ダンジョンの構造が目の前にひらめいた。", en: "You see the layout of the dungeon flash before your eyes."},
+		"magic.light":              {ja: "ダンジョンが明るく照らされた。", en: "The dungeon is lit up."},
+		"magic.sense.food":         {ja: "この階層に%d個の食料の気配を感じる。", en: "You sense %d food(s) on this level."},
+		"magic.sense.gold":         {ja: "この階層に%d個の金貨の気配を感じる。", en: "You sense %d gold(s) on this level."},
+		"magic.sense.potion":       {ja: "この階層に%d個の薬の気配を感じる。", en: "You sense %d potion(s) on this level."},
+		"magic.sense.monster":      {ja: "この階層に%d体のモンスターの気配を感じる。", en: "You sense %d monster(s) on this level."},
+		"magic.sense_none.food":    {ja: "この階層に食料の気配はない。", en: "You sense no foods on this level."},
+		"magic.sense_none.gold":    {ja: "この階層に金貨の気配はない。", en: "You sense no golds on this level."},
+		"magic.sense_none.potion":  {ja: "この階層に薬の気配はない。", en: "You sense no potions on this level."},
+		"magic.sense_none.monster": {ja: "この階層にモンスターの気配はない。", en: "You sense no monsters on this level."},
+		"magic.healed":             {ja: "気分が良くなった。（HPが%d回復）", en: "You feel better. (%d HP restored)"},
+		"magic.haste":              {ja: "体の動きがずっと速くなった。", en: "You feel yourself moving much faster."},
+		"magic.strength_returns":   {ja: "力が戻ってきた。", en: "You feel your strength returning."},
+		"magic.stronger":           {ja: "力がみなぎる！", en: "You feel stronger!"},
+		"magic.experienced":        {ja: "経験を積んだ気がする！（経験値%d）", en: "You feel more experienced! (%d exp)"},
+		"magic.eyes_tingle":        {ja: "目がちくちくする。", en: "Your eyes tingle."},
+		"magic.darkness":           {ja: "闇のとばりが降りてきた。", en: "A cloak of darkness falls around you."},
+		"magic.paralyzed":          {ja: "体が動かない！", en: "You can't move!"},
+		"magic.confused":           {ja: "あれ、どうなってる？　何？　誰？", en: "Wait, what's going on here? Huh? What? Who?"},
+		"magic.sick":               {ja: "ひどく気分が悪い。（%dのダメージ）", en: "You feel very sick. (%d damage)"},
+	})
+}
@@ -0,0 +1,38 @@
+package i18n
+
+// モンスターの名前（キーはモンスターの記号）と特殊攻撃
+func init() {
+	register(map[string]text{
+		"monster.A": {ja: "アント", en: "giant ant"},
+		"monster.B": {ja: "コウモリ", en: "bat"},
+		"monster.C": {ja: "ケンタウロス", en: "centaur"},
+		"monster.D": {ja: "ドラゴン", en: "dragon"},
+		"monster.E": {ja: "目玉", en: "floating eye"},
+		"monster.F": {ja: "ファンガス", en: "fungus"},
+		"monster.G": {ja: "ゴブリン", en: "goblin"},
+		"monster.H": {ja: "ホブゴブリン", en: "hobgoblin"},
+		"monster.I": {ja: "インプ", en: "imp"},
+		"monster.J": {ja: "ジェリー", en: "jelly"},
+		"monster.K": {ja: "コボルト", en: "kobold"},
+		"monster.L": {ja: "レプラコーン", en: "leprechaun"},
+		"monster.M": {ja: "ミノタウロス", en: "minotaur"},
+		"monster.N": {ja: "ニンフ", en: "nymph"},
+		"monster.O": {ja: "オーク", en: "orc"},
+		"monster.P": {ja: "ファントム", en: "phantom"},
+		"monster.Q": {ja: "クエーサー", en: "quasar"},
+		"monster.R": {ja: "ラットルスネーク", en: "rattlesnake"},
+		"monster.S": {ja: "スケルトン", en: "skeleton"},
+		"monster.T": {ja: "トロル", en: "troll"},
+		"monster.U": {ja: "アンバーハルク", en: "umber hulk"},
+		"monster.V": {ja: "バンパイア", en: "vampire"},
+		"monster.W": {ja: "ワイト", en: "wight"},
+		"monster.X": {ja: "ゼロックス", en: "xeroc"},
+		"monster.Y": {ja: "イエティ", en: "yeti"},
+		"monster.Z": {ja: "ゾンビ", en: "zombie"},
+
+		"ability.poison":     {ja: "毒", en: "poison"},
+		"ability.drain":      {ja: "レベル吸収", en: "drain"},
+		"ability.steal_gold": {ja: "金貨を盗む", en: "steals gold"},
+		"ability.steal_item": {ja: "アイテムを盗む", en: "steals items"},
+	})
+}
@@ -0,0 +1,258 @@
+package i18n
+
+// タイトルメニュー・オプション・ヘルプなどゲーム画面以外の画面
+func init() {
+	register(map[string]text{
+		// タイトルメニュー
+		"menu.new_game":        {ja: "はじめから", en: "NEW GAME"},
+		"menu.continue":        {ja: "つづきから", en: "CONTINUE"},
+		"menu.load":            {ja: "ロード", en: "LOAD"},
+		"menu.high_scores":     {ja: "ハイスコア", en: "HIGH SCORES"},
+		"menu.statistics":      {ja: "統計", en: "STATISTICS"},
+		"menu.achievements":    {ja: "実績", en: "ACHIEVEMENTS"},
+		"menu.bestiary":        {ja: "モンスター図鑑", en: "MONSTER RECALL"},
+		"menu.options":         {ja: "オプション", en: "OPTIONS"},
+		"menu.quit":            {ja: "終了", en: "QUIT"},
+		"menu.unknown":         {ja: "不明", en: "UNKNOWN"},
+		"menu.continue_failed": {ja: "再開できなかった: %v", en: "Continue failed: %v"},
+		"menu.adventure_over":  {ja: "その冒険はもう終わっている", en: "That adventure is already over"},
+		"menu.version":         {ja: "バージョン %s", en: "Version %s"},
+		"menu.controls":        {ja: "↑↓:選択  Enter:決定", en: "↑↓:Select  Enter:Decide"},
+
+		// オプション
+		"options.title":              {ja: "=== オプション ===", en: "=== OPTIONS ==="},
+		"options.auto_save":          {ja: "オートセーブ", en: "Auto-save"},
+		"options.auto_save_interval": {ja: "オートセーブの間隔", en: "Auto-save interval"},
+		"options.auto_pickup":        {ja: "自動で拾う", en: "Auto-pickup"},
+		"options.show_tips":          {ja: "ヒントを表示", en: "Show tips"},
+		"options.confirm_quit":       {ja: "終了時に確認", en: "Confirm quit"},
+		"options.language":           {ja: "言語", en: "Language"},
+		"options.turns":              {ja: "%dターン", en: "%d turns"},
+		"options.controls":           {ja: "↑↓:選択  ←→/Enter:変更  Esc:戻る", en: "↑↓:Select  ←→/Enter:Change  Esc:Back"},
+
+		// ヘルプ
+		"help.title":      {ja: "GoRogue - コマンド一覧", en: "GoRogue - Command Help"},
+		"help.subtitle":   {ja: "何かキーを押すとゲームに戻る", en: "Press any key to return to game"},
+		"help.movement":   {ja: "=== 移動 ===", en: "=== Movement ==="},
+		"help.actions":    {ja: "=== 行動 ===", en: "=== Actions ==="},
+		"help.navigation": {ja: "=== 階段 ===", en: "=== Navigation ==="},
+		"help.system":     {ja: "=== システム ===", en: "=== System ==="},
+		"help.keymap":     {ja: "キーマップ: %s（KEYMAP_PRESET / KEYMAP_FILE で変更できる）", en: "Keymap: %s (change with KEYMAP_PRESET / KEYMAP_FILE)"},
+		"help.shift_run":  {ja: "Shift+移動キーでも走る", en: "Shift+movement keys also run"},
+
+		// コマンドの説明（キーマップから生成するヘルプで使う）
+		"cmd.move_west":       {ja: "西へ移動", en: "Move west"},
+		"cmd.move_south":      {ja: "南へ移動", en: "Move south"},
+		"cmd.move_north":      {ja: "北へ移動", en: "Move north"},
+		"cmd.move_east":       {ja: "東へ移動", en: "Move east"},
+		"cmd.move_north_west": {ja: "北西へ移動", en: "Move north-west"},
+		"cmd.move_north_east": {ja: "北東へ移動", en: "Move north-east"},
+		"cmd.move_south_west": {ja: "南西へ移動", en: "Move south-west"},
+		"cmd.move_south_east": {ja: "南東へ移動", en: "Move south-east"},
+		"cmd.run_west":        {ja: "西へ走る", en: "Run west"},
+		"cmd.run_south":       {ja: "南へ走る", en: "Run south"},
+		"cmd.run_north":       {ja: "北へ走る", en: "Run north"},
+		"cmd.run_east":        {ja: "東へ走る", en: "Run east"},
+		"cmd.run_north_west":  {ja: "北西へ走る", en: "Run north-west"},
+		"cmd.run_north_east":  {ja: "北東へ走る", en: "Run north-east"},
+		"cmd.run_south_west":  {ja: "南西へ走る", en: "Run south-west"},
+		"cmd.run_south_east":  {ja: "南東へ走る", en: "Run south-east"},
+		"cmd.wait":            {ja: "1ターン休む", en: "Rest for a turn"},
+		"cmd.inventory":       {ja: "持ち物を見る", en: "Show inventory"},
+		"cmd.pick_up":         {ja: "拾う", en: "Pick up"},
+		"cmd.drop":            {ja: "置く", en: "Drop an item"},
+		"cmd.use":             {ja: "アイテムを使う", en: "Use an item"},
+		"cmd.quaff":           {ja: "薬を飲む", en: "Quaff a potion"},
+		"cmd.read":            {ja: "巻物を読む", en: "Read a scroll"},
+		"cmd.wield":           {ja: "武器を持つ", en: "Wield a weapon"},
+		"cmd.take_off":        {ja: "装備を外す", en: "Take off"},
+		"cmd.equip":           {ja: "装備する", en: "Equip an item"},
+		"cmd.unequip":         {ja: "装備を外す", en: "Unequip an item"},
+		"cmd.search":          {ja: "罠や扉を探す", en: "Search"},
+		"cmd.open":            {ja: "扉を開ける", en: "Open a door"},
+		"cmd.close":           {ja: "扉を閉める", en: "Close a door"},
+		"cmd.fight":           {ja: "戦う", en: "Fight"},
+		"cmd.disarm":          {ja: "罠を外す", en: "Disarm a trap"},
+		"cmd.look":            {ja: "カーソルで調べる", en: "Look around"},
+		"cmd.call":            {ja: "アイテムに名前を付ける", en: "Call an item kind"},
+		"cmd.count":           {ja: "回数指定（20s）", en: "Count (20s = search x20)"},
+		"cmd.repeat":          {ja: "直前のコマンドを繰り返す", en: "Repeat last command"},
+		"cmd.upstairs":        {ja: "階段を上る", en: "Go upstairs"},
+		"cmd.downstairs":      {ja: "階段を下りる", en: "Go downstairs"},
+		"cmd.explore":         {ja: "自動で探索する", en: "Auto-explore"},
+		"cmd.travel":          {ja: "指定した場所へ移動", en: "Travel"},
+		"cmd.messages":        {ja: "過去のメッセージ", en: "Previous messages"},
+		"cmd.redraw":          {ja: "画面を再描画", en: "Redraw the screen"},
+		"cmd.toggle_fov":      {ja: "視界の表示を切り替え", en: "Toggle FOV display"},
+		"cmd.help":            {ja: "このヘルプ", en: "Show this help"},
+		"cmd.save":            {ja: "セーブ", en: "Save game"},
+		"cmd.load":            {ja: "ロード", en: "Load game"},
+		"cmd.quit":            {ja: "ゲームを終了", en: "Quit"},
+		"cmd.escape":          {ja: "取り消す", en: "Cancel"},
+		"cmd.wizard":          {ja: "ウィザードモード", en: "Wizard mode"},
+		"cmd.cli":             {ja: "CLI デバッグモード", en: "CLI debug mode"},
+
+		// キャラクター作成
+		"creation.title":        {ja: "=== キャラクター作成 ===", en: "=== CREATE YOUR CHARACTER ==="},
+		"creation.name":         {ja: "名前: %s", en: "Name: %s"},
+		"creation.name_help":    {ja: "Enter: 決定  ESC: メニューに戻る（空欄なら %s）", en: "Enter: Next  ESC: Back to menu  (blank for %s)"},
+		"creation.choose_class": {ja: "職業を選ぶ:", en: "Choose a class:"},
+		"creation.class_help":   {ja: "j/k: 選択  Enter: 冒険を始める  ESC: 名前に戻る", en: "j/k: Select  Enter: Begin  ESC: Back to name"},
+		"creation.stats":        {ja: "HP:%d  攻:%d  防:%d  金:%d", en: "HP:%d  Atk:%d  Def:%d  Gold:%d"},
+		"creation.equipment":    {ja: "装備: %s / %s", en: "Equipment: %s / %s"},
+		"creation.pack":         {ja: "持ち物:", en: "Pack:"},
+		"class.fighter":         {ja: "戦士", en: "fighter"},
+		"class.rogue":           {ja: "盗賊", en: "rogue"},
+		"class.wizard":          {ja: "魔法使い", en: "wizard"},
+		"class.fighter_desc":    {ja: "頑丈で剣と鎖帷子を持つ。正面から戦う職業", en: "Tough, with a sword and chain mail. Fights head-on."},
+		"class.rogue_desc":      {ja: "軽装だが金貨と回復薬、地図の巻物を持つ", en: "Lightly armed, with gold, a healing potion and a map."},
+		"class.wizard_desc":     {ja: "打たれ弱いが、識別済みの巻物と薬を多く持つ", en: "Frail, but carries known scrolls and potions."},
+
+		// ゲーム終了画面
+		"gameover.press_any_key": {ja: "何かキーを押すと戻る", en: "Press any key to return"},
+		"gameover.return_title":  {ja: "何かキーを押すとタイトルに戻る", en: "Press any key to return to the title"},
+		"gameover.gold":          {ja: "%d Au", en: "%d Au"},
+		"gameover.killed_by":     {ja: "死因", en: "killed by"},
+		"gameover.on_level":      {ja: "地下%d階にて", en: "on level %d"},
+		"gameover.victory_1":     {ja: "イェンダーの魔除けを握りしめ", en: "You emerge from the Dungeons of Doom"},
+		"gameover.victory_2":     {ja: "運命の迷宮から生還した！", en: "clutching the Amulet of Yendor!"},
+		"gameover.victory_3":     {ja: "冒険者ギルドは生ける伝説として", en: "The Guild of Adventurers welcomes"},
+		"gameover.victory_4":     {ja: "あなたを迎え入れた。", en: "you back as a living legend."},
+		"gameover.escaped":       {ja: "%sは%d枚の金貨を持って脱出した", en: "%s escaped with %d gold pieces"},
+		"gameover.not_recorded":  {ja: "スコアを記録できなかった", en: "Your score could not be recorded"},
+		"gameover.rank":          {ja: "ハイスコア表の%[2]d位に入った！", en: "You placed %[1]s on the high score list!"},
+		"gameover.not_ranked":    {ja: "ハイスコア表には入らなかった", en: "You did not make the high score list"},
+		"gameover.wizard":        {ja: "ウィザードモードのゲームはスコアに記録されない", en: "Wizard mode games are not scored"},
+		"gameover.morgue":        {ja: "キャラクターダンプ: %s", en: "Character dump: %s"},
+
+		// スコアの内訳
+		"score.base":             {ja: "基本点", en: "Base"},
+		"score.victory_bonus":    {ja: "勝利ボーナス", en: "Victory bonus"},
+		"score.floor_bonus":      {ja: "階層ボーナス", en: "Floor bonus"},
+		"score.monster_bonus":    {ja: "討伐ボーナス", en: "Monster bonus"},
+		"score.gold_bonus":       {ja: "金貨ボーナス", en: "Gold bonus"},
+		"score.level_bonus":      {ja: "レベルボーナス", en: "Level bonus"},
+		"score.survival_bonus":   {ja: "生存ボーナス", en: "Survival bonus"},
+		"score.efficiency_bonus": {ja: "効率ボーナス", en: "Efficiency bonus"},
+		"score.time_penalty":     {ja: "時間ペナルティ", en: "Time penalty"},
+		"score.total":            {ja: "合計", en: "Total"},
+		"score.grade":            {ja: "評価", en: "Grade"},
+		"score.escaped":          {ja: "魔除けを持って脱出", en: "Escaped with the Amulet"},
+		"score.died":             {ja: "死亡", en: "Died"},
+		"score.killed_by":        {ja: "%sに倒された", en: "Killed by %s"},
+		"score.seconds":          {ja: "%d秒", en: "%ds"},
+		"score.minutes":          {ja: "%d分%d秒", en: "%dm %ds"},
+		"score.hours":            {ja: "%d時間%d分%d秒", en: "%dh %dm %ds"},
+
+		// ハイスコア画面
+		"scores.title":           {ja: "=== ハイスコア ===", en: "=== HIGH SCORES ==="},
+		"scores.filter":          {ja: "絞り込み: %s", en: "Filter: %s"},
+		"scores.read_failed":     {ja: "スコアを読み込めなかった: %v", en: "Failed to read scores: %v"},
+		"scores.no_match":        {ja: "条件に合うスコアがない", en: "No scores match the filter"},
+		"scores.none":            {ja: "まだスコアがない", en: "No scores yet"},
+		"scores.player_name":     {ja: "プレイヤー名: %s_", en: "Player name: %s_"},
+		"scores.name_help":       {ja: "Enter: 適用  ESC: 取り消し（空欄で絞り込みを解除）", en: "Enter: apply  ESC: cancel  (empty name clears the filter)"},
+		"scores.help":            {ja: "j/k: 移動  Enter: 詳細  v: 勝利のみ  n: 名前  r: バージョン", en: "j/k: Move  Enter: Details  v: Victories  n: Name  r: Version"},
+		"scores.help_clear":      {ja: "c: 絞り込みを解除  ESC: 戻る", en: "c: Clear filters  ESC: Return"},
+		"scores.rank":            {ja: "順位", en: "Rank"},
+		"scores.name":            {ja: "名前", en: "Name"},
+		"scores.score":           {ja: "スコア", en: "Score"},
+		"scores.lvl":             {ja: "Lv", en: "Lvl"},
+		"scores.floor":           {ja: "階層", en: "Floor"},
+		"scores.result":          {ja: "結果", en: "Result"},
+		"scores.position":        {ja: "%d-%d / %d", en: "%d-%d of %d"},
+		"scores.level":           {ja: "レベル", en: "Level"},
+		"scores.deepest_floor":   {ja: "最深階層", en: "Deepest floor"},
+		"scores.turns":           {ja: "ターン数", en: "Turns"},
+		"scores.play_time":       {ja: "プレイ時間", en: "Play time"},
+		"scores.monsters_killed": {ja: "倒したモンスター", en: "Monsters killed"},
+		"scores.gold":            {ja: "金貨", en: "Gold"},
+		"scores.date":            {ja: "日時", en: "Date"},
+		"scores.version":         {ja: "バージョン", en: "Version"},
+		"scores.class":           {ja: "職業", en: "Class"},
+		"scores.no_breakdown":    {ja: "スコアの内訳は記録されていない", en: "No score breakdown was recorded"},
+		"scores.detail_help":     {ja: "j/k: 前/次  ESC: 戻る", en: "j/k: Previous/Next  ESC: Back"},
+
+		// 統計画面
+		"stats.title":           {ja: "=== 通算の統計 ===", en: "=== LIFETIME STATISTICS ==="},
+		"stats.read_failed":     {ja: "統計を読み込めなかった: %v", en: "Failed to read statistics: %v"},
+		"stats.none":            {ja: "まだ終えたゲームがない", en: "No finished games yet"},
+		"stats.games_played":    {ja: "プレイ回数       %d", en: "Games played     %d"},
+		"stats.victories":       {ja: "勝利             %d (%.0f%%)", en: "Victories        %d (%.0f%%)"},
+		"stats.deaths":          {ja: "死亡             %d", en: "Deaths           %d"},
+		"stats.deepest_floor":   {ja: "最深階層         %d", en: "Deepest floor    %d"},
+		"stats.highest_level":   {ja: "最高レベル       %d", en: "Highest level    %d"},
+		"stats.monsters_killed": {ja: "倒したモンスター %d", en: "Monsters killed  %d"},
+		"stats.gold_collected":  {ja: "集めた金貨       %d", en: "Gold collected   %d"},
+		"stats.turns_per_floor": {ja: "1階層のターン数  %.1f", en: "Turns per floor  %.1f"},
+		"stats.play_time":       {ja: "総プレイ時間     %s", en: "Total play time  %s"},
+		"stats.death_causes":    {ja: "死因", en: "Deaths by cause"},
+		"stats.most_killed":     {ja: "よく倒したモンスター", en: "Most killed"},
+		"stats.favorite_items":  {ja: "よく使うアイテム", en: "Favorite items"},
+
+		// 実績画面
+		"achievements.title":    {ja: "=== 実績 ===", en: "=== ACHIEVEMENTS ==="},
+		"achievements.unlocked": {ja: "解除済み %d / %d", en: "Unlocked %d / %d"},
+		"achievements.help":     {ja: "j/k: スクロール  その他のキー: 戻る", en: "j/k: Scroll  Any other key: Return"},
+
+		// モンスター図鑑
+		"bestiary.title":       {ja: "=== モンスター図鑑 ===", en: "=== MONSTER RECALL ==="},
+		"bestiary.encountered": {ja: "遭遇 %d / %d", en: "Encountered %d / %d"},
+		"bestiary.help":        {ja: "j/k: スクロール  その他のキー: 戻る", en: "j/k: Scroll  Any other key: Return"},
+		"bestiary.unknown":     {ja: "まだ出会っていない", en: "Not yet encountered"},
+		"bestiary.entry":       {ja: "%c  %s  (倒した数 %d)", en: "%c  %s  (killed %d)"},
+		"bestiary.hp":          {ja: "HP %s", en: "HP %s"},
+		"bestiary.hits_for":    {ja: "%sのダメージ", en: "hits for %s"},
+		"bestiary.not_hurt":    {ja: "まだ傷を負わされていない", en: "has not hurt you"},
+
+		// セーブ・ロード画面
+		"saveload.refreshed":         {ja: "セーブスロットを更新した", en: "Save slots refreshed"},
+		"saveload.save_failed":       {ja: "セーブできなかった: %v", en: "Save failed: %v"},
+		"saveload.saved":             {ja: "スロット%dにセーブした", en: "Game saved to slot %d"},
+		"saveload.no_file":           {ja: "このスロットにはセーブデータがない", en: "No save file in this slot"},
+		"saveload.load_failed":       {ja: "ロードできなかった: %v", en: "Load failed: %v"},
+		"saveload.loaded":            {ja: "スロット%dからロードした", en: "Game loaded from slot %d"},
+		"saveload.confirm_prompt":    {ja: "スロット%dを削除する？ (y/n)", en: "Delete save slot %d? (y/n)"},
+		"saveload.delete_failed":     {ja: "削除できなかった: %v", en: "Delete failed: %v"},
+		"saveload.deleted":           {ja: "スロット%dを削除した", en: "Save slot %d deleted"},
+		"saveload.quick_save_failed": {ja: "クイックセーブできなかった: %v", en: "Quick save failed: %v"},
+		"saveload.quick_saved":       {ja: "クイックセーブした", en: "Quick save completed"},
+		"saveload.no_quick_save":     {ja: "クイックセーブのデータがない", en: "No quick save file"},
+		"saveload.quick_load_failed": {ja: "クイックロードできなかった: %v", en: "Quick load failed: %v"},
+		"saveload.quick_loaded":      {ja: "クイックロードした", en: "Quick load completed"},
+		"saveload.help":              {ja: "セーブ/ロード: ↑↓:移動 Enter:決定 s:セーブ l:ロード d:削除 r:更新 F5:クイックセーブ F9:クイックロード", en: "Save/Load Help: ↑↓:Navigate Enter:Select s:Save l:Load d:Delete r:Refresh F5:Quick Save F9:Quick Load"},
+		"saveload.title_save":        {ja: "=== セーブ ===", en: "=== SAVE GAME ==="},
+		"saveload.title_load":        {ja: "=== ロード ===", en: "=== LOAD GAME ==="},
+		"saveload.title_delete":      {ja: "=== セーブデータの削除 ===", en: "=== DELETE SAVE ==="},
+		"saveload.title":             {ja: "=== セーブ/ロード ===", en: "=== SAVE/LOAD ==="},
+		"saveload.select_save":       {ja: "セーブするスロットを選ぶ", en: "Select a slot to save your game"},
+		"saveload.select_load":       {ja: "ロードするスロットを選ぶ", en: "Select a slot to load your game"},
+		"saveload.select_delete":     {ja: "削除するスロットを選ぶ", en: "Select a slot to delete"},
+		"saveload.select":            {ja: "操作を選ぶ", en: "Select an option"},
+		"saveload.empty_load":        {ja: "空き - ロードできない", en: "Empty - Cannot load"},
+		"saveload.empty_delete":      {ja: "空き - 削除できない", en: "Empty - Cannot delete"},
+		"saveload.auto_save":         {ja: "オートセーブ:", en: "Auto-Save:"},
+		"saveload.auto_save_error":   {ja: "オートセーブを読み込めない", en: "Error reading auto-save"},
+		"saveload.no_auto_save":      {ja: "オートセーブはない", en: "No auto-save available"},
+		"saveload.key_navigate":      {ja: "↑↓: 移動", en: "↑↓: Navigate"},
+		"saveload.key_select":        {ja: "Enter: 決定", en: "Enter: Select"},
+		"saveload.key_save":          {ja: "s: セーブ", en: "s: Save Mode"},
+		"saveload.key_load":          {ja: "l: ロード", en: "l: Load Mode"},
+		"saveload.key_delete":        {ja: "d: 削除", en: "d: Delete Mode"},
+		"saveload.key_refresh":       {ja: "r: 更新", en: "r: Refresh"},
+		"saveload.key_quick_save":    {ja: "F5: クイックセーブ", en: "F5: Quick Save"},
+		"saveload.key_quick_load":    {ja: "F9: クイックロード", en: "F9: Quick Load"},
+		"saveload.key_back":          {ja: "Esc: 戻る", en: "Esc: Back"},
+		"saveload.confirm_title":     {ja: "削除の確認", en: "CONFIRM DELETE"},
+		"saveload.confirm_slot":      {ja: "スロット%dを削除する？", en: "Delete save slot %d?"},
+		"saveload.confirm_warning":   {ja: "この操作は取り消せない！", en: "This action cannot be undone!"},
+		"saveload.confirm_keys":      {ja: "'y' で削除、'n' で取り消し", en: "Press 'y' to confirm, 'n' to cancel"},
+
+		// セーブスロットの概要
+		"save.empty":          {ja: "空き", en: "Empty"},
+		"save.slot_info":      {ja: "%s - レベル%d、地下%d階 - %s - %s", en: "%s - Level %d, Floor %d - %s - %s"},
+		"save.status_active":  {ja: "冒険中", en: "Active"},
+		"save.status_victory": {ja: "勝利", en: "Victory"},
+		"save.status_defeat":  {ja: "敗北", en: "Defeated"},
+	})
+}
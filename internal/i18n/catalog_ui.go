@@ -0,0 +1,68 @@
+package i18n
+
+// ゲーム画面のプロンプト・ステータス表示・メッセージ
+func init() {
+	register(map[string]text{
+		// 拾う・落とす
+		"ui.dropped":          {ja: "%sを置いた。", en: "You dropped %s."},
+		"ui.found_gold":       {ja: "%d枚の金貨を見つけた", en: "You found %d gold pieces"},
+		"ui.picked_up_amulet": {ja: "%sを手に入れた！", en: "You picked up the %s!"},
+		"ui.picked_up":        {ja: "%sを拾った", en: "You picked up %s"},
+
+		// 未実装のコマンド
+		"ui.use_what":           {ja: "何を使う？（まだ実装されていない）", en: "Use what? (not fully implemented yet)"},
+		"ui.which_direction":    {ja: "どの方向？（まだ実装されていない）", en: "Which direction? (not implemented yet)"},
+		"ui.attack_direction":   {ja: "どの方向を攻撃する？（hjklybnu）", en: "Attack which direction? (hjklybnu)"},
+		"ui.disarm_direction":   {ja: "どの方向の罠を外す？（hjklybnu）", en: "Disarm trap which direction? (hjklybnu)"},
+		"ui.fov_toggled":        {ja: "視界の表示を切り替えた", en: "FOV display toggled"},
+		"ui.wizard_mode":        {ja: "ウィザードモード: %s", en: "Wizard mode: %s"},
+		"ui.on":                 {ja: "オン", en: "ON"},
+		"ui.off":                {ja: "オフ", en: "OFF"},
+		"ui.saving_unavailable": {ja: "セーブは利用できない。", en: "Saving is not available."},
+		"ui.canceled":           {ja: "取り消した。", en: "Canceled."},
+		"ui.invalid_unequip":    {ja: "選択が無効だ。(w)武器、(a)鎧、(l)左手の指輪、(r)右手の指輪から選ぶ", en: "Invalid selection. Use (w)eapon, (a)rmor, (l)eft ring, (r)ight ring"},
+		"ui.call_it_what":       {ja: "何と名付ける？（Enter で決定、ESC で取り消し）", en: "Call it what? (Enter to confirm, ESC to cancel)"},
+		"ui.no_need_to_call":    {ja: "それに名前を付ける必要はない。", en: "You don't need to call that."},
+		"ui.now_known_as":       {ja: "これからは%sと呼ぶ。", en: "You now know it as %s."},
+		"ui.cli_exited":         {ja: "CLI モードを終了した。", en: "CLI mode exited."},
+		"ui.cli_unavailable":    {ja: "CLI モードは利用できない。", en: "CLI mode not available."},
+		"ui.cli_entered":        {ja: "CLI モードに入った。'help' でコマンド一覧、ESC で終了。", en: "Entered CLI mode. Type 'help' for commands, ESC to exit."},
+		"ui.travel_prompt":      {ja: "どこへ移動する？（カーソル移動、. で出発、< > で階段、ESC で取り消し）", en: "Where do you want to travel to? (move cursor, . to go, < > for stairs, ESC to cancel)"},
+
+		// アイテム選択
+		"ui.nothing_to_equip":    {ja: "装備できるものを持っていない。", en: "You have nothing to equip."},
+		"ui.no_equippable":       {ja: "装備できるアイテムがない。", en: "You have no equippable items."},
+		"ui.equippable_items":    {ja: "装備できるアイテム:", en: "Equippable items:"},
+		"ui.equip_which":         {ja: "どれを装備する？（a-z、ESC で取り消し）", en: "Equip which item? (a-z, ESC to cancel)"},
+		"ui.nothing_to_take_off": {ja: "外せる装備がない。", en: "You have nothing equipped to take off."},
+		"ui.currently_equipped":  {ja: "現在の装備:", en: "Currently equipped:"},
+		"ui.take_off_which":      {ja: "どれを外す？(w)武器、(a)鎧、(l)左手の指輪、(r)右手の指輪", en: "Take off which item? (w)eapon, (a)rmor, (l)eft ring, (r)ight ring"},
+		"ui.nothing_to_drop":     {ja: "置けるものを持っていない。", en: "You have nothing to drop."},
+		"ui.drop_which":          {ja: "どれを置く？（a-z、ESC で取り消し）", en: "Drop which item? (a-z, ESC to cancel)"},
+		"ui.no_potions":          {ja: "飲める薬を持っていない。", en: "You have no potions to drink."},
+		"ui.available_potions":   {ja: "持っている薬:", en: "Available potions:"},
+		"ui.quaff_which":         {ja: "どの薬を飲む？（a-z、ESC で取り消し）", en: "Quaff which potion? (a-z, ESC to cancel)"},
+		"ui.no_scrolls":          {ja: "読める巻物を持っていない。", en: "You have no scrolls to read."},
+		"ui.available_scrolls":   {ja: "持っている巻物:", en: "Available scrolls:"},
+		"ui.read_which":          {ja: "どの巻物を読む？（a-z、ESC で取り消し）", en: "Read which scroll? (a-z, ESC to cancel)"},
+		"ui.nothing_to_call":     {ja: "名前を付けられるものがない。", en: "You have nothing to call."},
+		"ui.unidentified_items":  {ja: "未識別のアイテム:", en: "Unidentified items:"},
+		"ui.call_which":          {ja: "どれに名前を付ける？（a-z、ESC で取り消し）", en: "Call which item? (a-z, ESC to cancel)"},
+
+		// ステータス表示
+		"ui.status":        {ja: "Lv:%d  HP:%d/%d  攻:%d  防:%d  満腹:%d%%  経験:%d  金:%d", en: "Lv:%d  HP:%d/%d  Atk:%d  Def:%d  Hunger:%d%%  Exp:%d  Gold:%d"},
+		"ui.status_count":  {ja: "  回数:%d", en: "  Count:%d"},
+		"ui.floor":         {ja: "地下%d階/26", en: "B%dF/26"},
+		"ui.floor_maze":    {ja: " [迷路]", en: " [MAZE]"},
+		"ui.floor_final":   {ja: " [最深部]", en: " [FINAL]"},
+		"ui.floor_amulet":  {ja: " [魔除け]", en: " [AMULET]"},
+		"ui.floor_escape":  {ja: " [脱出可能！]", en: " [ESCAPE!]"},
+		"ui.equipment":     {ja: "武器: %-15s  鎧: %-15s  指輪(左): %-10s  指輪(右): %-10s", en: "Weapon: %-15s  Armor: %-15s  Ring: (L): %-10s  Ring: (R): %-10s"},
+		"ui.wizard_tag":    {ja: "  [ウィザードモード]", en: "  [WIZARD MODE]"},
+		"ui.more":          {ja: "--続く--", en: "--More--"},
+		"ui.call_prompt":   {ja: "名前: %s_", en: "Call it: %s_"},
+		"ui.messages":      {ja: "=== メッセージ ===", en: "=== MESSAGES ==="},
+		"ui.messages_page": {ja: "=== メッセージ (%d-%d / %d) ===", en: "=== MESSAGES (%d-%d of %d) ==="},
+		"ui.messages_help": {ja: "j/k: スクロール  PgUp/PgDn: ページ  g/G: 最古/最新  その他のキー: 戻る", en: "j/k: Scroll  PgUp/PgDn: Page  g/G: Oldest/Newest  Other keys: Return"},
+	})
+}
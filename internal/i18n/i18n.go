@@ -0,0 +1,135 @@
+// Package i18n ゲーム内で表示する文字列のメッセージカタログ
+// 文字列は ID で引き、選択中の言語（日本語・英語）の訳を返す
+package i18n
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Language is a language of the message catalog
+type Language string
+
+const (
+	Japanese Language = "ja"
+	English  Language = "en"
+)
+
+// DefaultLanguage is used until SetLanguage is called
+const DefaultLanguage = English
+
+// Languages lists the supported languages in the order the options screen cycles them
+var Languages = []Language{English, Japanese}
+
+// text is one catalog entry with its translations
+type text struct {
+	ja string
+	en string
+}
+
+// get returns the translation for a language
+func (t text) get(lang Language) string {
+	if lang == Japanese {
+		return t.ja
+	}
+	return t.en
+}
+
+var (
+	mu      sync.RWMutex
+	current = DefaultLanguage
+
+	// catalog はすべての文字列（各ファイルの init で登録する）
+	catalog = make(map[string]text)
+)
+
+// register adds catalog entries; ids must be unique across files
+func register(texts map[string]text) {
+	for id, t := range texts {
+		if _, exists := catalog[id]; exists {
+			panic("i18n: duplicate message id " + id)
+		}
+		catalog[id] = t
+	}
+}
+
+// ParseLanguage converts a config value such as "ja" or "en_US" to a language
+func ParseLanguage(value string) (Language, bool) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	switch {
+	case value == "ja" || strings.HasPrefix(value, "ja_") || strings.HasPrefix(value, "ja-") || value == "japanese":
+		return Japanese, true
+	case value == "en" || strings.HasPrefix(value, "en_") || strings.HasPrefix(value, "en-") || value == "english":
+		return English, true
+	default:
+		return DefaultLanguage, false
+	}
+}
+
+// Name returns the language name written in that language
+func (l Language) Name() string {
+	if l == Japanese {
+		return "日本語"
+	}
+	return "English"
+}
+
+// SetLanguage selects the language of T
+func SetLanguage(lang Language) {
+	mu.Lock()
+	defer mu.Unlock()
+	current = lang
+}
+
+// CurrentLanguage returns the selected language
+func CurrentLanguage() Language {
+	mu.RLock()
+	defer mu.RUnlock()
+	return current
+}
+
+// NextLanguage returns the language after lang in Languages (delta -1 for the previous one)
+func NextLanguage(lang Language, delta int) Language {
+	index := 0
+	for i, l := range Languages {
+		if l == lang {
+			index = i
+		}
+	}
+	return Languages[((index+delta)%len(Languages)+len(Languages))%len(Languages)]
+}
+
+// T returns the message for id in the selected language, formatted with args
+// 未登録の ID はそのまま返す
+func T(id string, args ...interface{}) string {
+	return In(CurrentLanguage(), id, args...)
+}
+
+// In returns the message for id in the given language
+func In(lang Language, id string, args ...interface{}) string {
+	t, exists := catalog[id]
+	if !exists {
+		return id
+	}
+	format := t.get(lang)
+	if len(args) == 0 {
+		return format
+	}
+	return fmt.Sprintf(format, args...)
+}
+
+// Has reports whether id is in the catalog
+func Has(id string) bool {
+	_, exists := catalog[id]
+	return exists
+}
+
+// Named translates a data name such as an item kind ("item." + name)
+// カタログにない名前はそのまま返す
+func Named(prefix, name string) string {
+	if id := prefix + "." + name; Has(id) {
+		return T(id)
+	}
+	return name
+}
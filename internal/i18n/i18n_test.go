@@ -0,0 +1,152 @@
+package i18n
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// verbPattern matches a format verb such as %s, %-15s, %.1f or %[2]d
+var verbPattern = regexp.MustCompile(`%(\[(\d+)\])?[-+# 0]*\d*(\.\d+)?([a-zA-Z%])`)
+
+// formatVerbs returns the verb used for each argument position (1-based)
+func formatVerbs(format string) map[int]byte {
+	verbs := make(map[int]byte)
+	next := 1
+	for _, match := range verbPattern.FindAllStringSubmatch(format, -1) {
+		verb := match[4][0]
+		if verb == '%' {
+			continue
+		}
+		if match[2] != "" {
+			next, _ = strconv.Atoi(match[2])
+		}
+		verbs[next] = verb
+		next++
+	}
+	return verbs
+}
+
+// sampleArgs builds arguments that satisfy the given verbs
+func sampleArgs(verbs map[int]byte) []interface{} {
+	count := 0
+	for index := range verbs {
+		count = max(count, index)
+	}
+	args := make([]interface{}, count)
+	for index, verb := range verbs {
+		switch verb {
+		case 'd', 'c':
+			args[index-1] = 7
+		case 'f':
+			args[index-1] = 1.5
+		default:
+			args[index-1] = "x"
+		}
+	}
+	return args
+}
+
+func TestCatalogIsComplete(t *testing.T) {
+	for id, entry := range catalog {
+		if entry.ja == "" || entry.en == "" {
+			t.Errorf("%s: missing translation (ja=%q, en=%q)", id, entry.ja, entry.en)
+		}
+	}
+}
+
+func TestCatalogFormatsMatch(t *testing.T) {
+	for id, entry := range catalog {
+		jaVerbs := formatVerbs(entry.ja)
+		enVerbs := formatVerbs(entry.en)
+
+		// 位置指定のない書式は、両方の言語で同じ引数を同じ順に使う
+		if !strings.Contains(entry.ja+entry.en, "%[") && len(jaVerbs) != len(enVerbs) {
+			t.Errorf("%s: ja uses %d arguments, en uses %d", id, len(jaVerbs), len(enVerbs))
+			continue
+		}
+
+		merged := make(map[int]byte)
+		for index, verb := range enVerbs {
+			merged[index] = verb
+		}
+		for index, verb := range jaVerbs {
+			if other, exists := merged[index]; exists && other != verb {
+				t.Errorf("%s: argument %d is %%%c in en but %%%c in ja", id, index, other, verb)
+			}
+			merged[index] = verb
+		}
+		if len(merged) == 0 {
+			continue
+		}
+
+		args := sampleArgs(merged)
+		for _, lang := range Languages {
+			if text := In(lang, id, args...); strings.Contains(text, "%!") {
+				t.Errorf("%s: bad format in %s: %q", id, lang, text)
+			}
+		}
+	}
+}
+
+func TestParseLanguage(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected Language
+		ok       bool
+	}{
+		{"ja", Japanese, true},
+		{"JA", Japanese, true},
+		{"ja_JP.UTF-8", Japanese, true},
+		{"japanese", Japanese, true},
+		{"en", English, true},
+		{" en_US ", English, true},
+		{"english", English, true},
+		{"fr", DefaultLanguage, false},
+		{"", DefaultLanguage, false},
+	}
+
+	for _, tt := range tests {
+		lang, ok := ParseLanguage(tt.value)
+		if lang != tt.expected || ok != tt.ok {
+			t.Errorf("ParseLanguage(%q) = %v, %v, expected %v, %v", tt.value, lang, ok, tt.expected, tt.ok)
+		}
+	}
+}
+
+func TestNextLanguage(t *testing.T) {
+	if got := NextLanguage(English, 1); got != Japanese {
+		t.Errorf("NextLanguage(en, 1) = %v, expected ja", got)
+	}
+	if got := NextLanguage(Japanese, 1); got != English {
+		t.Errorf("NextLanguage(ja, 1) = %v, expected en", got)
+	}
+	if got := NextLanguage(English, -1); got != Japanese {
+		t.Errorf("NextLanguage(en, -1) = %v, expected ja", got)
+	}
+}
+
+func TestTranslate(t *testing.T) {
+	defer SetLanguage(CurrentLanguage())
+
+	SetLanguage(Japanese)
+	if got := T("game.floor_down", 3); got != "階層 3 へ下りた" {
+		t.Errorf("T(game.floor_down) in ja = %q", got)
+	}
+
+	SetLanguage(English)
+	if got := T("game.floor_down", 3); got != "You descend to floor 3." {
+		t.Errorf("T(game.floor_down) in en = %q", got)
+	}
+
+	if got := T("no.such.id"); got != "no.such.id" {
+		t.Errorf("T of a missing id = %q, expected the id", got)
+	}
+	if got := Named("item", "healing"); got != "healing" {
+		t.Errorf("Named(item, healing) = %q", got)
+	}
+	if got := Named("item", "unregistered thing"); got != "unregistered thing" {
+		t.Errorf("Named of a missing name = %q, expected the name", got)
+	}
+}
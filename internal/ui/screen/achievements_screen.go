@@ -0,0 +1,119 @@
+// Package screen 実績画面のUI実装
+// 全実績の解除状況を一覧表示する
+package screen
+
+import (
+	"fmt"
+
+	"github.com/anaseto/gruid"
+	"github.com/yuru-sha/gorogue/internal/core/state"
+	"github.com/yuru-sha/gorogue/internal/game/save"
+	"github.com/yuru-sha/gorogue/internal/i18n"
+)
+
+// achievementListTop is the first row of the achievement list
+const achievementListTop = 6
+
+// AchievementsScreen displays locked and unlocked achievements
+type AchievementsScreen struct {
+	width, height int
+	tracker       *save.AchievementTracker
+	offset        int
+}
+
+// NewAchievementsScreen creates a new achievements screen
+func NewAchievementsScreen(width, height int, tracker *save.AchievementTracker) *AchievementsScreen {
+	return &AchievementsScreen{
+		width:   width,
+		height:  height,
+		tracker: tracker,
+	}
+}
+
+// visibleRows returns the number of achievements that fit on screen (2 rows each)
+func (s *AchievementsScreen) visibleRows() int {
+	rows := (s.height - achievementListTop - 4) / 2
+	if rows < 1 {
+		rows = 1
+	}
+	return rows
+}
+
+// HandleInput scrolls the list and returns to the title menu
+func (s *AchievementsScreen) HandleInput(msg gruid.Msg) state.GameState {
+	keyMsg, ok := msg.(gruid.MsgKeyDown)
+	if !ok {
+		return state.StateAchievements
+	}
+
+	maxOffset := len(save.GetAchievements()) - s.visibleRows()
+	if maxOffset < 0 {
+		maxOffset = 0
+	}
+
+	switch keyMsg.Key {
+	case gruid.KeyArrowDown, "j":
+		if s.offset < maxOffset {
+			s.offset++
+		}
+	case gruid.KeyArrowUp, "k":
+		if s.offset > 0 {
+			s.offset--
+		}
+	default:
+		s.offset = 0
+		return state.StateMenu
+	}
+	return state.StateAchievements
+}
+
+// Draw draws the achievement list
+func (s *AchievementsScreen) Draw(grid *gruid.Grid) {
+	grid.Fill(gruid.Cell{Rune: ' '})
+
+	achievements := save.GetAchievements()
+
+	s.drawCenteredText(grid, 2, i18n.T("achievements.title"), colorYellow)
+	s.drawCenteredText(grid, 4, i18n.T("achievements.unlocked", s.tracker.UnlockedCount(), len(achievements)), colorGray)
+	s.drawCenteredText(grid, s.height-3, i18n.T("achievements.help"), colorDarkGray)
+
+	x := 6
+	end := s.offset + s.visibleRows()
+	if end > len(achievements) {
+		end = len(achievements)
+	}
+	for i, achievement := range achievements[s.offset:end] {
+		y := achievementListTop + i*2
+		record, unlocked := s.tracker.GetUnlocked(achievement.ID)
+		if unlocked {
+			s.drawText(grid, x, y, "[*] "+achievement.DisplayName(), colorYellow)
+			detail := fmt.Sprintf("%s (%s", achievement.DisplayDescription(), record.UnlockedAt.Format("2006-01-02"))
+			if record.CharName != "" {
+				detail += ", " + record.CharName
+			}
+			s.drawText(grid, x+4, y+1, detail+")", colorGray)
+		} else {
+			s.drawText(grid, x, y, "[ ] "+achievement.DisplayName(), colorDarkGray)
+			s.drawText(grid, x+4, y+1, achievement.DisplayDescription(), colorDarkGray)
+		}
+	}
+}
+
+// drawText draws text at the specified position with the given style
+func (s *AchievementsScreen) drawText(grid *gruid.Grid, x, y int, text string, style gruid.Style) {
+	for i, r := range []rune(text) {
+		if x+i < 0 || x+i >= s.width || y >= s.height {
+			continue
+		}
+		grid.Set(gruid.Point{X: x + i, Y: y}, gruid.Cell{Rune: r, Style: style})
+	}
+}
+
+// drawCenteredText draws centered text
+func (s *AchievementsScreen) drawCenteredText(grid *gruid.Grid, y int, text string, style gruid.Style) {
+	x := (s.width - len([]rune(text))) / 2
+	if x < 0 {
+		x = 0
+	}
+	s.drawText(grid, x, y, text, style)
+}
@@ -0,0 +1,156 @@
+// Package screen モンスター図鑑画面のUI実装
+// actor.MonsterTypes の全モンスターについて、戦って分かったことを一覧表示する
+package screen
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/anaseto/gruid"
+	"github.com/yuru-sha/gorogue/internal/core/state"
+	"github.com/yuru-sha/gorogue/internal/game/actor"
+	"github.com/yuru-sha/gorogue/internal/game/save"
+	"github.com/yuru-sha/gorogue/internal/i18n"
+)
+
+// bestiaryListTop is the first row of the monster list
+const bestiaryListTop = 6
+
+// BestiaryScreen displays the monster recall
+type BestiaryScreen struct {
+	width, height int
+	bestiary      *save.Bestiary
+	offset        int
+}
+
+// NewBestiaryScreen creates a new monster recall screen
+func NewBestiaryScreen(width, height int, bestiary *save.Bestiary) *BestiaryScreen {
+	return &BestiaryScreen{
+		width:    width,
+		height:   height,
+		bestiary: bestiary,
+	}
+}
+
+// monsterSymbols returns the symbols of all monster types in alphabetical order
+func monsterSymbols() []rune {
+	symbols := make([]rune, 0, len(actor.MonsterTypes))
+	for symbol := range actor.MonsterTypes {
+		symbols = append(symbols, symbol)
+	}
+	sort.Slice(symbols, func(i, j int) bool { return symbols[i] < symbols[j] })
+	return symbols
+}
+
+// recallSummary describes what is known about a monster in one line
+func recallSummary(record save.MonsterRecord) string {
+	parts := []string{i18n.T("bestiary.hp", valueRange(record.MinHP, record.MaxHP))}
+	if record.Hits > 0 {
+		parts = append(parts, i18n.T("bestiary.hits_for", valueRange(record.MinDamage, record.MaxDamage)))
+	} else {
+		parts = append(parts, i18n.T("bestiary.not_hurt"))
+	}
+	if len(record.Abilities) > 0 {
+		abilities := make([]string, len(record.Abilities))
+		for i, ability := range record.Abilities {
+			abilities[i] = actor.AbilityName(ability)
+		}
+		parts = append(parts, strings.Join(abilities, ", "))
+	}
+	return strings.Join(parts, "; ")
+}
+
+// valueRange formats a min-max range, collapsing equal values
+func valueRange(minValue, maxValue int) string {
+	if minValue == maxValue {
+		return fmt.Sprintf("%d", minValue)
+	}
+	return fmt.Sprintf("%d-%d", minValue, maxValue)
+}
+
+// visibleRows returns the number of monsters that fit on screen (2 rows each)
+func (s *BestiaryScreen) visibleRows() int {
+	rows := (s.height - bestiaryListTop - 4) / 2
+	if rows < 1 {
+		rows = 1
+	}
+	return rows
+}
+
+// HandleInput scrolls the list and returns to the title menu
+func (s *BestiaryScreen) HandleInput(msg gruid.Msg) state.GameState {
+	keyMsg, ok := msg.(gruid.MsgKeyDown)
+	if !ok {
+		return state.StateBestiary
+	}
+
+	maxOffset := len(actor.MonsterTypes) - s.visibleRows()
+	if maxOffset < 0 {
+		maxOffset = 0
+	}
+
+	switch keyMsg.Key {
+	case gruid.KeyArrowDown, "j":
+		if s.offset < maxOffset {
+			s.offset++
+		}
+	case gruid.KeyArrowUp, "k":
+		if s.offset > 0 {
+			s.offset--
+		}
+	default:
+		s.offset = 0
+		return state.StateMenu
+	}
+	return state.StateBestiary
+}
+
+// Draw draws the monster list
+func (s *BestiaryScreen) Draw(grid *gruid.Grid) {
+	grid.Fill(gruid.Cell{Rune: ' '})
+
+	symbols := monsterSymbols()
+
+	s.drawCenteredText(grid, 2, i18n.T("bestiary.title"), colorYellow)
+	s.drawCenteredText(grid, 4, i18n.T("bestiary.encountered", s.bestiary.KnownCount(), len(symbols)), colorGray)
+	s.drawCenteredText(grid, s.height-3, i18n.T("bestiary.help"), colorDarkGray)
+
+	x := 6
+	end := s.offset + s.visibleRows()
+	if end > len(symbols) {
+		end = len(symbols)
+	}
+	for i, symbol := range symbols[s.offset:end] {
+		y := bestiaryListTop + i*2
+		monsterType := actor.MonsterTypes[symbol]
+		record, known := s.bestiary.Record(symbol)
+		if !known {
+			s.drawText(grid, x, y, fmt.Sprintf("%c  ???", symbol), colorDarkGray)
+			s.drawText(grid, x+3, y+1, i18n.T("bestiary.unknown"), colorDarkGray)
+			continue
+		}
+
+		s.drawText(grid, x, y, i18n.T("bestiary.entry", symbol, monsterType.DisplayName(), record.Kills), colorYellow)
+		s.drawText(grid, x+3, y+1, recallSummary(record), colorGray)
+	}
+}
+
+// drawText draws text at the specified position with the given style
+func (s *BestiaryScreen) drawText(grid *gruid.Grid, x, y int, text string, style gruid.Style) {
+	for i, r := range []rune(text) {
+		if x+i < 0 || x+i >= s.width || y >= s.height {
+			continue
+		}
+		grid.Set(gruid.Point{X: x + i, Y: y}, gruid.Cell{Rune: r, Style: style})
+	}
+}
+
+// drawCenteredText draws centered text
+func (s *BestiaryScreen) drawCenteredText(grid *gruid.Grid, y int, text string, style gruid.Style) {
+	x := (s.width - len([]rune(text))) / 2
+	if x < 0 {
+		x = 0
+	}
+	s.drawText(grid, x, y, text, style)
+}
@@ -0,0 +1,196 @@
+// Package screen キャラクター作成画面のUI実装
+// 名前を入力して職業を選ぶと、その職業の能力値と持ち物で最初の階層に入る
+package screen
+
+import (
+	"strings"
+
+	"github.com/anaseto/gruid"
+	"github.com/yuru-sha/gorogue/internal/core/state"
+	"github.com/yuru-sha/gorogue/internal/game/actor"
+	"github.com/yuru-sha/gorogue/internal/i18n"
+)
+
+// charNameMaxLen limits the character name (it is shown on the tombstone)
+const charNameMaxLen = 16
+
+// creationStep is the part of character creation being edited
+type creationStep int
+
+const (
+	creationStepName creationStep = iota
+	creationStepClass
+)
+
+// CreationScreen asks for the character name and class before a new game
+type CreationScreen struct {
+	width, height int
+	defaultName   string
+	nameBuffer    string
+	selected      int
+	step          creationStep
+	previews      map[actor.Class]*actor.Player        // 職業ごとの持ち物の見本
+	onCreate      func(name string, class actor.Class) // 作成したキャラクターで新規ゲームを始める
+}
+
+// NewCreationScreen creates a new character creation screen
+func NewCreationScreen(width, height int, defaultName string) *CreationScreen {
+	return &CreationScreen{
+		width:       width,
+		height:      height,
+		defaultName: defaultName,
+		previews:    make(map[actor.Class]*actor.Player),
+	}
+}
+
+// SetOnCreate sets the callback that starts the game with the chosen character
+func (s *CreationScreen) SetOnCreate(onCreate func(name string, class actor.Class)) {
+	s.onCreate = onCreate
+}
+
+// Reset starts over from the name step
+func (s *CreationScreen) Reset() {
+	s.nameBuffer = ""
+	s.selected = 0
+	s.step = creationStepName
+}
+
+// charName returns the typed name, or the default when it is blank
+func (s *CreationScreen) charName() string {
+	if name := strings.TrimSpace(s.nameBuffer); name != "" {
+		return name
+	}
+	return s.defaultName
+}
+
+// HandleInput handles typing the name and choosing the class
+func (s *CreationScreen) HandleInput(msg gruid.Msg) state.GameState {
+	keyMsg, ok := msg.(gruid.MsgKeyDown)
+	if !ok {
+		return state.StateCreation
+	}
+	if s.step == creationStepName {
+		return s.handleNameInput(keyMsg.Key)
+	}
+	return s.handleClassInput(keyMsg.Key)
+}
+
+// handleNameInput handles typing the character name
+func (s *CreationScreen) handleNameInput(key gruid.Key) state.GameState {
+	switch key {
+	case gruid.KeyEscape:
+		s.Reset()
+		return state.StateMenu
+	case gruid.KeyEnter:
+		s.step = creationStepClass
+	case gruid.KeyBackspace:
+		if s.nameBuffer != "" {
+			runes := []rune(s.nameBuffer)
+			s.nameBuffer = string(runes[:len(runes)-1])
+		}
+	default:
+		if len(string(key)) == 1 {
+			char := string(key)[0]
+			if char >= 32 && char <= 126 && len(s.nameBuffer) < charNameMaxLen { // Printable ASCII
+				s.nameBuffer += string(char)
+			}
+		}
+	}
+	return state.StateCreation
+}
+
+// handleClassInput handles choosing the class and starting the game
+func (s *CreationScreen) handleClassInput(key gruid.Key) state.GameState {
+	switch key {
+	case gruid.KeyArrowDown, "j":
+		s.selected = (s.selected + 1) % len(actor.Classes)
+	case gruid.KeyArrowUp, "k":
+		s.selected = (s.selected - 1 + len(actor.Classes)) % len(actor.Classes)
+	case gruid.KeyEscape:
+		s.step = creationStepName
+	case gruid.KeyEnter, " ":
+		name, class := s.charName(), actor.Classes[s.selected]
+		s.Reset()
+		if s.onCreate != nil {
+			s.onCreate(name, class)
+		}
+		return state.StateGame
+	}
+	return state.StateCreation
+}
+
+// Draw draws the name prompt, the class list and the selected class's kit
+func (s *CreationScreen) Draw(grid *gruid.Grid) {
+	grid.Fill(gruid.Cell{Rune: ' '})
+
+	s.drawCenteredText(grid, 2, i18n.T("creation.title"), colorYellow)
+
+	nameStyle := colorGray
+	if s.step == creationStepName {
+		nameStyle = colorWhite
+	}
+	name := s.nameBuffer
+	if s.step == creationStepName {
+		name += "_"
+	} else {
+		name = s.charName()
+	}
+	s.drawText(grid, 6, 5, i18n.T("creation.name", name), nameStyle)
+
+	if s.step == creationStepName {
+		s.drawCenteredText(grid, s.height-3, i18n.T("creation.name_help", s.defaultName), colorDarkGray)
+		return
+	}
+
+	s.drawText(grid, 6, 8, i18n.T("creation.choose_class"), colorWhite)
+	for i, class := range actor.Classes {
+		style, prefix := colorGray, "  "
+		if i == s.selected {
+			style, prefix = colorYellow, "> "
+		}
+		s.drawText(grid, 8, 10+i, prefix+i18n.Named("class", string(class)), style)
+	}
+
+	s.drawClassDetails(grid, actor.Classes[s.selected], 10+len(actor.Classes)+1)
+	s.drawCenteredText(grid, s.height-3, i18n.T("creation.class_help"), colorDarkGray)
+}
+
+// drawClassDetails shows the class's description, stats and starting kit
+// 表示には実際に作られるプレイヤーを使うので、説明と中身がずれない
+func (s *CreationScreen) drawClassDetails(grid *gruid.Grid, class actor.Class, y int) {
+	preview, ok := s.previews[class]
+	if !ok {
+		preview = actor.NewPlayerWithClass(0, 0, class)
+		s.previews[class] = preview
+	}
+
+	s.drawText(grid, 6, y, i18n.T("class."+string(class)+"_desc"), colorWhite)
+	s.drawText(grid, 6, y+2, i18n.T("creation.stats", preview.MaxHP, preview.Attack, preview.Defense, preview.Gold), colorGray)
+
+	weapon, armor, _, _ := preview.Equipment.GetEquippedNames()
+	s.drawText(grid, 6, y+4, i18n.T("creation.equipment", weapon, armor), colorGray)
+
+	s.drawText(grid, 6, y+5, i18n.T("creation.pack"), colorGray)
+	for i, itm := range preview.Inventory.Items {
+		s.drawText(grid, 8, y+6+i, preview.IdentifyMgr.GetDisplayName(itm), colorGray)
+	}
+}
+
+// drawText draws text at the specified position with the given style
+func (s *CreationScreen) drawText(grid *gruid.Grid, x, y int, text string, style gruid.Style) {
+	for i, r := range []rune(text) {
+		if x+i < 0 || x+i >= s.width || y >= s.height {
+			continue
+		}
+		grid.Set(gruid.Point{X: x + i, Y: y}, gruid.Cell{Rune: r, Style: style})
+	}
+}
+
+// drawCenteredText draws centered text
+func (s *CreationScreen) drawCenteredText(grid *gruid.Grid, y int, text string, style gruid.Style) {
+	x := (s.width - len([]rune(text))) / 2
+	if x < 0 {
+		x = 0
+	}
+	s.drawText(grid, x, y, text, style)
+}
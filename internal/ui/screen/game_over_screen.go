@@ -0,0 +1,294 @@
+// Package screen ゲーム終了画面
+// 死亡時はオリジナルローグ風の墓碑、脱出時は勝利画面を描画し、スコアを記録して順位を表示する
+package screen
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anaseto/gruid"
+	"github.com/yuru-sha/gorogue/internal/core/state"
+	"github.com/yuru-sha/gorogue/internal/game/actor"
+	"github.com/yuru-sha/gorogue/internal/game/save"
+	"github.com/yuru-sha/gorogue/internal/game/score"
+	"github.com/yuru-sha/gorogue/internal/i18n"
+	"github.com/yuru-sha/gorogue/internal/utils/logger"
+)
+
+// tombstoneArt is the Rogue-style RIP tombstone
+var tombstoneArt = []string{
+	"              __________",
+	"             /          \\",
+	"            /    REST    \\",
+	"           /      IN      \\",
+	"          /     PEACE      \\",
+	"         /                  \\",
+	"         |                  |",
+	"         |                  |",
+	"         |                  |",
+	"         |                  |",
+	"         |                  |",
+	"         |                  |",
+	"        *|     *  *  *      | *",
+	"________)/\\\\_//(\\/(/\\)/\\//\\/|_)_______",
+}
+
+// victoryLines are the catalog keys of the message in the victory scroll ("" for a blank line)
+var victoryLines = []string{
+	"gameover.victory_1",
+	"gameover.victory_2",
+	"",
+	"gameover.victory_3",
+	"gameover.victory_4",
+}
+
+// victoryArt draws the victory message in the current language inside a scroll
+// 枠の幅は一番長い行に合わせる
+func victoryArt() []string {
+	lines := make([]string, len(victoryLines))
+	textWidth := 0
+	for i, id := range victoryLines {
+		if id != "" {
+			lines[i] = i18n.T(id)
+		}
+		textWidth = max(textWidth, len([]rune(lines[i])))
+	}
+
+	inner := textWidth + 5
+	art := []string{
+		"    " + strings.Repeat("_", inner-2),
+		"   /" + strings.Repeat(" ", inner-2) + "\\",
+	}
+	for _, line := range lines {
+		art = append(art, "  |   "+line+strings.Repeat(" ", inner-3-len([]rune(line)))+"|")
+	}
+	return append(art, "   \\"+strings.Repeat("_", inner-2)+"/")
+}
+
+// tombstoneInnerWidth is the writable width inside the tombstone
+const tombstoneInnerWidth = 18
+
+// GameOverResult holds the outcome shown on the tombstone
+type GameOverResult struct {
+	Name       string
+	KilledBy   string
+	Floor      int
+	Gold       int
+	Year       int
+	Breakdown  *score.ScoreBreakdown
+	Rank       int  // 0 when the score did not make the table
+	Recorded   bool // スコアファイルに記録できたか
+	IsVictory  bool
+	IsWizard   bool   // ウィザードモードのゲームなのでスコア表に載せていない
+	MorgueFile string // 書き出したモルグファイルのパス（失敗時は空）
+}
+
+// GameOverScreen shows the tombstone and the final score
+type GameOverScreen struct {
+	width, height int
+	scoreManager  *score.ScoreManager
+	calculator    *score.ScoreCalculator
+	result        *GameOverResult
+}
+
+// NewGameOverScreen creates a new game over screen
+func NewGameOverScreen(width, height int, scoreManager *score.ScoreManager) *GameOverScreen {
+	return &GameOverScreen{
+		width:        width,
+		height:       height,
+		scoreManager: scoreManager,
+		calculator:   score.NewScoreCalculator(),
+	}
+}
+
+// RecordDeath scores the finished game, records the entry and prepares the tombstone
+func (s *GameOverScreen) RecordDeath(player *actor.Player, stats save.Stats, gameInfo save.GameInfo, reason string, floor int) *GameOverResult {
+	return s.record(player, stats, gameInfo, false, reason, floor)
+}
+
+// RecordVictory scores a won game, records the entry and prepares the victory screen
+func (s *GameOverScreen) RecordVictory(player *actor.Player, stats save.Stats, gameInfo save.GameInfo) *GameOverResult {
+	return s.record(player, stats, gameInfo, true, "", 0)
+}
+
+// record calculates the score breakdown and adds the entry to the score file
+func (s *GameOverScreen) record(player *actor.Player, stats save.Stats, gameInfo save.GameInfo, isVictory bool, reason string, floor int) *GameOverResult {
+	breakdown := s.calculator.CalculateScoreWithBreakdown(player, &stats, gameInfo.PlayTime, isVictory)
+
+	entry := s.calculator.CreateScoreEntry(gameInfo.CharName, player, &stats, &gameInfo, isVictory, reason)
+	entry.Score = breakdown.TotalScore
+	entry.DeepestFloor = stats.DeepestFloor
+	entry.Breakdown = breakdown
+
+	result := &GameOverResult{
+		Name:      gameInfo.CharName,
+		KilledBy:  reason,
+		Floor:     floor,
+		Gold:      player.Gold,
+		Year:      entry.Timestamp.Year(),
+		Breakdown: breakdown,
+		IsVictory: isVictory,
+	}
+
+	// ウィザードモードのゲームはスコア表に載せない
+	if gameInfo.IsWizard {
+		result.IsWizard = true
+		logger.Info("Wizard mode game is not added to the score table")
+		s.result = result
+		return result
+	}
+
+	// 追加前に順位を調べる
+	if isHighScore, rank, err := s.scoreManager.IsHighScore(entry.Score); err != nil {
+		logger.Warn("Failed to check high score", "error", err)
+	} else if isHighScore {
+		result.Rank = rank
+	}
+
+	if err := s.scoreManager.AddScore(entry); err != nil {
+		logger.Error("Failed to record score", "error", err)
+	} else {
+		result.Recorded = true
+	}
+
+	s.result = result
+	return result
+}
+
+// GetResult returns the result currently shown
+func (s *GameOverScreen) GetResult() *GameOverResult {
+	return s.result
+}
+
+// HandleInput returns to the title menu on any key
+func (s *GameOverScreen) HandleInput(msg gruid.Msg) state.GameState {
+	if _, ok := msg.(gruid.MsgKeyDown); ok {
+		s.result = nil
+		return state.StateMenu
+	}
+	if s.result != nil && s.result.IsVictory {
+		return state.StateVictory
+	}
+	return state.StateGameOver
+}
+
+// Draw draws the tombstone and score breakdown
+func (s *GameOverScreen) Draw(grid *gruid.Grid) {
+	grid.Fill(gruid.Cell{Rune: ' '})
+
+	if s.result == nil {
+		s.drawCenteredText(grid, s.height/2, i18n.T("gameover.press_any_key"), colorGray)
+		return
+	}
+
+	if s.result.IsVictory {
+		s.drawVictory(grid)
+		return
+	}
+
+	// 墓碑の描画
+	top := 2
+	left := (s.width - len(tombstoneArt[len(tombstoneArt)-1])) / 2
+	for i, line := range tombstoneArt {
+		s.drawText(grid, left, top+i, line, colorWhite)
+	}
+
+	// 墓碑の中に名前・死因・階層・ゴールドを刻む
+	center := left + 19
+	lines := []string{
+		s.result.Name,
+		i18n.T("gameover.gold", s.result.Gold),
+		i18n.T("gameover.killed_by"),
+		actor.DeathCauseName(s.result.KilledBy),
+		i18n.T("gameover.on_level", s.result.Floor),
+		fmt.Sprintf("%d", s.result.Year),
+	}
+	for i, line := range lines {
+		line = truncateText(line, tombstoneInnerWidth)
+		s.drawText(grid, center-len([]rune(line))/2, top+6+i, line, colorYellow)
+	}
+
+	s.drawBreakdown(grid, top+len(tombstoneArt)+2)
+
+	s.drawCenteredText(grid, s.height-2, i18n.T("gameover.return_title"), colorDarkGray)
+}
+
+// drawVictory draws the victory message and score breakdown
+func (s *GameOverScreen) drawVictory(grid *gruid.Grid) {
+	top := 3
+	art := victoryArt()
+	left := (s.width - len([]rune(art[len(art)-1]))) / 2
+	for i, line := range art {
+		s.drawText(grid, left, top+i, line, colorYellow)
+	}
+
+	title := i18n.T("gameover.escaped", s.result.Name, s.result.Gold)
+	s.drawCenteredText(grid, top+len(art)+1, title, colorWhite)
+
+	s.drawBreakdown(grid, top+len(art)+3)
+
+	s.drawCenteredText(grid, s.height-2, i18n.T("gameover.return_title"), colorDarkGray)
+}
+
+// drawBreakdown draws the score breakdown and the rank
+func (s *GameOverScreen) drawBreakdown(grid *gruid.Grid, y int) {
+	b := s.result.Breakdown
+	rows := []struct {
+		label string
+		value int
+	}{
+		{i18n.T("score.base"), b.BaseScore},
+		{i18n.T("score.victory_bonus"), b.VictoryBonus},
+		{i18n.T("score.floor_bonus"), b.FloorBonus},
+		{i18n.T("score.monster_bonus"), b.MonsterKillBonus},
+		{i18n.T("score.gold_bonus"), b.GoldBonus},
+		{i18n.T("score.level_bonus"), b.LevelBonus},
+		{i18n.T("score.survival_bonus"), b.SurvivalBonus},
+		{i18n.T("score.efficiency_bonus"), b.EfficiencyBonus},
+		{i18n.T("score.time_penalty"), -b.TimePenalty},
+	}
+
+	x := s.width/2 - 14
+	for i, row := range rows {
+		s.drawText(grid, x, y+i, fmt.Sprintf("%-18s %9d", row.label, row.value), colorGray)
+	}
+
+	y += len(rows)
+	s.drawText(grid, x, y, fmt.Sprintf("%-18s %9d", i18n.T("score.total"), b.TotalScore), colorWhite)
+	s.drawText(grid, x, y+1, fmt.Sprintf("%-18s %9s", i18n.T("score.grade"), s.calculator.GetScoreGrade(b.TotalScore)), colorWhite)
+
+	switch {
+	case s.result.IsWizard:
+		s.drawCenteredText(grid, y+3, i18n.T("gameover.wizard"), colorGray)
+	case !s.result.Recorded:
+		s.drawCenteredText(grid, y+3, i18n.T("gameover.not_recorded"), colorRed)
+	case s.result.Rank > 0:
+		rankText := i18n.T("gameover.rank", s.calculator.GetScoreRank(s.result.Rank), s.result.Rank)
+		s.drawCenteredText(grid, y+3, rankText, colorYellow)
+	default:
+		s.drawCenteredText(grid, y+3, i18n.T("gameover.not_ranked"), colorGray)
+	}
+
+	if s.result.MorgueFile != "" {
+		s.drawCenteredText(grid, y+5, i18n.T("gameover.morgue", s.result.MorgueFile), colorDarkGray)
+	}
+}
+
+// drawText draws text at the specified position with the given style
+func (s *GameOverScreen) drawText(grid *gruid.Grid, x, y int, text string, style gruid.Style) {
+	for i, r := range []rune(text) {
+		if x+i < 0 || x+i >= s.width || y >= s.height {
+			continue
+		}
+		grid.Set(gruid.Point{X: x + i, Y: y}, gruid.Cell{Rune: r, Style: style})
+	}
+}
+
+// drawCenteredText draws centered text
+func (s *GameOverScreen) drawCenteredText(grid *gruid.Grid, y int, text string, style gruid.Style) {
+	x := (s.width - len([]rune(text))) / 2
+	if x < 0 {
+		x = 0
+	}
+	s.drawText(grid, x, y, text, style)
+}
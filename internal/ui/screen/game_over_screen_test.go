@@ -0,0 +1,73 @@
+package screen
+
+import (
+	"testing"
+
+	"github.com/yuru-sha/gorogue/internal/game/actor"
+	"github.com/yuru-sha/gorogue/internal/game/save"
+	"github.com/yuru-sha/gorogue/internal/game/score"
+	"github.com/yuru-sha/gorogue/internal/i18n"
+	"github.com/yuru-sha/gorogue/internal/utils/logger"
+)
+
+// newTestGameOverScreen creates a game over screen writing scores under a temporary home
+func newTestGameOverScreen(t *testing.T) (*GameOverScreen, *score.ScoreManager) {
+	t.Helper()
+	logger.Setup()
+	t.Setenv("HOME", t.TempDir())
+
+	scoreManager := score.NewScoreManager()
+	if err := scoreManager.Initialize(); err != nil {
+		t.Fatalf("Initialize failed: %v", err)
+	}
+	return NewGameOverScreen(80, 24, scoreManager), scoreManager
+}
+
+func TestGameOverScreen_WizardGameIsNotScored(t *testing.T) {
+	s, scoreManager := newTestGameOverScreen(t)
+	player := actor.NewPlayer(0, 0)
+	stats := save.Stats{DeepestFloor: 26, MonstersKilled: 50}
+
+	result := s.RecordVictory(player, stats, save.GameInfo{CharName: "Cheater", IsWizard: true})
+	if result.Recorded || result.Rank != 0 || !result.IsWizard {
+		t.Errorf("Wizard mode victory should not be scored, got %+v", result)
+	}
+	scores, err := scoreManager.GetAllScores()
+	if err != nil {
+		t.Fatalf("GetAllScores failed: %v", err)
+	}
+	if len(scores) != 0 {
+		t.Fatalf("Expected no score entries, got %v", scores)
+	}
+
+	// 通常のゲームは記録される
+	result = s.RecordDeath(player, stats, save.GameInfo{CharName: "Honest"}, "kobold", 3)
+	if !result.Recorded {
+		t.Error("A normal game should be recorded")
+	}
+	if scores, _ = scoreManager.GetAllScores(); len(scores) != 1 || scores[0].PlayerName != "Honest" {
+		t.Errorf("Expected only the normal game in the score table, got %v", scores)
+	}
+}
+
+func TestGameOverScreen_VictoryArt(t *testing.T) {
+	defer i18n.SetLanguage(i18n.CurrentLanguage())
+
+	for _, lang := range []i18n.Language{i18n.English, i18n.Japanese} {
+		i18n.SetLanguage(lang)
+		for _, id := range victoryLines {
+			if id != "" && !i18n.Has(id) {
+				t.Errorf("Missing catalog key %s", id)
+			}
+		}
+
+		// 枠の右端がそろう
+		art := victoryArt()
+		width := len([]rune(art[2]))
+		for _, line := range art[2 : len(art)-1] {
+			if len([]rune(line)) != width {
+				t.Errorf("%v: victory art line %q is not %d wide", lang, line, width)
+			}
+		}
+	}
+}
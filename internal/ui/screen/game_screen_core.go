@@ -6,10 +6,15 @@ import (
 	"github.com/anaseto/gruid"
 	"github.com/yuru-sha/gorogue/internal/core/cli"
 	"github.com/yuru-sha/gorogue/internal/core/command"
+	"github.com/yuru-sha/gorogue/internal/core/event"
+	"github.com/yuru-sha/gorogue/internal/core/session"
 	"github.com/yuru-sha/gorogue/internal/core/wizard"
 	"github.com/yuru-sha/gorogue/internal/game/actor"
 	"github.com/yuru-sha/gorogue/internal/game/dungeon"
 	gameitem "github.com/yuru-sha/gorogue/internal/game/item"
+	"github.com/yuru-sha/gorogue/internal/game/morgue"
+	"github.com/yuru-sha/gorogue/internal/game/save"
+	"github.com/yuru-sha/gorogue/internal/i18n"
 	"github.com/yuru-sha/gorogue/internal/utils/logger"
 )
 
@@ -24,6 +29,11 @@ const (
 	ModeQuaff
 	ModeRead
 	ModeCLI
+	ModeCall
+	ModeCallName
+	ModeTravel
+	ModeLook
+	ModeMessages
 )
 
 // GameScreen handles the main game display
@@ -32,7 +42,7 @@ type GameScreen struct {
 	player          *actor.Player
 	level           *dungeon.Level
 	dungeonManager  *dungeon.DungeonManager
-	messages        []string
+	messages        *messageLog            // メッセージ履歴と --More-- の状態
 	lastStats       map[string]interface{} // 前回のステータス情報
 	grid            gruid.Grid             // 画面全体のグリッド
 	wizardMode      *wizard.WizardMode     // ウィザードモード
@@ -42,6 +52,20 @@ type GameScreen struct {
 	cliBuffer       string                 // CLI入力バッファ
 	cliHistory      []string               // CLIコマンド履歴
 	cmdParser       *command.Parser        // Command parser
+	callItem        *gameitem.Item         // 名前を付ける対象アイテム
+	callBuffer      string                 // 名前入力バッファ
+	saveLoadScreen  *SaveLoadScreen        // セーブ/ロード画面
+	onVictory       func()                 // 魔除けを持って脱出したときのコールバック
+	onCheat         func()                 // ウィザードモードや CLI の裏技を使ったときのコールバック
+	morgueReport    func() *morgue.Report  // CLIのmorgueコマンド用
+	events          *event.Bus             // ゲームイベントの発行先
+	session         *session.Session       // ゲームルールを実行するセッション
+	bestiary        *save.Bestiary         // 調べるモードで表示するモンスター図鑑
+	lookTarget      int                    // 調べるモードで最後に飛んだ対象の番号
+	cursorX         int                    // 移動先・調べる場所のカーソル位置
+	cursorY         int
+	historyOffset   int  // メッセージ履歴画面で最新行から遡った行数
+	redrawRequested bool // 画面全体の再描画を求められた
 }
 
 // NewGameScreen creates a new game screen
@@ -50,7 +74,7 @@ func NewGameScreen(width, height int, player *actor.Player) *GameScreen {
 		width:           width,
 		height:          height,
 		player:          player,
-		messages:        make([]string, 0, 7), // 7行分のメッセージを保持
+		messages:        newMessageLog(),
 		lastStats:       make(map[string]interface{}),
 		grid:            gruid.NewGrid(width, height),
 		inputMode:       ModeNormal,
@@ -59,15 +83,7 @@ func NewGameScreen(width, height int, player *actor.Player) *GameScreen {
 		cliHistory:      make([]string, 0),
 		cmdParser:       command.NewParser(),
 	}
-
-	// PyRogue風の初期メッセージを追加
-	screen.AddMessage("Welcome to PyRogue!")
-	screen.AddMessage("Use vi keys (hjkl), arrow keys, or numpad (1-9) to move.")
-	screen.AddMessage("You are a skilled warrior.")
-	screen.AddMessage("You are equipped with a dagger and leather armor.")
-	screen.AddMessage("You start with no rings, potions, scrolls, food, and a scroll.")
-	screen.AddMessage("You see a lit room.")
-	screen.AddMessage("You enter the dungeon. Your quest begins!")
+	screen.SetEventBus(event.NewBus())
 
 	logger.Debug("Created game screen",
 		"width", width,
@@ -76,11 +92,23 @@ func NewGameScreen(width, height int, player *actor.Player) *GameScreen {
 	return screen
 }
 
+// TakeRedrawRequest reports whether a full redraw was requested and clears the request
+func (s *GameScreen) TakeRedrawRequest() bool {
+	requested := s.redrawRequested
+	s.redrawRequested = false
+	return requested
+}
+
 // SetLevel sets the dungeon level for the game screen
 func (s *GameScreen) SetLevel(level *dungeon.Level) {
 	s.level = level
 	s.wizardMode = wizard.NewWizardMode(level, s.player)
 	s.cliMode = cli.NewCLIMode(level, s.player)
+	s.cliMode.MorgueReport = s.morgueReport
+	s.cliMode.OnCheat = s.onCheat
+	if s.session != nil {
+		s.cliMode.Session = s.session
+	}
 	logger.Debug("Set dungeon level for game screen",
 		"width", level.Width,
 		"height", level.Height,
@@ -93,14 +121,107 @@ func (s *GameScreen) SetDungeonManager(dm *dungeon.DungeonManager) {
 	logger.Debug("Set dungeon manager for game screen")
 }
 
+// SetSaveLoadScreen sets the save/load screen opened by the save and load keys
+func (s *GameScreen) SetSaveLoadScreen(saveLoadScreen *SaveLoadScreen) {
+	s.saveLoadScreen = saveLoadScreen
+}
+
+// SetKeymap sets the keys used in the game and in cursor modes
+func (s *GameScreen) SetKeymap(km *command.Keymap) {
+	s.cmdParser.SetKeymap(km)
+}
+
+// SetBestiary sets the monster recall shown in look mode
+func (s *GameScreen) SetBestiary(bestiary *save.Bestiary) {
+	s.bestiary = bestiary
+}
+
+// SetOnVictory sets the callback invoked when the player escapes with the amulet
+func (s *GameScreen) SetOnVictory(onVictory func()) {
+	s.onVictory = onVictory
+}
+
+// SetOnCheat sets the callback invoked when wizard mode or a CLI cheat is used
+func (s *GameScreen) SetOnCheat(onCheat func()) {
+	s.onCheat = onCheat
+	if s.cliMode != nil {
+		s.cliMode.OnCheat = onCheat
+	}
+}
+
+// SetEventBus sets the bus gameplay events are published on and subscribes the message log
+func (s *GameScreen) SetEventBus(bus *event.Bus) {
+	s.events = bus
+	bus.SubscribeAll(s.logEvent)
+	if s.session != nil {
+		s.session.SetEventBus(bus)
+	}
+}
+
+// SetMorgueReport sets the builder used by the CLI morgue command
+func (s *GameScreen) SetMorgueReport(morgueReport func() *morgue.Report) {
+	s.morgueReport = morgueReport
+	if s.cliMode != nil {
+		s.cliMode.MorgueReport = morgueReport
+	}
+}
+
+// GetMessageHistory returns recent messages, oldest first
+func (s *GameScreen) GetMessageHistory() []string {
+	return s.messages.lines()
+}
+
+// ReplaceWorld swaps in a loaded player and dungeon without recreating the screen
+func (s *GameScreen) ReplaceWorld(player *actor.Player, dm *dungeon.DungeonManager) {
+	s.player = player
+	s.SetDungeonManager(dm)
+	s.session = session.New(player, dm)
+	s.session.SetEventBus(s.events)
+	s.SetLevel(dm.GetCurrentLevel())
+
+	// 入力途中の状態は旧ワールドのものなので破棄する
+	s.inputMode = ModeNormal
+	s.equippableItems = make([]*gameitem.Item, 0)
+	s.cliBuffer = ""
+	s.callItem = nil
+	s.callBuffer = ""
+	s.lastStats = make(map[string]interface{})
+
+	logger.Debug("Replaced game world",
+		"player_x", player.Position.X,
+		"player_y", player.Position.Y,
+		"floor", dm.GetCurrentFloor(),
+	)
+}
+
+// StartNewGame installs a freshly generated world and shows the opening messages
+func (s *GameScreen) StartNewGame(charName string, player *actor.Player, dm *dungeon.DungeonManager) {
+	s.ReplaceWorld(player, dm)
+	s.messages = newMessageLog()
+
+	// PyRogue風の初期メッセージを追加（職業と実際の装備から作る）
+	s.AddMessage(i18n.T("game.welcome"))
+	s.AddMessage(i18n.T("game.welcome_move"))
+	if player.Class != "" {
+		s.AddMessage(i18n.T("game.welcome_class", charName, i18n.Named("class", string(player.Class))))
+	}
+	weapon, armor, _, _ := player.Equipment.GetEquippedNames()
+	s.AddMessage(i18n.T("game.welcome_equipment", weapon, armor))
+	s.AddMessage(i18n.T("game.welcome_pack", player.Inventory.Size()))
+	s.AddMessage(i18n.T("game.welcome_room"))
+	s.AddMessage(i18n.T("game.welcome_quest"))
+}
+
 // AddMessage adds a message to the message log
 func (s *GameScreen) AddMessage(msg string) {
-	s.messages = append(s.messages, msg)
-	if len(s.messages) > 7 {
-		s.messages = s.messages[len(s.messages)-7:]
-	}
+	s.addMessage(msg, MessageInfo)
+}
+
+// addMessage adds a message shown in the color of its category
+func (s *GameScreen) addMessage(msg string, category MessageCategory) {
+	s.messages.add(msg, category)
 	logger.Debug("Added message to log",
 		"message", msg,
-		"messages_count", len(s.messages),
+		"messages_count", len(s.messages.entries),
 	)
 }
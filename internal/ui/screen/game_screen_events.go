@@ -0,0 +1,57 @@
+package screen
+
+import (
+	"github.com/yuru-sha/gorogue/internal/core/event"
+	gameitem "github.com/yuru-sha/gorogue/internal/game/item"
+	"github.com/yuru-sha/gorogue/internal/i18n"
+)
+
+// logEvent writes the message log entries for a game event
+// SDL UI の操作でも CLI コマンドでも同じメッセージが残る
+func (s *GameScreen) logEvent(ev event.Event) {
+	switch e := ev.(type) {
+	case event.AttackEvent:
+		s.addMessage(i18n.T("combat.player_hits", e.Monster.Type.DisplayName(), e.Damage), MessageCombat)
+	case event.MonsterKilledEvent:
+		s.addMessage(i18n.T("combat.monster_killed", e.Monster.Type.DisplayName()), MessageCombat)
+		s.addMessage(i18n.T("combat.rewards", e.Exp, e.Gold), MessageCombat)
+	case event.PlayerDamagedEvent:
+		if e.Source != "" {
+			s.addMessage(i18n.T("combat.player_damaged", e.Source, e.Damage), MessageWarning)
+		} else {
+			s.addMessage(i18n.T("combat.player_damaged_bare", e.Damage), MessageWarning)
+		}
+	case event.StatusChangedEvent:
+		if e.Status == event.StatusLevelUp {
+			s.AddMessage(i18n.T("game.level_up", e.Level))
+		}
+	case event.ItemPickedUpEvent:
+		s.announcePickup(e.Item)
+	case event.ItemUsedEvent:
+		s.addMessage(e.Message, MessageItem)
+	case event.ItemDroppedEvent:
+		s.addMessage(i18n.T("ui.dropped", s.player.IdentifyMgr.GetDisplayName(e.Item)), MessageItem)
+	case event.FloorChangedEvent:
+		if e.Down {
+			s.AddMessage(i18n.T("game.floor_down", e.Floor))
+		} else {
+			s.AddMessage(i18n.T("game.floor_up", e.Floor))
+		}
+	case event.MessageEvent:
+		s.AddMessage(e.Text)
+	}
+}
+
+// announcePickup shows the pickup message for an item
+func (s *GameScreen) announcePickup(item *gameitem.Item) {
+	displayName := s.player.IdentifyMgr.GetDisplayName(item)
+	switch item.Type {
+	case gameitem.ItemGold:
+		s.addMessage(i18n.T("ui.found_gold", item.Value), MessageItem)
+	case gameitem.ItemAmulet:
+		s.addMessage(i18n.T("ui.picked_up_amulet", displayName), MessageItem)
+		s.addMessage(i18n.T("game.amulet_power"), MessageItem)
+	default:
+		s.addMessage(i18n.T("ui.picked_up", displayName), MessageItem)
+	}
+}
@@ -5,14 +5,53 @@ import (
 
 	"github.com/anaseto/gruid"
 	"github.com/yuru-sha/gorogue/internal/core/command"
+	"github.com/yuru-sha/gorogue/internal/core/session"
 	"github.com/yuru-sha/gorogue/internal/core/state"
-	gameitem "github.com/yuru-sha/gorogue/internal/game/item"
-	"github.com/yuru-sha/gorogue/internal/game/magic"
+	"github.com/yuru-sha/gorogue/internal/i18n"
 	"github.com/yuru-sha/gorogue/internal/utils/logger"
 )
 
 // HandleInput handles input events
 func (s *GameScreen) HandleInput(msg gruid.Msg) state.GameState {
+	// --More-- の表示中はキー入力でメッセージを送る
+	if key, ok := msg.(gruid.MsgKeyDown); ok && s.messages.paging() {
+		if key.Key == gruid.KeyEscape {
+			s.messages.skipPages()
+		} else {
+			s.messages.nextPage()
+		}
+		return state.StateGame
+	}
+
+	s.messages.beginTurn()
+	next := s.handleInputByMode(msg)
+	s.messages.endTurn()
+
+	// 行動の結果プレイヤーが死亡したら墓碑画面へ
+	if next == state.StateGame && s.player != nil && !s.player.IsAlive() {
+		return s.handlePlayerDeath()
+	}
+	return next
+}
+
+// handlePlayerDeath reports the death and switches to the game over screen
+func (s *GameScreen) handlePlayerDeath() state.GameState {
+	// セッションの行動による死亡は報告済み。CLIやウィザードコマンドによる死亡はここで報告する
+	s.session.ReportDeath()
+	return state.StateGameOver
+}
+
+// handleVictory reports the escape and switches to the victory screen
+func (s *GameScreen) handleVictory() state.GameState {
+	logger.Info("Player escaped with the Amulet of Yendor")
+	if s.onVictory != nil {
+		s.onVictory()
+	}
+	return state.StateVictory
+}
+
+// handleInputByMode dispatches input to the handler of the current input mode
+func (s *GameScreen) handleInputByMode(msg gruid.Msg) state.GameState {
 	switch msg := msg.(type) {
 	case gruid.MsgKeyDown:
 		// モード別の処理
@@ -29,27 +68,52 @@ func (s *GameScreen) HandleInput(msg gruid.Msg) state.GameState {
 			return s.handleReadInput(msg.Key)
 		case ModeCLI:
 			return s.handleCLIInput(msg.Key)
+		case ModeCall:
+			return s.handleCallInput(msg.Key)
+		case ModeCallName:
+			return s.handleCallNameInput(msg.Key)
+		case ModeTravel:
+			return s.handleTravelInput(msg.Key)
+		case ModeLook:
+			return s.handleLookInput(msg.Key)
+		case ModeMessages:
+			return s.handleMessagesInput(msg.Key)
 		default: // ModeNormal
-			return s.handleNormalInput(msg.Key)
+			return s.handleNormalInput(msg)
 		}
 	}
 	return state.StateGame
 }
 
 // handleNormalInput handles input in normal mode
-func (s *GameScreen) handleNormalInput(key gruid.Key) state.GameState {
+func (s *GameScreen) handleNormalInput(msg gruid.MsgKeyDown) state.GameState {
 	// Parse the key into a command
-	cmd := s.cmdParser.Parse(key)
+	key := msg.Key
+	cmd := s.cmdParser.ParseKeyDown(msg)
 
 	switch cmd.Type {
 	// Movement commands
 	case command.CmdMoveWest, command.CmdMoveEast, command.CmdMoveNorth, command.CmdMoveSouth,
 		command.CmdMoveNorthWest, command.CmdMoveNorthEast, command.CmdMoveSouthWest, command.CmdMoveSouthEast:
-		s.tryMovePlayer(cmd.Direction.X, cmd.Direction.Y)
+		if cmd.Run {
+			s.handleRun(cmd.Direction.X, cmd.Direction.Y)
+		} else if cmd.Count > 1 {
+			s.handleRepeat(session.Move(cmd.Direction.X, cmd.Direction.Y), cmd.Count)
+		} else {
+			s.tryMovePlayer(cmd.Direction.X, cmd.Direction.Y)
+		}
+
+	// Prefix commands
+	case command.CmdCount:
+		// 回数はパーサーが次のコマンドまで保持する
+	case command.CmdRepeat:
+		s.AddMessage(i18n.T("game.nothing_to_repeat"))
 
 	// Action commands
 	case command.CmdLook:
-		s.handleLook()
+		s.enterLookMode()
+	case command.CmdMessages:
+		s.enterMessagesMode()
 	case command.CmdInventory:
 		s.showInventory()
 	case command.CmdPickUp:
@@ -58,10 +122,14 @@ func (s *GameScreen) handleNormalInput(key gruid.Key) state.GameState {
 		s.enterDropMode()
 	case command.CmdUse:
 		s.enterUseMode() // PyRogue unified use interface
+	case command.CmdQuaff:
+		s.enterQuaffMode()
+	case command.CmdRead:
+		s.enterReadMode()
 	case command.CmdWait:
-		s.handleWait()
+		s.handleWait(cmd.Count)
 	case command.CmdSearch:
-		s.handleSearch()
+		s.handleSearch(cmd.Count)
 	case command.CmdOpen:
 		s.handleOpenDoor()
 	case command.CmdClose:
@@ -70,22 +138,30 @@ func (s *GameScreen) handleNormalInput(key gruid.Key) state.GameState {
 		s.handleFight()
 	case command.CmdDisarm:
 		s.handleDisarm()
-	case command.CmdEquip:
+	case command.CmdEquip, command.CmdWield:
 		s.enterEquipMode()
-	case command.CmdUnequip:
+	case command.CmdUnequip, command.CmdTakeOff:
 		s.enterUnequipMode()
 	case command.CmdToggleFOV:
 		s.handleToggleFOV()
+	case command.CmdCall:
+		s.enterCallMode()
+	case command.CmdExplore:
+		s.handleAutoExplore()
+	case command.CmdTravel:
+		s.enterTravelMode()
 
 	// Stair commands
 	case command.CmdGoUpstairs:
-		s.handleStairs(true)
+		if result := s.act(session.Ascend()); result.Snapshot.Escaped {
+			return s.handleVictory()
+		}
 	case command.CmdGoDownstairs:
 		// Check if we're on stairs - if so, go down, otherwise wait
 		if s.canGoDownstairs() {
-			s.handleStairs(false)
+			s.act(session.Descend())
 		} else {
-			s.handleWait()
+			s.handleWait(cmd.Count)
 		}
 
 	// System commands
@@ -94,16 +170,31 @@ func (s *GameScreen) handleNormalInput(key gruid.Key) state.GameState {
 		return state.StateMenu
 	case command.CmdHelp:
 		return state.StateHelp
+	case command.CmdSave:
+		return s.openSaveLoadScreen(ModeSave)
+	case command.CmdLoad:
+		return s.openSaveLoadScreen(ModeLoad)
+	case command.CmdRedraw:
+		// 実際の再描画はエンジンが行う
+		s.redrawRequested = true
 	case command.CmdEscape:
+		if cmd.Count > 0 {
+			// 回数指定の途中なら取り消すだけ
+			s.AddMessage(i18n.T("game.count_canceled"))
+			break
+		}
 		logger.Info("Returning to menu")
 		return state.StateMenu
 	case command.CmdWizard:
 		s.wizardMode.Toggle()
-		status := "OFF"
+		status := i18n.T("ui.off")
 		if s.wizardMode.IsActive {
-			status = "ON"
+			status = i18n.T("ui.on")
+			if s.onCheat != nil {
+				s.onCheat()
+			}
 		}
-		s.AddMessage(fmt.Sprintf("ウィザードモード: %s", status))
+		s.AddMessage(i18n.T("ui.wizard_mode", status))
 	case command.CmdCLI:
 		s.enterCLIMode()
 
@@ -119,40 +210,16 @@ func (s *GameScreen) handleNormalInput(key gruid.Key) state.GameState {
 	return state.StateGame
 }
 
-// handleStairs handles stair movement
-func (s *GameScreen) handleStairs(goUp bool) {
-	if s.dungeonManager == nil {
-		s.AddMessage("Dungeon manager not available")
-		return
+// openSaveLoadScreen switches to the save/load screen in the given mode
+func (s *GameScreen) openSaveLoadScreen(mode SaveLoadMode) state.GameState {
+	if s.saveLoadScreen == nil {
+		s.AddMessage(i18n.T("ui.saving_unavailable"))
+		return state.StateGame
 	}
 
-	if goUp {
-		if s.dungeonManager.CanGoUpstairs() {
-			if s.dungeonManager.GoUpstairs() {
-				s.level = s.dungeonManager.GetCurrentLevel()
-				s.wizardMode.SetLevel(s.level)
-				s.AddMessage(fmt.Sprintf("階層 %d へ上がった", s.dungeonManager.GetCurrentFloor()))
-			}
-		} else {
-			s.AddMessage("ここには上り階段がない")
-		}
-	} else {
-		if s.dungeonManager.CanGoDownstairs() {
-			if s.dungeonManager.GoDownstairs() {
-				s.level = s.dungeonManager.GetCurrentLevel()
-				s.wizardMode.SetLevel(s.level)
-				s.AddMessage(fmt.Sprintf("階層 %d へ下りた", s.dungeonManager.GetCurrentFloor()))
-
-				// 最終階層に到達した場合、イェンダーの魔除けを配置
-				if s.dungeonManager.IsOnFinalFloor() {
-					s.dungeonManager.PlaceAmuletOfYendor()
-					s.AddMessage("この階層には強力な魔力を感じる...")
-				}
-			}
-		} else {
-			s.AddMessage("ここには下り階段がない")
-		}
-	}
+	s.saveLoadScreen.SetMode(mode)
+	s.saveLoadScreen.SetReturnState(state.StateGame)
+	return state.StateSaveLoad
 }
 
 // handleEquipInput handles input in equip mode
@@ -160,28 +227,22 @@ func (s *GameScreen) handleEquipInput(key gruid.Key) state.GameState {
 	switch key {
 	case gruid.KeyEscape:
 		s.inputMode = ModeNormal
-		s.AddMessage("Canceled.")
+		s.AddMessage(i18n.T("ui.canceled"))
 		return state.StateGame
 	default:
 		if len(string(key)) == 1 && string(key)[0] >= 'a' && string(key)[0] <= 'z' {
 			index := int(string(key)[0] - 'a')
 			if index < len(s.equippableItems) {
+				// 選択肢の番号をインベントリの位置に変換
 				item := s.equippableItems[index]
-				if s.player.Equipment.EquipItem(item) {
-					// インベントリからアイテムを削除
-					for i, invItem := range s.player.Inventory.Items {
-						if invItem == item {
-							s.player.Inventory.RemoveItem(i)
-							break
-						}
+				for i, invItem := range s.player.Inventory.Items {
+					if invItem == item {
+						s.act(session.Equip(i))
+						break
 					}
-					displayName := s.player.IdentifyMgr.GetDisplayName(item)
-					s.AddMessage(fmt.Sprintf("You equipped %s.", displayName))
-				} else {
-					s.AddMessage("You can't equip that item.")
 				}
 			} else {
-				s.AddMessage("Invalid selection.")
+				s.AddMessage(i18n.T("game.invalid_selection"))
 			}
 			s.inputMode = ModeNormal
 		}
@@ -194,58 +255,33 @@ func (s *GameScreen) handleUnequipInput(key gruid.Key) state.GameState {
 	switch key {
 	case gruid.KeyEscape:
 		s.inputMode = ModeNormal
-		s.AddMessage("Canceled.")
+		s.AddMessage(i18n.T("ui.canceled"))
 		return state.StateGame
 	case "w": // Unequip weapon
-		s.unequipSlot("weapon", "weapon")
+		s.act(session.Unequip(session.SlotWeapon))
 	case "a": // Unequip armor
-		s.unequipSlot("armor", "armor")
+		s.act(session.Unequip(session.SlotArmor))
 	case "l": // Unequip left ring
-		s.unequipSlot("ring_left", "left ring")
+		s.act(session.Unequip(session.SlotRingLeft))
 	case "r": // Unequip right ring
-		s.unequipSlot("ring_right", "right ring")
+		s.act(session.Unequip(session.SlotRingRight))
 	default:
-		s.AddMessage("Invalid selection. Use (w)eapon, (a)rmor, (l)eft ring, (r)ight ring")
+		s.AddMessage(i18n.T("ui.invalid_unequip"))
 	}
 	s.inputMode = ModeNormal
 	return state.StateGame
 }
 
-// unequipSlot unequips an item from a specific slot
-func (s *GameScreen) unequipSlot(slot, displaySlot string) {
-	if item := s.player.Equipment.UnequipItem(slot); item != nil {
-		if s.player.Inventory.AddItem(item) {
-			displayName := s.player.IdentifyMgr.GetDisplayName(item)
-			s.AddMessage(fmt.Sprintf("You took off %s.", displayName))
-		} else {
-			s.AddMessage("Your pack is full!")
-			// 装備を戻す
-			s.player.Equipment.EquipItem(item)
-		}
-	} else {
-		s.AddMessage(fmt.Sprintf("You have no %s equipped.", displaySlot))
-	}
-}
-
 // handleDropInput handles input in drop mode
 func (s *GameScreen) handleDropInput(key gruid.Key) state.GameState {
 	switch key {
 	case gruid.KeyEscape:
 		s.inputMode = ModeNormal
-		s.AddMessage("Canceled.")
+		s.AddMessage(i18n.T("ui.canceled"))
 		return state.StateGame
 	default:
 		if len(string(key)) == 1 && string(key)[0] >= 'a' && string(key)[0] <= 'z' {
-			index := int(string(key)[0] - 'a')
-			if item := s.player.Inventory.GetItem(index); item != nil {
-				displayName := s.player.IdentifyMgr.GetDisplayName(item)
-				s.AddMessage(fmt.Sprintf("You dropped %s.", displayName))
-				// アイテムをプレイヤーの位置に配置
-				s.level.AddItem(item, s.player.Position.X, s.player.Position.Y)
-				s.player.Inventory.RemoveItem(index)
-			} else {
-				s.AddMessage("Invalid selection.")
-			}
+			s.act(session.Drop(int(string(key)[0] - 'a')))
 			s.inputMode = ModeNormal
 		}
 	}
@@ -257,28 +293,11 @@ func (s *GameScreen) handleQuaffInput(key gruid.Key) state.GameState {
 	switch key {
 	case gruid.KeyEscape:
 		s.inputMode = ModeNormal
-		s.AddMessage("Canceled.")
+		s.AddMessage(i18n.T("ui.canceled"))
 		return state.StateGame
 	default:
 		if len(string(key)) == 1 && string(key)[0] >= 'a' && string(key)[0] <= 'z' {
-			index := int(string(key)[0] - 'a')
-			if item := s.player.Inventory.GetItem(index); item != nil {
-				if item.Type == gameitem.ItemPotion {
-					result := magic.UsePotion(item.Name, s.player)
-					s.AddMessage(result.Message)
-
-					if result.Identified {
-						s.player.IdentifyMgr.IdentifyByUse(item)
-					}
-
-					// ポーションを消費
-					s.player.Inventory.RemoveItem(index)
-				} else {
-					s.AddMessage("You can't drink that!")
-				}
-			} else {
-				s.AddMessage("Invalid selection.")
-			}
+			s.act(session.Quaff(int(string(key)[0] - 'a')))
 			s.inputMode = ModeNormal
 		}
 	}
@@ -290,27 +309,38 @@ func (s *GameScreen) handleReadInput(key gruid.Key) state.GameState {
 	switch key {
 	case gruid.KeyEscape:
 		s.inputMode = ModeNormal
-		s.AddMessage("Canceled.")
+		s.AddMessage(i18n.T("ui.canceled"))
+		return state.StateGame
+	default:
+		if len(string(key)) == 1 && string(key)[0] >= 'a' && string(key)[0] <= 'z' {
+			s.act(session.Read(int(string(key)[0] - 'a')))
+			s.inputMode = ModeNormal
+		}
+	}
+	return state.StateGame
+}
+
+// handleCallInput handles item selection in call mode
+func (s *GameScreen) handleCallInput(key gruid.Key) state.GameState {
+	switch key {
+	case gruid.KeyEscape:
+		s.inputMode = ModeNormal
+		s.AddMessage(i18n.T("ui.canceled"))
 		return state.StateGame
 	default:
 		if len(string(key)) == 1 && string(key)[0] >= 'a' && string(key)[0] <= 'z' {
 			index := int(string(key)[0] - 'a')
 			if item := s.player.Inventory.GetItem(index); item != nil {
-				if item.Type == gameitem.ItemScroll {
-					result := magic.UseScroll(item.Name, s.player, s.level)
-					s.AddMessage(result.Message)
-
-					if result.Identified {
-						s.player.IdentifyMgr.IdentifyByUse(item)
-					}
-
-					// 巻物を消費
-					s.player.Inventory.RemoveItem(index)
-				} else {
-					s.AddMessage("You can't read that!")
+				if s.player.IdentifyMgr.CanCall(item) {
+					s.callItem = item
+					s.callBuffer = s.player.IdentifyMgr.GetCalledName(item)
+					s.inputMode = ModeCallName
+					s.AddMessage(i18n.T("ui.call_it_what"))
+					return state.StateGame
 				}
+				s.AddMessage(i18n.T("ui.no_need_to_call"))
 			} else {
-				s.AddMessage("Invalid selection.")
+				s.AddMessage(i18n.T("game.invalid_selection"))
 			}
 			s.inputMode = ModeNormal
 		}
@@ -318,13 +348,45 @@ func (s *GameScreen) handleReadInput(key gruid.Key) state.GameState {
 	return state.StateGame
 }
 
+// handleCallNameInput handles label entry in call mode
+func (s *GameScreen) handleCallNameInput(key gruid.Key) state.GameState {
+	switch key {
+	case gruid.KeyEscape:
+		s.inputMode = ModeNormal
+		s.callItem = nil
+		s.callBuffer = ""
+		s.AddMessage(i18n.T("ui.canceled"))
+	case gruid.KeyEnter:
+		if s.callItem != nil && s.player.IdentifyMgr.CallItem(s.callItem, s.callBuffer) {
+			displayName := s.player.IdentifyMgr.GetDisplayName(s.callItem)
+			s.AddMessage(i18n.T("ui.now_known_as", displayName))
+		}
+		s.inputMode = ModeNormal
+		s.callItem = nil
+		s.callBuffer = ""
+	case gruid.KeyBackspace:
+		if s.callBuffer != "" {
+			runes := []rune(s.callBuffer)
+			s.callBuffer = string(runes[:len(runes)-1])
+		}
+	default:
+		if len(string(key)) == 1 {
+			char := string(key)[0]
+			if char >= 32 && char <= 126 && len(s.callBuffer) < 30 { // Printable ASCII
+				s.callBuffer += string(char)
+			}
+		}
+	}
+	return state.StateGame
+}
+
 // handleCLIInput handles input in CLI mode
 func (s *GameScreen) handleCLIInput(key gruid.Key) state.GameState {
 	switch key {
 	case gruid.KeyEscape:
 		s.inputMode = ModeNormal
 		s.cliBuffer = ""
-		s.AddMessage("CLI mode exited.")
+		s.AddMessage(i18n.T("ui.cli_exited"))
 		return state.StateGame
 	case gruid.KeyEnter:
 		if s.cliBuffer != "" {
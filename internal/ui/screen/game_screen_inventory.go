@@ -3,7 +3,9 @@ package screen
 import (
 	"fmt"
 
+	"github.com/yuru-sha/gorogue/internal/core/session"
 	gameitem "github.com/yuru-sha/gorogue/internal/game/item"
+	"github.com/yuru-sha/gorogue/internal/i18n"
 )
 
 // showInventory displays the player's inventory
@@ -17,7 +19,7 @@ func (s *GameScreen) showInventory() {
 // enterEquipMode enters equipment selection mode
 func (s *GameScreen) enterEquipMode() {
 	if s.player.Inventory.IsEmpty() {
-		s.AddMessage("You have nothing to equip.")
+		s.AddMessage(i18n.T("ui.nothing_to_equip"))
 		return
 	}
 
@@ -30,7 +32,7 @@ func (s *GameScreen) enterEquipMode() {
 	}
 
 	if len(s.equippableItems) == 0 {
-		s.AddMessage("You have no equippable items.")
+		s.AddMessage(i18n.T("ui.no_equippable"))
 		return
 	}
 
@@ -40,13 +42,13 @@ func (s *GameScreen) enterEquipMode() {
 
 // showEquipMenu shows the equip item menu
 func (s *GameScreen) showEquipMenu() {
-	s.AddMessage("Equippable items:")
+	s.AddMessage(i18n.T("ui.equippable_items"))
 	for i, item := range s.equippableItems {
 		letter := rune('a' + i)
 		displayName := s.player.IdentifyMgr.GetDisplayName(item)
 		s.AddMessage(fmt.Sprintf("%c) %s", letter, displayName))
 	}
-	s.AddMessage("Equip which item? (a-z, ESC to cancel)")
+	s.AddMessage(i18n.T("ui.equip_which"))
 }
 
 // enterUnequipMode enters unequip selection mode
@@ -54,7 +56,7 @@ func (s *GameScreen) enterUnequipMode() {
 	// 現在装備しているアイテムがあるかチェック
 	if s.player.Equipment.Weapon == nil && s.player.Equipment.Armor == nil &&
 		s.player.Equipment.RingLeft == nil && s.player.Equipment.RingRight == nil {
-		s.AddMessage("You have nothing equipped to take off.")
+		s.AddMessage(i18n.T("ui.nothing_to_take_off"))
 		return
 	}
 
@@ -85,21 +87,21 @@ func (s *GameScreen) showUnequipMenu() {
 	}
 
 	if len(equippedItems) == 0 {
-		s.AddMessage("You have nothing equipped to take off.")
+		s.AddMessage(i18n.T("ui.nothing_to_take_off"))
 		return
 	}
 
-	s.AddMessage("Currently equipped:")
+	s.AddMessage(i18n.T("ui.currently_equipped"))
 	for _, item := range equippedItems {
 		s.AddMessage(item)
 	}
-	s.AddMessage("Take off which item? (w)eapon, (a)rmor, (l)eft ring, (r)ight ring")
+	s.AddMessage(i18n.T("ui.take_off_which"))
 }
 
 // enterDropMode enters drop selection mode
 func (s *GameScreen) enterDropMode() {
 	if s.player.Inventory.IsEmpty() {
-		s.AddMessage("You have nothing to drop.")
+		s.AddMessage(i18n.T("ui.nothing_to_drop"))
 		return
 	}
 
@@ -113,13 +115,13 @@ func (s *GameScreen) showDropMenu() {
 	for _, line := range listing {
 		s.AddMessage(line)
 	}
-	s.AddMessage("Drop which item? (a-z, ESC to cancel)")
+	s.AddMessage(i18n.T("ui.drop_which"))
 }
 
 // enterQuaffMode enters potion quaffing mode
 func (s *GameScreen) enterQuaffMode() {
 	if s.player.Inventory.IsEmpty() {
-		s.AddMessage("You have no potions to drink.")
+		s.AddMessage(i18n.T("ui.no_potions"))
 		return
 	}
 
@@ -132,7 +134,7 @@ func (s *GameScreen) enterQuaffMode() {
 	}
 
 	if len(potions) == 0 {
-		s.AddMessage("You have no potions to drink.")
+		s.AddMessage(i18n.T("ui.no_potions"))
 		return
 	}
 
@@ -142,7 +144,7 @@ func (s *GameScreen) enterQuaffMode() {
 
 // showPotions displays available potions
 func (s *GameScreen) showPotions() {
-	s.AddMessage("Available potions:")
+	s.AddMessage(i18n.T("ui.available_potions"))
 	index := 0
 	for i, item := range s.player.Inventory.Items {
 		if item.Type == gameitem.ItemPotion {
@@ -152,13 +154,13 @@ func (s *GameScreen) showPotions() {
 			index++
 		}
 	}
-	s.AddMessage("Quaff which potion? (a-z, ESC to cancel)")
+	s.AddMessage(i18n.T("ui.quaff_which"))
 }
 
 // enterReadMode enters scroll reading mode
 func (s *GameScreen) enterReadMode() {
 	if s.player.Inventory.IsEmpty() {
-		s.AddMessage("You have no scrolls to read.")
+		s.AddMessage(i18n.T("ui.no_scrolls"))
 		return
 	}
 
@@ -171,7 +173,7 @@ func (s *GameScreen) enterReadMode() {
 	}
 
 	if len(scrolls) == 0 {
-		s.AddMessage("You have no scrolls to read.")
+		s.AddMessage(i18n.T("ui.no_scrolls"))
 		return
 	}
 
@@ -181,7 +183,7 @@ func (s *GameScreen) enterReadMode() {
 
 // showScrolls displays available scrolls
 func (s *GameScreen) showScrolls() {
-	s.AddMessage("Available scrolls:")
+	s.AddMessage(i18n.T("ui.available_scrolls"))
 	index := 0
 	for i, item := range s.player.Inventory.Items {
 		if item.Type == gameitem.ItemScroll {
@@ -191,29 +193,57 @@ func (s *GameScreen) showScrolls() {
 			index++
 		}
 	}
-	s.AddMessage("Read which scroll? (a-z, ESC to cancel)")
+	s.AddMessage(i18n.T("ui.read_which"))
+}
+
+// enterCallMode enters item naming mode
+func (s *GameScreen) enterCallMode() {
+	// 名前を付けられるアイテム（未識別の巻物・薬・指輪）をリストアップ
+	hasCallable := false
+	for _, item := range s.player.Inventory.Items {
+		if s.player.IdentifyMgr.CanCall(item) {
+			hasCallable = true
+			break
+		}
+	}
+
+	if !hasCallable {
+		s.AddMessage(i18n.T("ui.nothing_to_call"))
+		return
+	}
+
+	s.inputMode = ModeCall
+	s.showCallableItems()
+}
+
+// showCallableItems displays items that can be called
+func (s *GameScreen) showCallableItems() {
+	s.AddMessage(i18n.T("ui.unidentified_items"))
+	for i, item := range s.player.Inventory.Items {
+		if s.player.IdentifyMgr.CanCall(item) {
+			letter := rune('a' + i)
+			displayName := s.player.IdentifyMgr.GetDisplayName(item)
+			s.AddMessage(fmt.Sprintf("%c) %s", letter, displayName))
+		}
+	}
+	s.AddMessage(i18n.T("ui.call_which"))
 }
 
 // enterCLIMode enters CLI debug mode
 func (s *GameScreen) enterCLIMode() {
 	if s.cliMode == nil {
-		s.AddMessage("CLI mode not available.")
+		s.AddMessage(i18n.T("ui.cli_unavailable"))
 		return
 	}
 
 	s.cliMode.IsActive = true
 	s.inputMode = ModeCLI
 	s.cliBuffer = ""
-	s.AddMessage("Entered CLI mode. Type 'help' for commands, ESC to exit.")
+	s.AddMessage(i18n.T("ui.cli_entered"))
 	s.AddMessage("CLI> ")
 }
 
 // canEquip checks if an item can be equipped
 func (s *GameScreen) canEquip(item *gameitem.Item) bool {
-	switch item.Type {
-	case gameitem.ItemWeapon, gameitem.ItemArmor, gameitem.ItemRing:
-		return true
-	default:
-		return false
-	}
+	return session.CanEquip(item)
 }
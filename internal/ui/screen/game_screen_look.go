@@ -0,0 +1,86 @@
+package screen
+
+import (
+	"strings"
+
+	"github.com/anaseto/gruid"
+	"github.com/yuru-sha/gorogue/internal/core/state"
+	"github.com/yuru-sha/gorogue/internal/i18n"
+)
+
+// lookPanelTop is the screen row where the look panel starts
+const lookPanelTop = 3
+
+// enterLookMode starts examining the map with the cursor
+func (s *GameScreen) enterLookMode() {
+	s.cursorX, s.cursorY = s.player.Position.X, s.player.Position.Y
+	s.lookTarget = -1
+	s.inputMode = ModeLook
+	s.AddMessage(i18n.T("look.prompt"))
+}
+
+// handleLookInput handles input while examining the map
+func (s *GameScreen) handleLookInput(key gruid.Key) state.GameState {
+	switch key {
+	case gruid.KeyEscape, gruid.KeyEnter, "x", "/":
+		s.inputMode = ModeNormal
+	case gruid.KeyTab, "+", "=":
+		s.jumpLookTarget(1)
+	case "-":
+		s.jumpLookTarget(-1)
+	default:
+		s.moveCursor(key)
+	}
+	return state.StateGame
+}
+
+// jumpLookTarget moves the cursor to the next or previous monster or item
+// 対象はプレイヤーに近い順に並んでいる
+func (s *GameScreen) jumpLookTarget(step int) {
+	targets := s.session.LookTargets()
+	if len(targets) == 0 {
+		s.AddMessage(i18n.T("look.nothing"))
+		return
+	}
+
+	if s.lookTarget < 0 && step < 0 {
+		s.lookTarget = 0
+	}
+	s.lookTarget = ((s.lookTarget+step)%len(targets) + len(targets)) % len(targets)
+	s.cursorX, s.cursorY = targets[s.lookTarget].X, targets[s.lookTarget].Y
+}
+
+// drawLookPanel draws the description of the position under the cursor
+// カーソルと重ならないよう、カーソルの反対側に表示する
+func (s *GameScreen) drawLookPanel(grid *gruid.Grid) {
+	desc, ok := s.session.Describe(s.cursorX, s.cursorY)
+	if !ok {
+		return
+	}
+	lines := desc.Lines()
+	if desc.Monster != nil && s.bestiary != nil {
+		// 戦ったことのあるモンスターは図鑑の内容も表示する
+		if record, known := s.bestiary.Record(desc.Monster.Symbol); known {
+			lines = append(lines, i18n.T("look.recall", recallSummary(record)), i18n.T("look.killed", record.Kills))
+		}
+	}
+
+	width := 0
+	for _, line := range lines {
+		width = max(width, len([]rune(line)))
+	}
+	width += 4 // 枠と余白
+
+	x := s.width - width
+	if s.cursorX >= s.width/2 {
+		x = 0
+	}
+
+	style := gruid.Style{Fg: 0xFFFFFF, Bg: 0x202020}
+	border := "+" + strings.Repeat("-", width-2) + "+"
+	s.drawText(grid, x, lookPanelTop, border, style)
+	for i, line := range lines {
+		s.drawText(grid, x, lookPanelTop+1+i, "| "+line+strings.Repeat(" ", width-4-len([]rune(line)))+" |", style)
+	}
+	s.drawText(grid, x, lookPanelTop+1+len(lines), border, style)
+}
@@ -0,0 +1,66 @@
+package screen
+
+import (
+	"github.com/anaseto/gruid"
+	"github.com/yuru-sha/gorogue/internal/core/state"
+	"github.com/yuru-sha/gorogue/internal/i18n"
+)
+
+// enterMessagesMode opens the message history, showing the newest messages
+func (s *GameScreen) enterMessagesMode() {
+	s.historyOffset = 0
+	s.inputMode = ModeMessages
+}
+
+// historyRows returns the number of message rows on the history screen
+func (s *GameScreen) historyRows() int {
+	return s.height - 4 // タイトルと操作説明の行を除く
+}
+
+// handleMessagesInput scrolls the message history; other keys close it
+func (s *GameScreen) handleMessagesInput(key gruid.Key) state.GameState {
+	rows := s.historyRows()
+	maxOffset := max(0, len(s.messages.entries)-rows)
+
+	switch key {
+	case "k", gruid.KeyArrowUp:
+		s.historyOffset++
+	case "j", gruid.KeyArrowDown:
+		s.historyOffset--
+	case gruid.KeyPageUp, "b":
+		s.historyOffset += rows
+	case gruid.KeyPageDown, " ", "f":
+		s.historyOffset -= rows
+	case gruid.KeyHome, "g":
+		s.historyOffset = maxOffset
+	case gruid.KeyEnd, "G":
+		s.historyOffset = 0
+	default:
+		s.inputMode = ModeNormal
+	}
+	s.historyOffset = min(max(s.historyOffset, 0), maxOffset)
+	return state.StateGame
+}
+
+// drawMessageHistory draws the scrollback over the whole screen
+// 古いメッセージが上、最新のメッセージが下になる
+func (s *GameScreen) drawMessageHistory(grid *gruid.Grid) {
+	grid.Fill(gruid.Cell{Rune: ' ', Style: gruid.Style{Fg: 0x000000, Bg: 0x000000}})
+
+	entries := s.messages.entries
+	end := len(entries) - s.historyOffset
+	start := max(0, end-s.historyRows())
+
+	title := i18n.T("ui.messages_page", start+1, end, len(entries))
+	if len(entries) == 0 {
+		title = i18n.T("ui.messages")
+	}
+	s.drawText(grid, 0, 0, title, gruid.Style{Fg: 0xFFFF00, Bg: 0x000000})
+
+	for i, entry := range entries[start:end] {
+		s.drawText(grid, 0, 2+i, entry.String(), gruid.Style{Fg: entry.Category.Color(), Bg: 0x000000})
+	}
+
+	help := i18n.T("ui.messages_help")
+	s.drawText(grid, 0, s.height-1, help, gruid.Style{Fg: 0x808080, Bg: 0x000000})
+}
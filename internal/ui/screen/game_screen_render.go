@@ -5,6 +5,7 @@ import (
 	"reflect"
 
 	"github.com/anaseto/gruid"
+	"github.com/yuru-sha/gorogue/internal/i18n"
 	"github.com/yuru-sha/gorogue/internal/utils/logger"
 )
 
@@ -22,6 +23,12 @@ func (s *GameScreen) Draw(grid *gruid.Grid) {
 	// Output detailed drawing logs at TRACE level
 	logger.Trace("Drawing game screen")
 
+	// メッセージ履歴は画面全体に表示する
+	if s.inputMode == ModeMessages {
+		s.drawMessageHistory(grid)
+		return
+	}
+
 	// Clear grid - consistent black background with proper alpha
 	blackCell := gruid.Cell{Rune: ' ', Style: gruid.Style{Fg: 0x000000, Bg: 0x000000}}
 	grid.Fill(blackCell)
@@ -42,6 +49,19 @@ func (s *GameScreen) Draw(grid *gruid.Grid) {
 	if s.inputMode == ModeCLI {
 		s.drawCLIPrompt(grid)
 	}
+
+	// 名前入力の表示
+	if s.inputMode == ModeCallName {
+		s.drawCallPrompt(grid)
+	}
+
+	// 移動先・調べる場所のカーソルの表示
+	if s.inputMode == ModeTravel || s.inputMode == ModeLook {
+		s.drawCursor(grid)
+	}
+	if s.inputMode == ModeLook {
+		s.drawLookPanel(grid)
+	}
 }
 
 // collectCurrentStats collects current player stats for change detection
@@ -83,8 +103,8 @@ func (s *GameScreen) drawStatusLines(grid *gruid.Grid) {
 	}
 
 	// 第1行: プレイヤーステータス
-	statusLine1 := fmt.Sprintf(
-		"Lv:%d  HP:%d/%d  Atk:%d  Def:%d  Hunger:%d%%  Exp:%d  Gold:%d",
+	statusLine1 := i18n.T(
+		"ui.status",
 		s.player.Level,
 		s.player.HP,
 		s.player.MaxHP,
@@ -94,11 +114,18 @@ func (s *GameScreen) drawStatusLines(grid *gruid.Grid) {
 		s.player.Exp,
 		s.player.Gold,
 	)
+	if count := s.cmdParser.PendingCount(); count > 0 {
+		statusLine1 += i18n.T("ui.status_count", count) // 入力途中の回数指定
+	}
 	s.drawText(grid, 0, 0, statusLine1, gruid.Style{Fg: 0xFFFFFF, Bg: 0x000000})
 
 	// 右上に詳細階層表示を追加
 	floorDisplay := s.formatFloorDisplay(currentFloor, floorInfo)
-	s.drawText(grid, s.width-len(floorDisplay), 0, floorDisplay, gruid.Style{Fg: 0xFFFFFF, Bg: 0x000000})
+	floorStyle := gruid.Style{Fg: 0xFFFFFF, Bg: 0x000000}
+	if hasAmulet, ok := floorInfo["player_has_amulet"].(bool); ok && hasAmulet {
+		floorStyle.Fg = 0xFFD700 // 魔除けを持っている間は金色で表示
+	}
+	s.drawText(grid, s.width-len([]rune(floorDisplay)), 0, floorDisplay, floorStyle)
 
 	// 第2行: 装備情報
 	s.drawEquipmentLine(grid)
@@ -106,26 +133,26 @@ func (s *GameScreen) drawStatusLines(grid *gruid.Grid) {
 
 // formatFloorDisplay formats the floor display with additional information
 func (s *GameScreen) formatFloorDisplay(currentFloor int, floorInfo map[string]interface{}) string {
-	baseDisplay := fmt.Sprintf("B%dF/26", currentFloor)
+	baseDisplay := i18n.T("ui.floor", currentFloor)
 
 	// 特別な階層の場合はマーカーを追加
 	if isSpecial, ok := floorInfo["is_special"].(bool); ok && isSpecial {
-		baseDisplay += " [MAZE]"
+		baseDisplay += i18n.T("ui.floor_maze")
 	}
 
 	// 最終階層の場合
 	if isFinal, ok := floorInfo["is_final"].(bool); ok && isFinal {
-		baseDisplay += " [FINAL]"
+		baseDisplay += i18n.T("ui.floor_final")
 	}
 
 	// 魔除けを持っている場合
 	if hasAmulet, ok := floorInfo["player_has_amulet"].(bool); ok && hasAmulet {
-		baseDisplay += " [AMULET]"
+		baseDisplay += i18n.T("ui.floor_amulet")
 	}
 
 	// 勝利可能な場合
 	if canEscape, ok := floorInfo["can_escape"].(bool); ok && canEscape {
-		baseDisplay += " [ESCAPE!]"
+		baseDisplay += i18n.T("ui.floor_escape")
 	}
 
 	return baseDisplay
@@ -134,8 +161,8 @@ func (s *GameScreen) formatFloorDisplay(currentFloor int, floorInfo map[string]i
 // drawEquipmentLine draws the equipment status line
 func (s *GameScreen) drawEquipmentLine(grid *gruid.Grid) {
 	weapon, armor, ringLeft, ringRight := s.player.Equipment.GetEquippedNames()
-	statusLine2 := fmt.Sprintf(
-		"Weapon: %-15s  Armor: %-15s  Ring: (L): %-10s  Ring: (R): %-10s",
+	statusLine2 := i18n.T(
+		"ui.equipment",
 		weapon,
 		armor,
 		ringLeft,
@@ -144,7 +171,7 @@ func (s *GameScreen) drawEquipmentLine(grid *gruid.Grid) {
 
 	// ウィザードモードの表示を追加
 	if s.wizardMode != nil && s.wizardMode.IsActive {
-		statusLine2 += "  [WIZARD MODE]"
+		statusLine2 += i18n.T("ui.wizard_tag")
 	}
 
 	s.drawText(grid, 0, 1, statusLine2, gruid.Style{Fg: 0xFFFFFF, Bg: 0x000000})
@@ -190,10 +217,14 @@ func (s *GameScreen) drawEntities(grid *gruid.Grid) {
 	})
 }
 
-// drawMessageLog draws the message log at the bottom
+// drawMessageLog draws the message log at the bottom, colored by category
 func (s *GameScreen) drawMessageLog(grid *gruid.Grid) {
-	for i, msg := range s.messages {
-		s.drawText(grid, 0, s.height-7+i, msg, gruid.Style{Fg: 0xFFFFFF, Bg: 0x000000})
+	entries, more := s.messages.visible()
+	for i, entry := range entries {
+		s.drawText(grid, 0, s.height-messageLines+i, entry.String(), gruid.Style{Fg: entry.Category.Color(), Bg: 0x000000})
+	}
+	if more {
+		s.drawText(grid, 0, s.height-1, i18n.T("ui.more"), gruid.Style{Fg: 0x000000, Bg: 0xFFFFFF}) // 反転表示
 	}
 }
 
@@ -203,9 +234,15 @@ func (s *GameScreen) drawCLIPrompt(grid *gruid.Grid) {
 	s.drawText(grid, 0, s.height-1, cliPrompt, gruid.Style{Fg: 0x00FF00, Bg: 0x000000}) // 緑色で表示
 }
 
+// drawCallPrompt draws the label prompt when naming an item
+func (s *GameScreen) drawCallPrompt(grid *gruid.Grid) {
+	callPrompt := i18n.T("ui.call_prompt", s.callBuffer)
+	s.drawText(grid, 0, s.height-1, callPrompt, gruid.Style{Fg: 0xFFFF00, Bg: 0x000000}) // 黄色で表示
+}
+
 // drawText draws text at the specified position with the given style
 func (s *GameScreen) drawText(grid *gruid.Grid, x, y int, text string, style gruid.Style) {
-	for i, r := range text {
+	for i, r := range []rune(text) {
 		pos := gruid.Point{X: x + i, Y: y}
 		if pos.X >= grid.Size().X {
 			break
@@ -0,0 +1,75 @@
+package screen
+
+import (
+	"github.com/anaseto/gruid"
+	"github.com/yuru-sha/gorogue/internal/core/session"
+	"github.com/yuru-sha/gorogue/internal/core/state"
+	"github.com/yuru-sha/gorogue/internal/i18n"
+)
+
+// cursorJump is how far the cursor moves with an uppercase direction key
+const cursorJump = 8
+
+// enterTravelMode starts picking a travel destination with the map cursor
+func (s *GameScreen) enterTravelMode() {
+	s.cursorX, s.cursorY = s.player.Position.X, s.player.Position.Y
+	s.inputMode = ModeTravel
+	s.AddMessage(i18n.T("ui.travel_prompt"))
+}
+
+// handleTravelInput handles input while picking a travel destination
+func (s *GameScreen) handleTravelInput(key gruid.Key) state.GameState {
+	switch key {
+	case gruid.KeyEscape:
+		s.inputMode = ModeNormal
+		s.AddMessage(i18n.T("ui.canceled"))
+	case gruid.KeyEnter, ".", ",", "_":
+		s.inputMode = ModeNormal
+		s.reportTravel(s.session.Travel(s.cursorX, s.cursorY))
+	case "<":
+		s.inputMode = ModeNormal
+		s.reportTravel(s.session.TravelToStairs(false))
+	case ">":
+		s.inputMode = ModeNormal
+		s.reportTravel(s.session.TravelToStairs(true))
+	default:
+		s.moveCursor(key)
+	}
+	return state.StateGame
+}
+
+// moveCursor moves the map cursor with the movement keys
+func (s *GameScreen) moveCursor(key gruid.Key) {
+	cmd := s.cmdParser.Parse(key)
+	if !cmd.Type.IsMovement() {
+		return
+	}
+	dx, dy := cmd.Direction.X, cmd.Direction.Y
+
+	// 走るキーではまとめて動かす
+	steps := 1
+	if cmd.Run {
+		steps = cursorJump
+	}
+	for i := 0; i < steps; i++ {
+		if !s.level.IsInBounds(s.cursorX+dx, s.cursorY+dy) {
+			break
+		}
+		s.cursorX += dx
+		s.cursorY += dy
+	}
+}
+
+// reportTravel shows why a travel run ended
+func (s *GameScreen) reportTravel(result session.RunResult) {
+	s.AddMessage(result.Reason.String())
+}
+
+// drawCursor highlights the map cursor
+func (s *GameScreen) drawCursor(grid *gruid.Grid) {
+	pos := gruid.Point{X: s.cursorX, Y: s.cursorY + 2}
+	cell := grid.At(pos)
+	cell.Style.Bg = 0xFFFF00 // 黄色の背景
+	cell.Style.Fg = 0x000000
+	grid.Set(pos, cell)
+}
@@ -1,14 +1,17 @@
 package screen
 
 import (
-	"fmt"
-	"sort"
+	"strings"
 
 	"github.com/anaseto/gruid"
 	"github.com/yuru-sha/gorogue/internal/core/command"
 	"github.com/yuru-sha/gorogue/internal/core/state"
+	"github.com/yuru-sha/gorogue/internal/i18n"
 )
 
+// helpKeyWidth is the width of the key column
+const helpKeyWidth = 12
+
 // HelpScreen displays game commands and controls
 type HelpScreen struct {
 	width, height int
@@ -32,66 +35,60 @@ func (s *HelpScreen) Draw(dst *gruid.Grid) {
 	s.grid.Fill(gruid.Cell{Rune: ' '})
 
 	// Draw title
-	title := "GoRogue - Command Help"
-	titleX := (s.width - len(title)) / 2
+	title := i18n.T("help.title")
+	titleX := (s.width - len([]rune(title))) / 2
 	s.drawString(titleX, 2, title, 0xFFFF00, 0x000000) // Yellow on black
 
 	// Draw subtitle
-	subtitle := "Press any key to return to game"
-	subtitleX := (s.width - len(subtitle)) / 2
+	subtitle := i18n.T("help.subtitle")
+	subtitleX := (s.width - len([]rune(subtitle))) / 2
 	s.drawString(subtitleX, 4, subtitle, 0x00FFFF, 0x000000) // Cyan on black
 
-	// Get key bindings
-	bindings := s.parser.GetKeyBindings()
-
-	// Group commands by category
-	categories := map[string][]string{
-		"Movement":   []string{"h,j,k,l", "y,u,b,n", "Arrow keys"},
-		"Actions":    []string{"x", "i", ",", "d", "a", "q", "r", "w", "t", ".", "s", "o", "c"},
-		"Navigation": []string{"<", ">"},
-		"System":     []string{"Q", "?", "ESC", "Ctrl+W", ":"},
+	// 有効なキーマップから生成する（左の列に移動と階段、右の列に行動とシステム）
+	keymap := s.parser.Keymap()
+	columns := [][]string{
+		{command.CategoryMovement, command.CategoryNavigation},
+		{command.CategoryActions, command.CategorySystem},
 	}
-
-	// Draw commands by category
-	y := 6
-	for _, category := range []string{"Movement", "Actions", "Navigation", "System"} {
-		// Draw category header
-		s.drawString(5, y, fmt.Sprintf("=== %s ===", category), 0x00FF00, 0x000000) // Green on black
-		y += 2
-
-		// Sort keys in category for consistent display
-		keys := categories[category]
-		sort.Strings(keys)
-
-		// Draw commands in category
-		for _, key := range keys {
-			if desc, ok := bindings[key]; ok {
-				// Format the key display
-				keyDisplay := fmt.Sprintf("%-12s", key)
-				s.drawString(7, y, keyDisplay, 0xFFFFFF, 0x000000) // White on black
-				s.drawString(20, y, desc, 0x808080, 0x000000)      // Gray on black
+	columnWidth := s.width / len(columns)
+	for i, categories := range columns {
+		x := 2 + i*columnWidth
+		y := 6
+		for _, category := range categories {
+			s.drawString(x, y, i18n.T("help."+category), 0x00FF00, 0x000000) // Green on black
+			y++
+			for _, entry := range keymap.HelpEntries(category) {
+				keys := s.fitText(strings.Join(entry.Keys, " "), helpKeyWidth)
+				s.drawString(x+1, y, keys, 0xFFFFFF, 0x000000) // White on black
+				desc := s.fitText(i18n.T("cmd."+entry.Action), columnWidth-helpKeyWidth-3)
+				s.drawString(x+helpKeyWidth+2, y, desc, 0x808080, 0x000000) // Gray on black
 				y++
 			}
+			y++ // Extra space between categories
 		}
-		y++ // Extra space between categories
 	}
 
-	// Draw additional info
-	y = s.height - 8
-	s.drawString(5, y, "Additional Information:", 0xFFFF00, 0x000000) // Yellow on black
-	y += 2
-	s.drawString(7, y, "• Vi keys (hjkl) and diagonal movement (yubn) follow vi/nethack conventions", 0x808080, 0x000000) // Gray on black
-	y++
-	s.drawString(7, y, "• Commands are case-sensitive (q=quaff, Q=quit)", 0x808080, 0x000000) // Gray on black
-	y++
-	s.drawString(7, y, "• Some commands will prompt for additional input (item selection, etc.)", 0x808080, 0x000000) // Gray on black
-	y++
-	s.drawString(7, y, "• Wizard mode provides debug features for testing", 0x808080, 0x000000) // Gray on black
+	y := s.height - 3
+	s.drawString(2, y, i18n.T("help.shift_run"), 0x808080, 0x000000)               // Gray on black
+	s.drawString(2, y+1, i18n.T("help.keymap", keymap.Name()), 0xFFFF00, 0x000000) // Yellow on black
 
 	// Copy to destination
 	dst.Copy(s.grid)
 }
 
+// SetKeymap sets the keymap the help is generated from
+func (s *HelpScreen) SetKeymap(km *command.Keymap) {
+	s.parser.SetKeymap(km)
+}
+
+// fitText cuts text that does not fit in width cells
+func (s *HelpScreen) fitText(text string, width int) string {
+	if runes := []rune(text); len(runes) > width {
+		return string(runes[:width])
+	}
+	return text
+}
+
 // HandleInput handles input events for the help screen
 func (s *HelpScreen) HandleInput(msg gruid.Msg) state.GameState {
 	switch msg.(type) {
@@ -104,7 +101,7 @@ func (s *HelpScreen) HandleInput(msg gruid.Msg) state.GameState {
 
 // drawString draws a string at the specified position
 func (s *HelpScreen) drawString(x, y int, str string, fg, bg gruid.Color) {
-	for i, r := range str {
+	for i, r := range []rune(str) {
 		if x+i < s.width && y < s.height {
 			s.grid.Set(gruid.Point{X: x + i, Y: y}, gruid.Cell{
 				Rune: r,
@@ -3,6 +3,8 @@ package screen
 import (
 	"github.com/anaseto/gruid"
 	"github.com/yuru-sha/gorogue/internal/core/state"
+	"github.com/yuru-sha/gorogue/internal/game/save"
+	"github.com/yuru-sha/gorogue/internal/i18n"
 	"github.com/yuru-sha/gorogue/internal/utils/logger"
 )
 
@@ -12,11 +14,48 @@ var titleArt = []string{
 	"",
 }
 
-var menuBox = []string{
-	"",
-	"NEW GAME",
-	"QUIT",
-	"",
+// MenuItem represents an entry of the title menu
+type MenuItem int
+
+const (
+	MenuNewGame MenuItem = iota
+	MenuContinue
+	MenuLoad
+	MenuHighScores
+	MenuStatistics
+	MenuAchievements
+	MenuBestiary
+	MenuOptions
+	MenuQuit
+)
+
+// menuItems is the display order of the title menu
+var menuItems = []MenuItem{MenuNewGame, MenuContinue, MenuLoad, MenuHighScores, MenuStatistics, MenuAchievements, MenuBestiary, MenuOptions, MenuQuit}
+
+// String returns the menu label
+func (m MenuItem) String() string {
+	switch m {
+	case MenuNewGame:
+		return i18n.T("menu.new_game")
+	case MenuContinue:
+		return i18n.T("menu.continue")
+	case MenuLoad:
+		return i18n.T("menu.load")
+	case MenuHighScores:
+		return i18n.T("menu.high_scores")
+	case MenuStatistics:
+		return i18n.T("menu.statistics")
+	case MenuAchievements:
+		return i18n.T("menu.achievements")
+	case MenuBestiary:
+		return i18n.T("menu.bestiary")
+	case MenuOptions:
+		return i18n.T("menu.options")
+	case MenuQuit:
+		return i18n.T("menu.quit")
+	default:
+		return i18n.T("menu.unknown")
+	}
 }
 
 var version = "v0.1.0"
@@ -27,6 +66,7 @@ var (
 	colorGray     = gruid.Style{Fg: 8}  // グレー
 	colorWhite    = gruid.Style{Fg: 15} // 白
 	colorDarkGray = gruid.Style{Fg: 7}  // 暗いグレー
+	colorRed      = gruid.Style{Fg: 1}  // 赤
 )
 
 // MenuScreen represents the menu screen
@@ -35,6 +75,16 @@ type MenuScreen struct {
 	height   int
 	selected int
 	grid     gruid.Grid
+	message  string
+
+	saveIntegration *save.SaveGameIntegration
+	saveLoadScreen  *SaveLoadScreen
+	onNewGame       func() // 新規ゲームのキャラクター作成を始めるコールバック
+	onContinue      func() // オートセーブ再開時のコールバック
+
+	// セーブの有無は毎フレーム調べず、メニューに入ったときとセーブ・削除の後に更新する
+	canContinue bool
+	canLoad     bool
 }
 
 // NewMenuScreen creates a new menu screen
@@ -47,24 +97,136 @@ func NewMenuScreen(width, height int) *MenuScreen {
 	}
 }
 
+// SetSaveIntegration sets the save system used by Continue and Load
+func (s *MenuScreen) SetSaveIntegration(saveIntegration *save.SaveGameIntegration) {
+	s.saveIntegration = saveIntegration
+	s.RefreshSaves()
+}
+
+// SetSaveLoadScreen sets the screen opened by Load
+func (s *MenuScreen) SetSaveLoadScreen(saveLoadScreen *SaveLoadScreen) {
+	s.saveLoadScreen = saveLoadScreen
+	s.RefreshSaves()
+}
+
+// RefreshSaves checks which saves exist so Continue and Load can be enabled
+func (s *MenuScreen) RefreshSaves() {
+	s.canContinue = s.saveIntegration != nil && s.saveIntegration.HasAutoSave()
+	s.canLoad = s.saveIntegration != nil && s.saveLoadScreen != nil &&
+		s.saveIntegration.HasAnySave()
+}
+
+// SetOnNewGame sets the callback invoked before the character creation screen opens
+func (s *MenuScreen) SetOnNewGame(onNewGame func()) {
+	s.onNewGame = onNewGame
+}
+
+// SetOnContinue sets the callback invoked after the auto-save was loaded
+func (s *MenuScreen) SetOnContinue(onContinue func()) {
+	s.onContinue = onContinue
+}
+
+// GetSelectedItem returns the highlighted menu item
+func (s *MenuScreen) GetSelectedItem() MenuItem {
+	return menuItems[s.selected]
+}
+
+// IsEnabled reports whether a menu item can be chosen right now
+func (s *MenuScreen) IsEnabled(item MenuItem) bool {
+	switch item {
+	case MenuContinue:
+		return s.canContinue
+	case MenuLoad:
+		return s.canLoad
+	default:
+		return true
+	}
+}
+
 // HandleInput handles input events
 func (s *MenuScreen) HandleInput(msg gruid.Msg) state.GameState {
 	switch msg := msg.(type) {
 	case gruid.MsgKeyDown:
 		switch msg.Key {
-		case "Up":
-			s.selected = 0
-		case "Down":
-			s.selected = 1
-		case "Enter":
-			if s.selected == 0 {
-				logger.Info("Game started from menu")
-				return state.StateGame
-			} else {
-				logger.Info("Game quit from menu")
-				return state.StateGameOver
-			}
+		case gruid.KeyArrowUp, "Up", "k":
+			s.moveSelection(-1)
+		case gruid.KeyArrowDown, "Down", "j":
+			s.moveSelection(1)
+		case gruid.KeyEnter:
+			return s.activate(s.GetSelectedItem())
+		}
+	}
+
+	return state.StateMenu
+}
+
+// moveSelection moves the cursor to the next enabled item in the given direction
+func (s *MenuScreen) moveSelection(delta int) {
+	count := len(menuItems)
+	for i := 1; i <= count; i++ {
+		next := ((s.selected+delta*i)%count + count) % count
+		if s.IsEnabled(menuItems[next]) {
+			s.selected = next
+			s.message = ""
+			return
+		}
+	}
+}
+
+// activate performs the action of a menu item
+func (s *MenuScreen) activate(item MenuItem) state.GameState {
+	if !s.IsEnabled(item) {
+		return state.StateMenu
+	}
+
+	switch item {
+	case MenuNewGame:
+		logger.Info("New game started from menu")
+		if s.onNewGame != nil {
+			s.onNewGame()
+		}
+		return state.StateCreation
+
+	case MenuContinue:
+		// 終了済み（勝利など）のオートセーブからは再開できないので、読み込む前にメタデータで確かめる
+		if s.saveIntegration.IsAutoSaveCompleted() {
+			s.message = i18n.T("menu.adventure_over")
+			return state.StateMenu
+		}
+		if err := s.saveIntegration.LoadAutoSave(); err != nil {
+			s.message = i18n.T("menu.continue_failed", err)
+			logger.Error("Continue from auto-save failed", "error", err)
+			return state.StateMenu
 		}
+		logger.Info("Game continued from auto-save")
+		if s.onContinue != nil {
+			s.onContinue()
+		}
+		return state.StateGame
+
+	case MenuLoad:
+		s.saveLoadScreen.SetMode(ModeLoad)
+		s.saveLoadScreen.SetReturnState(state.StateMenu)
+		return state.StateSaveLoad
+
+	case MenuHighScores:
+		return state.StateScores
+
+	case MenuStatistics:
+		return state.StateStatistics
+
+	case MenuAchievements:
+		return state.StateAchievements
+
+	case MenuBestiary:
+		return state.StateBestiary
+
+	case MenuOptions:
+		return state.StateOptions
+
+	case MenuQuit:
+		logger.Info("Game quit from menu")
+		return state.StateQuit
 	}
 
 	return state.StateMenu
@@ -75,6 +237,11 @@ func (s *MenuScreen) Draw(grid *gruid.Grid) {
 	// グリッドをクリア
 	grid.Fill(gruid.Cell{Rune: ' '})
 
+	// 無効な項目にカーソルが残らないようにする
+	if !s.IsEnabled(s.GetSelectedItem()) {
+		s.moveSelection(1)
+	}
+
 	// タイトルの描画
 	titleY := s.height/4 - len(titleArt)/2
 	for i, line := range titleArt {
@@ -84,12 +251,15 @@ func (s *MenuScreen) Draw(grid *gruid.Grid) {
 
 	// メニューの描画
 	menuY := titleY + len(titleArt) + 4
-	for i, line := range menuBox {
-		menuX := (s.width - len(line)) / 2
+	for i, item := range menuItems {
+		line := item.String()
+		menuX := (s.width - len([]rune(line))) / 2
 		style := colorGray
 
-		// 選択中の項目をハイライト
-		if (i == 1 && s.selected == 0) || (i == 2 && s.selected == 1) {
+		// 選択中の項目をハイライト、選べない項目は暗く表示
+		if !s.IsEnabled(item) {
+			style = colorDarkGray
+		} else if i == s.selected {
 			style = colorWhite
 		}
 
@@ -97,23 +267,32 @@ func (s *MenuScreen) Draw(grid *gruid.Grid) {
 	}
 
 	// バージョン情報の描画
-	versionText := "Version " + version
+	versionText := i18n.T("menu.version", version)
 	versionX := 1
 	versionY := s.height - 1
 	s.drawText(grid, versionX, versionY, versionText, colorDarkGray)
 
 	// 操作説明の描画
-	controlsText := "↑↓:Select  Enter:Decide"
-	controlsX := (s.width - len(controlsText)) / 2
-	controlsY := menuY + len(menuBox) + 2
+	controlsText := i18n.T("menu.controls")
+	controlsX := (s.width - len([]rune(controlsText))) / 2
+	controlsY := menuY + len(menuItems) + 2
 	s.drawText(grid, controlsX, controlsY, controlsText, colorGray)
 
+	// エラーメッセージの描画
+	if s.message != "" {
+		messageX := (s.width - len([]rune(s.message))) / 2
+		if messageX < 0 {
+			messageX = 0
+		}
+		s.drawText(grid, messageX, controlsY+2, s.message, colorRed)
+	}
+
 	logger.Trace("Menu screen drawn")
 }
 
 // drawText draws text at the specified position with the given style
 func (s *MenuScreen) drawText(grid *gruid.Grid, x, y int, text string, style gruid.Style) {
-	for i, r := range text {
+	for i, r := range []rune(text) {
 		pos := gruid.Point{X: x + i, Y: y}
 		if pos.X >= grid.Size().X {
 			break
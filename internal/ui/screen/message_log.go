@@ -0,0 +1,134 @@
+package screen
+
+import (
+	"fmt"
+
+	"github.com/anaseto/gruid"
+)
+
+// messageLines is the number of message rows below the map
+const messageLines = 7
+
+// maxMessageHistory is the number of messages kept for scrollback and the morgue file
+const maxMessageHistory = 500
+
+// MessageCategory groups log messages by color
+type MessageCategory int
+
+const (
+	MessageInfo    MessageCategory = iota // 通常のメッセージ
+	MessageCombat                         // 攻撃やモンスター撃破
+	MessageItem                           // アイテムの取得・使用
+	MessageWarning                        // ダメージや罠などの注意
+)
+
+// Color returns the display color of the category
+func (c MessageCategory) Color() gruid.Color {
+	switch c {
+	case MessageCombat:
+		return 0xFFA040 // オレンジ
+	case MessageItem:
+		return 0x40C0FF // 水色
+	case MessageWarning:
+		return 0xFF4040 // 赤
+	default:
+		return 0xFFFFFF
+	}
+}
+
+// logEntry is one line of the message log
+type logEntry struct {
+	Text     string
+	Category MessageCategory
+	Count    int // 同じメッセージが続いた回数
+}
+
+// String returns the line with the repeat count ("You rest. (x3)")
+func (e logEntry) String() string {
+	if e.Count > 1 {
+		return fmt.Sprintf("%s (x%d)", e.Text, e.Count)
+	}
+	return e.Text
+}
+
+// messageLog is a bounded message history with Rogue-style --More-- paging
+// 行には追加順の通し番号を振り、古い行を捨てても番号は変わらない
+type messageLog struct {
+	entries   []logEntry
+	added     int // これまでに追加した行数（次の行の通し番号）
+	turnStart int // 今回の入力で最初に追加された行の通し番号
+	pageStart int // --More-- で表示中の先頭行の通し番号（-1 なら表示していない）
+}
+
+// newMessageLog creates an empty message log
+func newMessageLog() *messageLog {
+	return &messageLog{entries: make([]logEntry, 0, messageLines), pageStart: -1}
+}
+
+// add appends a message, collapsing it into the last line when it repeats
+func (l *messageLog) add(text string, category MessageCategory) {
+	if n := len(l.entries); n > 0 && l.entries[n-1].Text == text && l.entries[n-1].Category == category {
+		l.entries[n-1].Count++
+		return
+	}
+
+	l.entries = append(l.entries, logEntry{Text: text, Category: category, Count: 1})
+	l.added++
+	if len(l.entries) > maxMessageHistory {
+		l.entries = l.entries[len(l.entries)-maxMessageHistory:]
+	}
+}
+
+// firstSeq returns the sequence number of the oldest kept line
+func (l *messageLog) firstSeq() int {
+	return l.added - len(l.entries)
+}
+
+// beginTurn marks where the messages of the next input start
+func (l *messageLog) beginTurn() {
+	l.turnStart = l.added
+}
+
+// endTurn starts --More-- paging when the input produced more lines than fit
+func (l *messageLog) endTurn() {
+	if l.added-l.turnStart > messageLines {
+		l.pageStart = max(l.turnStart, l.firstSeq())
+	}
+}
+
+// paging reports whether a --More-- prompt is waiting for a key
+func (l *messageLog) paging() bool {
+	return l.pageStart >= 0
+}
+
+// nextPage shows the next page of messages; the prompt ends when the rest fits
+func (l *messageLog) nextPage() {
+	l.pageStart += messageLines - 1
+	if l.added-l.pageStart <= messageLines {
+		l.pageStart = -1
+	}
+}
+
+// skipPages ends the --More-- prompt
+func (l *messageLog) skipPages() {
+	l.pageStart = -1
+}
+
+// visible returns the lines shown below the map and whether --More-- follows them
+func (l *messageLog) visible() ([]logEntry, bool) {
+	if l.paging() {
+		start := l.pageStart - l.firstSeq()
+		end := min(start+messageLines-1, len(l.entries))
+		return l.entries[start:end], true
+	}
+	return l.entries[max(0, len(l.entries)-messageLines):], false
+}
+
+// lines returns the whole history as text, oldest first
+func (l *messageLog) lines() []string {
+	lines := make([]string, len(l.entries))
+	for i, entry := range l.entries {
+		lines[i] = entry.String()
+	}
+	return lines
+}
@@ -0,0 +1,144 @@
+package screen
+
+import (
+	"fmt"
+	"testing"
+)
+
+// addLines adds count numbered lines starting at first
+func addLines(l *messageLog, first, count int) {
+	for i := first; i < first+count; i++ {
+		l.add(fmt.Sprintf("line %d", i), MessageInfo)
+	}
+}
+
+// checkVisible checks the first and last visible line and the --More-- flag
+func checkVisible(t *testing.T, l *messageLog, first, last string, more bool) {
+	t.Helper()
+	entries, gotMore := l.visible()
+	if len(entries) == 0 {
+		t.Fatalf("Expected visible lines %q..%q, got none", first, last)
+	}
+	if entries[0].Text != first || entries[len(entries)-1].Text != last {
+		t.Errorf("Expected visible lines %q..%q, got %q..%q",
+			first, last, entries[0].Text, entries[len(entries)-1].Text)
+	}
+	if gotMore != more {
+		t.Errorf("Expected --More-- %v, got %v", more, gotMore)
+	}
+}
+
+func TestMessageLog_BurstPages(t *testing.T) {
+	l := newMessageLog()
+
+	// 収まる量なら --More-- は出ない
+	l.beginTurn()
+	addLines(l, 0, messageLines)
+	l.endTurn()
+	if l.paging() {
+		t.Fatal("A turn that fits should not page")
+	}
+
+	l.beginTurn()
+	addLines(l, 100, 15)
+	l.endTurn()
+	if !l.paging() {
+		t.Fatal("A burst larger than the message area should page")
+	}
+
+	// 1ページは --More-- の行を残して messageLines-1 行
+	checkVisible(t, l, "line 100", "line 105", true)
+	l.nextPage()
+	checkVisible(t, l, "line 106", "line 111", true)
+
+	// 残りが収まれば --More-- は終わり、最新の行が並ぶ
+	l.nextPage()
+	if l.paging() {
+		t.Fatal("Paging should end once the rest fits")
+	}
+	checkVisible(t, l, "line 108", "line 114", false)
+}
+
+func TestMessageLog_SkipPages(t *testing.T) {
+	l := newMessageLog()
+	l.beginTurn()
+	addLines(l, 0, 30)
+	l.endTurn()
+
+	l.skipPages()
+	if l.paging() {
+		t.Fatal("skipPages should end the prompt")
+	}
+	checkVisible(t, l, "line 23", "line 29", false)
+}
+
+func TestMessageLog_PagingAcrossTruncation(t *testing.T) {
+	l := newMessageLog()
+	addLines(l, 0, maxMessageHistory-5)
+
+	// 途中で古い行が捨てられても、今回の最初の行から表示する
+	l.beginTurn()
+	addLines(l, 1000, 15)
+	l.endTurn()
+	if len(l.entries) != maxMessageHistory {
+		t.Fatalf("Expected history to be truncated to %d, got %d", maxMessageHistory, len(l.entries))
+	}
+	checkVisible(t, l, "line 1000", "line 1005", true)
+	l.nextPage()
+	checkVisible(t, l, "line 1006", "line 1011", true)
+	l.nextPage()
+	if l.paging() {
+		t.Fatal("Paging should end once the rest fits")
+	}
+	checkVisible(t, l, "line 1008", "line 1014", false)
+}
+
+func TestMessageLog_BurstLargerThanHistory(t *testing.T) {
+	l := newMessageLog()
+
+	// 今回の最初の行がすでに捨てられていたら、残っている最古の行から表示する
+	l.beginTurn()
+	addLines(l, 0, maxMessageHistory+10)
+	l.endTurn()
+	checkVisible(t, l, "line 10", "line 15", true)
+
+	for i := 0; l.paging(); i++ {
+		if i > maxMessageHistory {
+			t.Fatal("Paging did not end")
+		}
+		l.nextPage()
+	}
+	last := fmt.Sprintf("line %d", maxMessageHistory+9)
+	checkVisible(t, l, fmt.Sprintf("line %d", maxMessageHistory+10-messageLines), last, false)
+}
+
+func TestMessageLog_RepeatsCollapse(t *testing.T) {
+	l := newMessageLog()
+
+	// 同じ行は何度続いても1行なので --More-- にならない
+	l.beginTurn()
+	for i := 0; i < 20; i++ {
+		l.add("You rest.", MessageInfo)
+	}
+	l.endTurn()
+	if l.paging() {
+		t.Error("Repeated lines should not page")
+	}
+	if len(l.entries) != 1 || l.entries[0].String() != "You rest. (x20)" {
+		t.Fatalf("Expected one collapsed line, got %v", l.lines())
+	}
+
+	// 分類が違えば別の行になり、一度だけの行には回数を付けない
+	l.add("You rest.", MessageWarning)
+	l.add("You rest.", MessageInfo)
+	want := []string{"You rest. (x20)", "You rest.", "You rest."}
+	got := l.lines()
+	if len(got) != len(want) {
+		t.Fatalf("Expected %v, got %v", want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Line %d: expected %q, got %q", i, want[i], got[i])
+		}
+	}
+}
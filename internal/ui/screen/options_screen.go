@@ -0,0 +1,171 @@
+// Package screen オプション画面のUI実装
+// オートセーブなどのゲーム設定を切り替える
+package screen
+
+import (
+	"fmt"
+
+	"github.com/anaseto/gruid"
+	"github.com/yuru-sha/gorogue/internal/core/state"
+	"github.com/yuru-sha/gorogue/internal/game/save"
+	"github.com/yuru-sha/gorogue/internal/i18n"
+	"github.com/yuru-sha/gorogue/internal/utils/logger"
+)
+
+// saveIntervalChoices are the selectable auto-save intervals in turns
+var saveIntervalChoices = []int{50, 100, 250, 500, 1000}
+
+// optionLabels is the display order of the options (message ids)
+var optionLabels = []string{
+	"options.auto_save",
+	"options.auto_save_interval",
+	"options.auto_pickup",
+	"options.show_tips",
+	"options.confirm_quit",
+	"options.language",
+}
+
+// OptionsScreen edits the game settings
+type OptionsScreen struct {
+	width, height   int
+	selected        int
+	saveIntegration *save.SaveGameIntegration
+}
+
+// NewOptionsScreen creates a new options screen
+func NewOptionsScreen(width, height int, saveIntegration *save.SaveGameIntegration) *OptionsScreen {
+	return &OptionsScreen{
+		width:           width,
+		height:          height,
+		saveIntegration: saveIntegration,
+	}
+}
+
+// HandleInput handles input events
+func (s *OptionsScreen) HandleInput(msg gruid.Msg) state.GameState {
+	keyMsg, ok := msg.(gruid.MsgKeyDown)
+	if !ok {
+		return state.StateOptions
+	}
+
+	switch keyMsg.Key {
+	case gruid.KeyArrowUp, "Up", "k":
+		if s.selected > 0 {
+			s.selected--
+		}
+	case gruid.KeyArrowDown, "Down", "j":
+		if s.selected < len(optionLabels)-1 {
+			s.selected++
+		}
+	case gruid.KeyArrowLeft, "Left", "h":
+		s.changeOption(-1)
+	case gruid.KeyArrowRight, "Right", "l", gruid.KeyEnter, gruid.KeySpace:
+		s.changeOption(1)
+	case gruid.KeyEscape, "q":
+		return state.StateMenu
+	}
+
+	return state.StateOptions
+}
+
+// changeOption toggles or cycles the selected option
+func (s *OptionsScreen) changeOption(delta int) {
+	settings := s.saveIntegration.GetSettings()
+
+	switch s.selected {
+	case 0:
+		settings.AutoSave = !settings.AutoSave
+	case 1:
+		settings.SaveInterval = nextSaveInterval(settings.SaveInterval, delta)
+	case 2:
+		settings.AutoPickup = !settings.AutoPickup
+	case 3:
+		settings.ShowTips = !settings.ShowTips
+	case 4:
+		settings.ConfirmQuit = !settings.ConfirmQuit
+	case 5:
+		// 言語は設定ファイルの LANGUAGE が初期値で、ここでの変更はこのセッションの間だけ有効
+		i18n.SetLanguage(i18n.NextLanguage(i18n.CurrentLanguage(), delta))
+	}
+
+	s.saveIntegration.SetSettings(settings)
+	logger.Debug("Option changed", "option", optionLabels[s.selected])
+}
+
+// nextSaveInterval returns the neighbouring interval choice
+func nextSaveInterval(current, delta int) int {
+	index := 0
+	for i, choice := range saveIntervalChoices {
+		if choice <= current {
+			index = i
+		}
+	}
+
+	index += delta
+	if index < 0 {
+		index = 0
+	}
+	if index >= len(saveIntervalChoices) {
+		index = len(saveIntervalChoices) - 1
+	}
+	return saveIntervalChoices[index]
+}
+
+// optionValue returns the display value of an option
+func (s *OptionsScreen) optionValue(index int, settings save.Settings) string {
+	switch index {
+	case 0:
+		return onOff(settings.AutoSave)
+	case 1:
+		return i18n.T("options.turns", settings.SaveInterval)
+	case 2:
+		return onOff(settings.AutoPickup)
+	case 3:
+		return onOff(settings.ShowTips)
+	case 4:
+		return onOff(settings.ConfirmQuit)
+	case 5:
+		return i18n.CurrentLanguage().Name()
+	default:
+		return ""
+	}
+}
+
+// Draw draws the options screen
+func (s *OptionsScreen) Draw(grid *gruid.Grid) {
+	grid.Fill(gruid.Cell{Rune: ' '})
+
+	title := i18n.T("options.title")
+	s.drawText(grid, (s.width-len([]rune(title)))/2, 2, title, colorYellow)
+
+	settings := s.saveIntegration.GetSettings()
+	for i, label := range optionLabels {
+		style := colorGray
+		if i == s.selected {
+			style = colorWhite
+			s.drawText(grid, 14, 6+i*2, ">", colorWhite)
+		}
+		s.drawText(grid, 16, 6+i*2, fmt.Sprintf("%-20s %s", i18n.T(label), s.optionValue(i, settings)), style)
+	}
+
+	controls := i18n.T("options.controls")
+	s.drawText(grid, (s.width-len([]rune(controls)))/2, s.height-3, controls, colorDarkGray)
+}
+
+// drawText draws text at the specified position with the given style
+func (s *OptionsScreen) drawText(grid *gruid.Grid, x, y int, text string, style gruid.Style) {
+	for i, r := range []rune(text) {
+		if x+i >= s.width {
+			break
+		}
+		grid.Set(gruid.Point{X: x + i, Y: y}, gruid.Cell{Rune: r, Style: style})
+	}
+}
+
+// onOff formats a boolean option
+func onOff(enabled bool) string {
+	if enabled {
+		return i18n.T("ui.on")
+	}
+	return i18n.T("ui.off")
+}
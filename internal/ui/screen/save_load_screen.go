@@ -9,6 +9,7 @@ import (
 	"github.com/anaseto/gruid"
 	"github.com/yuru-sha/gorogue/internal/core/state"
 	"github.com/yuru-sha/gorogue/internal/game/save"
+	"github.com/yuru-sha/gorogue/internal/i18n"
 	"github.com/yuru-sha/gorogue/internal/utils/logger"
 )
 
@@ -30,6 +31,10 @@ type SaveLoadScreen struct {
 
 	// Save system integration
 	saveIntegration *save.SaveGameIntegration
+	onSave          func(slot int)  // セーブ成功時のコールバック
+	onLoad          func(slot int)  // ロード成功時のコールバック
+	onDelete        func(slot int)  // 削除成功時のコールバック
+	returnState     state.GameState // キャンセル時の戻り先
 
 	// UI state
 	confirmDelete bool
@@ -57,6 +62,7 @@ func NewSaveLoadScreen(width, height int, saveIntegration *save.SaveGameIntegrat
 		mode:            ModeSave,
 		selected:        0,
 		saveIntegration: saveIntegration,
+		returnState:     state.StateGame,
 		confirmDelete:   false,
 		selectedSlot:    -1,
 		message:         "",
@@ -85,7 +91,7 @@ func (s *SaveLoadScreen) HandleInput(msg gruid.Msg) state.GameState {
 	case gruid.MsgKeyDown:
 		return s.handleKeyDown(string(msg.Key))
 	}
-	return state.StateGame
+	return state.StateSaveLoad
 }
 
 // handleKeyDown handles key press events
@@ -96,13 +102,11 @@ func (s *SaveLoadScreen) handleKeyDown(key string) state.GameState {
 		case "y", "Y":
 			s.performDelete()
 			s.confirmDelete = false
-			return state.StateGame
 		case "n", "N", "Escape":
 			s.confirmDelete = false
 			s.message = ""
-			return state.StateGame
 		}
-		return state.StateGame
+		return state.StateSaveLoad
 	}
 
 	// Handle main navigation
@@ -122,7 +126,7 @@ func (s *SaveLoadScreen) handleKeyDown(key string) state.GameState {
 		return s.handleSelection()
 
 	case "Escape", "q":
-		return state.StateGame
+		return s.returnState
 
 	case "s", "S":
 		s.SetMode(ModeSave)
@@ -135,7 +139,7 @@ func (s *SaveLoadScreen) handleKeyDown(key string) state.GameState {
 
 	case "r", "R":
 		s.updateSaveSlots()
-		s.setMessage("Save slots refreshed", s.colorSuccess)
+		s.setMessage(i18n.T("saveload.refreshed"), s.colorSuccess)
 
 	case "h", "H", "?":
 		s.showHelp()
@@ -155,13 +159,13 @@ func (s *SaveLoadScreen) handleKeyDown(key string) state.GameState {
 		}
 	}
 
-	return state.StateGame
+	return state.StateSaveLoad
 }
 
 // handleSelection handles selection of a save slot
 func (s *SaveLoadScreen) handleSelection() state.GameState {
 	if s.selected >= s.getMaxItems() {
-		return state.StateGame
+		return state.StateSaveLoad
 	}
 
 	slot := s.selected
@@ -177,101 +181,121 @@ func (s *SaveLoadScreen) handleSelection() state.GameState {
 		return s.performDeletePrompt(slot)
 	}
 
-	return state.StateGame
+	return state.StateSaveLoad
 }
 
 // performSave performs save operation
 func (s *SaveLoadScreen) performSave(slot int) state.GameState {
 	if err := s.saveIntegration.SaveGame(slot); err != nil {
-		s.setMessage(fmt.Sprintf("Save failed: %v", err), s.colorError)
+		s.setMessage(i18n.T("saveload.save_failed", err), s.colorError)
 		logger.Error("Save failed", "slot", slot, "error", err)
-		return state.StateGame
+		return state.StateSaveLoad
 	}
 
-	s.setMessage(fmt.Sprintf("Game saved to slot %d", slot+1), s.colorSuccess)
+	s.setMessage(i18n.T("saveload.saved", slot+1), s.colorSuccess)
 	s.updateSaveSlots()
 	logger.Info("Game saved via UI", "slot", slot)
 
+	if s.onSave != nil {
+		s.onSave(slot)
+	}
+
 	return state.StateGame
 }
 
 // performLoad performs load operation
 func (s *SaveLoadScreen) performLoad(slot int) state.GameState {
 	if !s.saveIntegration.HasSave(slot) {
-		s.setMessage("No save file in this slot", s.colorError)
-		return state.StateGame
+		s.setMessage(i18n.T("saveload.no_file"), s.colorError)
+		return state.StateSaveLoad
 	}
 
 	if err := s.saveIntegration.LoadGame(slot); err != nil {
-		s.setMessage(fmt.Sprintf("Load failed: %v", err), s.colorError)
+		s.setMessage(i18n.T("saveload.load_failed", err), s.colorError)
 		logger.Error("Load failed", "slot", slot, "error", err)
-		return state.StateGame
+		return state.StateSaveLoad
 	}
 
-	s.setMessage(fmt.Sprintf("Game loaded from slot %d", slot+1), s.colorSuccess)
+	s.setMessage(i18n.T("saveload.loaded", slot+1), s.colorSuccess)
 	logger.Info("Game loaded via UI", "slot", slot)
 
+	if s.onLoad != nil {
+		s.onLoad(slot)
+	}
+
 	return state.StateGame
 }
 
 // performDeletePrompt shows delete confirmation
 func (s *SaveLoadScreen) performDeletePrompt(slot int) state.GameState {
 	if !s.saveIntegration.HasSave(slot) {
-		s.setMessage("No save file in this slot", s.colorError)
-		return state.StateGame
+		s.setMessage(i18n.T("saveload.no_file"), s.colorError)
+		return state.StateSaveLoad
 	}
 
 	s.confirmDelete = true
 	s.selectedSlot = slot
-	s.setMessage(fmt.Sprintf("Delete save slot %d? (y/n)", slot+1), s.colorHighlight)
+	s.setMessage(i18n.T("saveload.confirm_prompt", slot+1), s.colorHighlight)
 
-	return state.StateGame
+	return state.StateSaveLoad
 }
 
 // performDelete performs actual deletion
 func (s *SaveLoadScreen) performDelete() {
 	if err := s.saveIntegration.DeleteSave(s.selectedSlot); err != nil {
-		s.setMessage(fmt.Sprintf("Delete failed: %v", err), s.colorError)
+		s.setMessage(i18n.T("saveload.delete_failed", err), s.colorError)
 		logger.Error("Delete failed", "slot", s.selectedSlot, "error", err)
 		return
 	}
 
-	s.setMessage(fmt.Sprintf("Save slot %d deleted", s.selectedSlot+1), s.colorSuccess)
+	s.setMessage(i18n.T("saveload.deleted", s.selectedSlot+1), s.colorSuccess)
 	s.updateSaveSlots()
 	logger.Info("Save deleted via UI", "slot", s.selectedSlot)
+
+	if s.onDelete != nil {
+		s.onDelete(s.selectedSlot)
+	}
 }
 
 // performQuickSave performs quick save
 func (s *SaveLoadScreen) performQuickSave() state.GameState {
 	if err := s.saveIntegration.QuickSave(); err != nil {
-		s.setMessage(fmt.Sprintf("Quick save failed: %v", err), s.colorError)
+		s.setMessage(i18n.T("saveload.quick_save_failed", err), s.colorError)
 		logger.Error("Quick save failed", "error", err)
-		return state.StateGame
+		return state.StateSaveLoad
 	}
 
-	s.setMessage("Quick save completed", s.colorSuccess)
+	s.setMessage(i18n.T("saveload.quick_saved"), s.colorSuccess)
 	s.updateSaveSlots()
 	logger.Info("Quick save completed via UI")
 
+	if s.onSave != nil {
+		s.onSave(0)
+	}
+
 	return state.StateGame
 }
 
 // performQuickLoad performs quick load
 func (s *SaveLoadScreen) performQuickLoad() state.GameState {
 	if !s.saveIntegration.HasSave(0) {
-		s.setMessage("No quick save file", s.colorError)
-		return state.StateGame
+		s.setMessage(i18n.T("saveload.no_quick_save"), s.colorError)
+		return state.StateSaveLoad
 	}
 
 	if err := s.saveIntegration.QuickLoad(); err != nil {
-		s.setMessage(fmt.Sprintf("Quick load failed: %v", err), s.colorError)
+		s.setMessage(i18n.T("saveload.quick_load_failed", err), s.colorError)
 		logger.Error("Quick load failed", "error", err)
-		return state.StateGame
+		return state.StateSaveLoad
 	}
 
-	s.setMessage("Quick load completed", s.colorSuccess)
+	s.setMessage(i18n.T("saveload.quick_loaded"), s.colorSuccess)
 	logger.Info("Quick load completed via UI")
 
+	if s.onLoad != nil {
+		s.onLoad(0)
+	}
+
 	return state.StateGame
 }
 
@@ -283,7 +307,7 @@ func (s *SaveLoadScreen) updateSaveSlots() {
 		if info, exists := allSaveInfo[i]; exists {
 			s.saveSlots[i] = info
 		} else {
-			s.saveSlots[i] = "Empty"
+			s.saveSlots[i] = i18n.T("save.empty")
 		}
 	}
 }
@@ -301,7 +325,7 @@ func (s *SaveLoadScreen) setMessage(message string, color gruid.Color) {
 
 // showHelp shows help message
 func (s *SaveLoadScreen) showHelp() {
-	help := "Save/Load Help: ↑↓:Navigate Enter:Select s:Save l:Load d:Delete r:Refresh F5:Quick Save F9:Quick Load"
+	help := i18n.T("saveload.help")
 	s.setMessage(help, s.colorHighlight)
 }
 
@@ -345,13 +369,13 @@ func (s *SaveLoadScreen) Draw(grid *gruid.Grid) {
 func (s *SaveLoadScreen) getTitle() string {
 	switch s.mode {
 	case ModeSave:
-		return "=== SAVE GAME ==="
+		return i18n.T("saveload.title_save")
 	case ModeLoad:
-		return "=== LOAD GAME ==="
+		return i18n.T("saveload.title_load")
 	case ModeDelete:
-		return "=== DELETE SAVE ==="
+		return i18n.T("saveload.title_delete")
 	default:
-		return "=== SAVE/LOAD ==="
+		return i18n.T("saveload.title")
 	}
 }
 
@@ -359,13 +383,13 @@ func (s *SaveLoadScreen) getTitle() string {
 func (s *SaveLoadScreen) getInstructions() string {
 	switch s.mode {
 	case ModeSave:
-		return "Select a slot to save your game"
+		return i18n.T("saveload.select_save")
 	case ModeLoad:
-		return "Select a slot to load your game"
+		return i18n.T("saveload.select_load")
 	case ModeDelete:
-		return "Select a slot to delete"
+		return i18n.T("saveload.select_delete")
 	default:
-		return "Select an option"
+		return i18n.T("saveload.select")
 	}
 }
 
@@ -389,7 +413,7 @@ func (s *SaveLoadScreen) drawSaveSlots(grid *gruid.Grid) {
 		}
 
 		// Check if slot is empty
-		isEmpty := s.saveSlots[i] == "Empty"
+		isEmpty := s.saveSlots[i] == i18n.T("save.empty")
 		if isEmpty {
 			textColor = s.colorEmpty
 		}
@@ -401,9 +425,9 @@ func (s *SaveLoadScreen) drawSaveSlots(grid *gruid.Grid) {
 		// Draw slot info
 		slotInfo := s.saveSlots[i]
 		if isEmpty && s.mode == ModeLoad {
-			slotInfo = "Empty - Cannot load"
+			slotInfo = i18n.T("saveload.empty_load")
 		} else if isEmpty && s.mode == ModeDelete {
-			slotInfo = "Empty - Cannot delete"
+			slotInfo = i18n.T("saveload.empty_delete")
 		}
 
 		s.drawText(grid, 13, y, slotInfo, textColor)
@@ -420,18 +444,18 @@ func (s *SaveLoadScreen) drawAutoSaveInfo(grid *gruid.Grid) {
 	autoSaveY := 7 + save.MaxSaveSlots*2 + 2
 
 	// Draw auto-save section header
-	s.drawText(grid, 10, autoSaveY, "Auto-Save:", s.colorHighlight)
+	s.drawText(grid, 10, autoSaveY, i18n.T("saveload.auto_save"), s.colorHighlight)
 
 	// Check if auto-save exists
 	if s.saveIntegration.HasAutoSave() {
 		info, err := s.saveIntegration.GetSaveInfo(save.AutoSaveSlot)
 		if err != nil {
-			s.drawText(grid, 10, autoSaveY+1, "Error reading auto-save", s.colorError)
+			s.drawText(grid, 10, autoSaveY+1, i18n.T("saveload.auto_save_error"), s.colorError)
 		} else {
 			s.drawText(grid, 10, autoSaveY+1, info, s.colorNormal)
 		}
 	} else {
-		s.drawText(grid, 10, autoSaveY+1, "No auto-save available", s.colorEmpty)
+		s.drawText(grid, 10, autoSaveY+1, i18n.T("saveload.no_auto_save"), s.colorEmpty)
 	}
 }
 
@@ -440,15 +464,15 @@ func (s *SaveLoadScreen) drawControls(grid *gruid.Grid) {
 	controlsY := s.height - 8
 
 	controls := []string{
-		"↑↓: Navigate",
-		"Enter: Select",
-		"s: Save Mode",
-		"l: Load Mode",
-		"d: Delete Mode",
-		"r: Refresh",
-		"F5: Quick Save",
-		"F9: Quick Load",
-		"Esc: Back",
+		i18n.T("saveload.key_navigate"),
+		i18n.T("saveload.key_select"),
+		i18n.T("saveload.key_save"),
+		i18n.T("saveload.key_load"),
+		i18n.T("saveload.key_delete"),
+		i18n.T("saveload.key_refresh"),
+		i18n.T("saveload.key_quick_save"),
+		i18n.T("saveload.key_quick_load"),
+		i18n.T("saveload.key_back"),
 	}
 
 	// Draw controls in two columns
@@ -479,15 +503,15 @@ func (s *SaveLoadScreen) drawConfirmDialog(grid *gruid.Grid) {
 	}
 
 	// Draw dialog content
-	s.drawCenteredText(grid, dialogY+1, "CONFIRM DELETE", s.colorError)
-	s.drawCenteredText(grid, dialogY+2, fmt.Sprintf("Delete save slot %d?", s.selectedSlot+1), s.colorNormal)
-	s.drawCenteredText(grid, dialogY+3, "This action cannot be undone!", s.colorError)
-	s.drawCenteredText(grid, dialogY+4, "Press 'y' to confirm, 'n' to cancel", s.colorNormal)
+	s.drawCenteredText(grid, dialogY+1, i18n.T("saveload.confirm_title"), s.colorError)
+	s.drawCenteredText(grid, dialogY+2, i18n.T("saveload.confirm_slot", s.selectedSlot+1), s.colorNormal)
+	s.drawCenteredText(grid, dialogY+3, i18n.T("saveload.confirm_warning"), s.colorError)
+	s.drawCenteredText(grid, dialogY+4, i18n.T("saveload.confirm_keys"), s.colorNormal)
 }
 
 // drawText draws text at the specified position
 func (s *SaveLoadScreen) drawText(grid *gruid.Grid, x, y int, text string, color gruid.Color) {
-	for i, r := range text {
+	for i, r := range []rune(text) {
 		if x+i < s.width && y < s.height {
 			grid.Set(gruid.Point{X: x + i, Y: y}, gruid.Cell{Rune: r, Style: gruid.Style{Fg: color}})
 		}
@@ -496,7 +520,7 @@ func (s *SaveLoadScreen) drawText(grid *gruid.Grid, x, y int, text string, color
 
 // drawCenteredText draws centered text
 func (s *SaveLoadScreen) drawCenteredText(grid *gruid.Grid, y int, text string, color gruid.Color) {
-	x := (s.width - len(text)) / 2
+	x := (s.width - len([]rune(text))) / 2
 	if x < 0 {
 		x = 0
 	}
@@ -513,6 +537,26 @@ func (s *SaveLoadScreen) SetSaveIntegration(saveIntegration *save.SaveGameIntegr
 	s.saveIntegration = saveIntegration
 }
 
+// SetReturnState sets the state to return to when the screen is cancelled
+func (s *SaveLoadScreen) SetReturnState(returnState state.GameState) {
+	s.returnState = returnState
+}
+
+// SetOnSave sets the callback invoked after a successful save
+func (s *SaveLoadScreen) SetOnSave(onSave func(slot int)) {
+	s.onSave = onSave
+}
+
+// SetOnLoad sets the callback invoked after a successful load
+func (s *SaveLoadScreen) SetOnLoad(onLoad func(slot int)) {
+	s.onLoad = onLoad
+}
+
+// SetOnDelete sets the callback invoked after a save was deleted
+func (s *SaveLoadScreen) SetOnDelete(onDelete func(slot int)) {
+	s.onDelete = onDelete
+}
+
 // GetMode returns the current mode
 func (s *SaveLoadScreen) GetMode() SaveLoadMode {
 	return s.mode
@@ -0,0 +1,417 @@
+// Package screen ハイスコア画面のUI実装
+// スコアファイルの記録をスクロール・絞り込みして表示し、選択した記録の内訳を表示する
+package screen
+
+import (
+	"fmt"
+
+	"github.com/anaseto/gruid"
+	"github.com/yuru-sha/gorogue/internal/core/state"
+	"github.com/yuru-sha/gorogue/internal/game/score"
+	"github.com/yuru-sha/gorogue/internal/i18n"
+	"github.com/yuru-sha/gorogue/internal/utils/logger"
+)
+
+// scoreListTop is the first row of the leaderboard table
+const scoreListTop = 7
+
+// scoreNameMaxLen is the longest player name filter that can be typed
+const scoreNameMaxLen = 20
+
+// ScoreView represents the current view of the score screen
+type ScoreView int
+
+const (
+	// ScoreViewList shows the leaderboard table
+	ScoreViewList ScoreView = iota
+	// ScoreViewDetail shows the selected entry
+	ScoreViewDetail
+	// ScoreViewNameInput edits the player name filter
+	ScoreViewNameInput
+)
+
+// ScoreScreen displays the leaderboard
+type ScoreScreen struct {
+	width, height int
+	scoreManager  *score.ScoreManager
+	calculator    *score.ScoreCalculator
+	entries       []score.RankedEntry
+	versions      []string
+	filter        score.ScoreFilter
+	view          ScoreView
+	selected      int
+	offset        int
+	nameBuffer    string
+	loaded        bool
+	errMessage    string
+}
+
+// NewScoreScreen creates a new high score screen
+func NewScoreScreen(width, height int, scoreManager *score.ScoreManager) *ScoreScreen {
+	return &ScoreScreen{
+		width:        width,
+		height:       height,
+		scoreManager: scoreManager,
+		calculator:   score.NewScoreCalculator(),
+	}
+}
+
+// Refresh reloads the scores matching the current filter
+func (s *ScoreScreen) Refresh() {
+	s.loaded = true
+	s.errMessage = ""
+	s.selected = 0
+	s.offset = 0
+
+	entries, err := s.scoreManager.QueryScores(s.filter)
+	if err != nil {
+		s.entries = nil
+		s.errMessage = i18n.T("scores.read_failed", err)
+		logger.Warn("Failed to read high scores", "error", err)
+		return
+	}
+	s.entries = entries
+
+	versions, err := s.scoreManager.GetVersions()
+	if err != nil {
+		logger.Warn("Failed to read score versions", "error", err)
+		versions = nil
+	}
+	s.versions = versions
+}
+
+// GetFilter returns the current filter
+func (s *ScoreScreen) GetFilter() score.ScoreFilter {
+	return s.filter
+}
+
+// GetView returns the current view
+func (s *ScoreScreen) GetView() ScoreView {
+	return s.view
+}
+
+// GetSelectedEntry returns the selected entry or nil
+func (s *ScoreScreen) GetSelectedEntry() *score.RankedEntry {
+	if s.selected < 0 || s.selected >= len(s.entries) {
+		return nil
+	}
+	return &s.entries[s.selected]
+}
+
+// HandleInput handles input for the score screen
+func (s *ScoreScreen) HandleInput(msg gruid.Msg) state.GameState {
+	keyMsg, ok := msg.(gruid.MsgKeyDown)
+	if !ok {
+		return state.StateScores
+	}
+	if !s.loaded {
+		s.Refresh()
+	}
+
+	switch s.view {
+	case ScoreViewDetail:
+		return s.handleDetailInput(keyMsg.Key)
+	case ScoreViewNameInput:
+		return s.handleNameInput(keyMsg.Key)
+	default:
+		return s.handleListInput(keyMsg.Key)
+	}
+}
+
+// handleListInput handles input in the leaderboard table
+func (s *ScoreScreen) handleListInput(key gruid.Key) state.GameState {
+	switch key {
+	case gruid.KeyEscape, "q":
+		// 次に開いたときに最新のスコアを読み直す
+		s.loaded = false
+		s.filter = score.ScoreFilter{}
+		return state.StateMenu
+	case gruid.KeyArrowUp, "k", "Up":
+		s.moveSelection(-1)
+	case gruid.KeyArrowDown, "j", "Down":
+		s.moveSelection(1)
+	case gruid.KeyPageUp:
+		s.moveSelection(-s.visibleRows())
+	case gruid.KeyPageDown:
+		s.moveSelection(s.visibleRows())
+	case gruid.KeyHome, "g":
+		s.moveSelection(-len(s.entries))
+	case gruid.KeyEnd, "G":
+		s.moveSelection(len(s.entries))
+	case gruid.KeyEnter:
+		if s.GetSelectedEntry() != nil {
+			s.view = ScoreViewDetail
+		}
+	case "v":
+		s.filter.VictoryOnly = !s.filter.VictoryOnly
+		s.Refresh()
+	case "n", "/":
+		s.nameBuffer = s.filter.PlayerName
+		s.view = ScoreViewNameInput
+	case "r":
+		s.filter.Version = s.nextVersion()
+		s.Refresh()
+	case "c":
+		s.filter = score.ScoreFilter{}
+		s.Refresh()
+	}
+	return state.StateScores
+}
+
+// handleDetailInput handles input in the entry detail view
+func (s *ScoreScreen) handleDetailInput(key gruid.Key) state.GameState {
+	switch key {
+	case gruid.KeyEscape, gruid.KeyEnter, "q":
+		s.view = ScoreViewList
+	case gruid.KeyArrowUp, "k", "Up":
+		s.moveSelection(-1)
+	case gruid.KeyArrowDown, "j", "Down":
+		s.moveSelection(1)
+	}
+	return state.StateScores
+}
+
+// handleNameInput handles typing the player name filter
+func (s *ScoreScreen) handleNameInput(key gruid.Key) state.GameState {
+	switch key {
+	case gruid.KeyEscape:
+		s.nameBuffer = ""
+		s.view = ScoreViewList
+	case gruid.KeyEnter:
+		s.filter.PlayerName = s.nameBuffer
+		s.nameBuffer = ""
+		s.view = ScoreViewList
+		s.Refresh()
+	case gruid.KeyBackspace:
+		if s.nameBuffer != "" {
+			runes := []rune(s.nameBuffer)
+			s.nameBuffer = string(runes[:len(runes)-1])
+		}
+	default:
+		if len(string(key)) == 1 {
+			char := string(key)[0]
+			if char >= 32 && char <= 126 && len(s.nameBuffer) < scoreNameMaxLen { // Printable ASCII
+				s.nameBuffer += string(char)
+			}
+		}
+	}
+	return state.StateScores
+}
+
+// moveSelection moves the cursor and keeps it inside the visible window
+func (s *ScoreScreen) moveSelection(delta int) {
+	if len(s.entries) == 0 {
+		return
+	}
+
+	s.selected += delta
+	if s.selected < 0 {
+		s.selected = 0
+	}
+	if s.selected >= len(s.entries) {
+		s.selected = len(s.entries) - 1
+	}
+
+	rows := s.visibleRows()
+	if s.selected < s.offset {
+		s.offset = s.selected
+	}
+	if s.selected >= s.offset+rows {
+		s.offset = s.selected - rows + 1
+	}
+}
+
+// nextVersion cycles the version filter through all, then each recorded version
+func (s *ScoreScreen) nextVersion() string {
+	if len(s.versions) == 0 {
+		return ""
+	}
+	if s.filter.Version == "" {
+		return s.versions[0]
+	}
+	for i, version := range s.versions {
+		if version == s.filter.Version && i+1 < len(s.versions) {
+			return s.versions[i+1]
+		}
+	}
+	return ""
+}
+
+// visibleRows returns the number of table rows that fit on screen
+func (s *ScoreScreen) visibleRows() int {
+	rows := s.height - scoreListTop - 4
+	if rows < 1 {
+		rows = 1
+	}
+	return rows
+}
+
+// Draw draws the score screen
+func (s *ScoreScreen) Draw(grid *gruid.Grid) {
+	grid.Fill(gruid.Cell{Rune: ' '})
+
+	if !s.loaded {
+		s.Refresh()
+	}
+
+	if s.view == ScoreViewDetail {
+		s.drawDetail(grid)
+		return
+	}
+
+	s.drawCenteredText(grid, 2, i18n.T("scores.title"), colorYellow)
+	s.drawCenteredText(grid, 3, i18n.T("scores.filter", s.filter.String()), colorGray)
+
+	switch {
+	case s.errMessage != "":
+		s.drawCenteredText(grid, scoreListTop, s.errMessage, colorRed)
+	case len(s.entries) == 0 && !s.filter.IsEmpty():
+		s.drawCenteredText(grid, scoreListTop, i18n.T("scores.no_match"), colorGray)
+	case len(s.entries) == 0:
+		s.drawCenteredText(grid, scoreListTop, i18n.T("scores.none"), colorGray)
+	default:
+		s.drawTable(grid)
+	}
+
+	if s.view == ScoreViewNameInput {
+		s.drawText(grid, 4, s.height-4, i18n.T("scores.player_name", s.nameBuffer), colorYellow)
+		s.drawCenteredText(grid, s.height-2, i18n.T("scores.name_help"), colorDarkGray)
+		return
+	}
+
+	s.drawCenteredText(grid, s.height-3, i18n.T("scores.help"), colorDarkGray)
+	s.drawCenteredText(grid, s.height-2, i18n.T("scores.help_clear"), colorDarkGray)
+}
+
+// drawTable draws the visible part of the leaderboard
+func (s *ScoreScreen) drawTable(grid *gruid.Grid) {
+	header := fmt.Sprintf("  %-4s %-12s %8s %5s %5s  %s", i18n.T("scores.rank"), i18n.T("scores.name"), i18n.T("scores.score"), i18n.T("scores.lvl"), i18n.T("scores.floor"), i18n.T("scores.result"))
+	s.drawText(grid, 2, scoreListTop-2, header, colorWhite)
+
+	end := s.offset + s.visibleRows()
+	if end > len(s.entries) {
+		end = len(s.entries)
+	}
+
+	for i := s.offset; i < end; i++ {
+		ranked := s.entries[i]
+		entry := ranked.Entry
+		line := fmt.Sprintf("%-4d %-12s %8d %5d %5d  %s",
+			ranked.Rank, truncateText(entry.PlayerName, 12), entry.Score, entry.Level, entry.DeepestFloor,
+			truncateText(entry.ResultText(), s.width-48))
+
+		style := colorGray
+		prefix := "  "
+		if i == s.selected {
+			style = colorYellow
+			prefix = "> "
+		}
+		s.drawText(grid, 2, scoreListTop+i-s.offset, prefix+line, style)
+	}
+
+	// スクロール位置
+	if len(s.entries) > s.visibleRows() {
+		position := i18n.T("scores.position", s.offset+1, end, len(s.entries))
+		s.drawText(grid, s.width-len([]rune(position))-2, scoreListTop-2, position, colorDarkGray)
+	}
+}
+
+// drawDetail draws the selected entry and its score breakdown
+func (s *ScoreScreen) drawDetail(grid *gruid.Grid) {
+	ranked := s.GetSelectedEntry()
+	if ranked == nil {
+		s.view = ScoreViewList
+		return
+	}
+	entry := ranked.Entry
+
+	s.drawCenteredText(grid, 2, fmt.Sprintf("=== #%d %s ===", ranked.Rank, entry.PlayerName), colorYellow)
+	s.drawCenteredText(grid, 3, entry.ResultText(), colorWhite)
+
+	x := s.width/2 - 16
+	y := 5
+	info := []struct {
+		label string
+		value string
+	}{
+		{i18n.T("scores.score"), fmt.Sprintf("%d (%s)", entry.Score, s.calculator.GetScoreGrade(entry.Score))},
+		{i18n.T("scores.class"), scoreClassName(entry.Class)},
+		{i18n.T("scores.level"), fmt.Sprintf("%d", entry.Level)},
+		{i18n.T("scores.deepest_floor"), fmt.Sprintf("%d", entry.DeepestFloor)},
+		{i18n.T("scores.turns"), fmt.Sprintf("%d", entry.TurnCount)},
+		{i18n.T("scores.play_time"), score.FormatPlayTime(entry.PlayTime)},
+		{i18n.T("scores.monsters_killed"), fmt.Sprintf("%d", entry.MonstersKilled)},
+		{i18n.T("scores.gold"), fmt.Sprintf("%d", entry.GoldCollected)},
+		{i18n.T("scores.date"), entry.Timestamp.Format("2006-01-02 15:04")},
+		{i18n.T("scores.version"), entry.Version},
+	}
+	for i, row := range info {
+		s.drawText(grid, x, y+i, fmt.Sprintf("%-16s %s", row.label, row.value), colorGray)
+	}
+	y += len(info) + 1
+
+	b := entry.Breakdown
+	if b == nil {
+		s.drawText(grid, x, y, i18n.T("scores.no_breakdown"), colorDarkGray)
+	} else {
+		rows := []struct {
+			label string
+			value int
+		}{
+			{i18n.T("score.base"), b.BaseScore},
+			{i18n.T("score.victory_bonus"), b.VictoryBonus},
+			{i18n.T("score.floor_bonus"), b.FloorBonus},
+			{i18n.T("score.monster_bonus"), b.MonsterKillBonus},
+			{i18n.T("score.gold_bonus"), b.GoldBonus},
+			{i18n.T("score.level_bonus"), b.LevelBonus},
+			{i18n.T("score.survival_bonus"), b.SurvivalBonus},
+			{i18n.T("score.efficiency_bonus"), b.EfficiencyBonus},
+			{i18n.T("score.time_penalty"), -b.TimePenalty},
+		}
+		for i, row := range rows {
+			s.drawText(grid, x, y+i, fmt.Sprintf("%-16s %9d", row.label, row.value), colorGray)
+		}
+		s.drawText(grid, x, y+len(rows), fmt.Sprintf("%-16s %9d", i18n.T("score.total"), b.TotalScore), colorWhite)
+	}
+
+	s.drawCenteredText(grid, s.height-2, i18n.T("scores.detail_help"), colorDarkGray)
+}
+
+// drawText draws text at the specified position with the given style
+func (s *ScoreScreen) drawText(grid *gruid.Grid, x, y int, text string, style gruid.Style) {
+	for i, r := range []rune(text) {
+		if x+i < 0 || x+i >= s.width || y >= s.height {
+			continue
+		}
+		grid.Set(gruid.Point{X: x + i, Y: y}, gruid.Cell{Rune: r, Style: style})
+	}
+}
+
+// drawCenteredText draws centered text
+func (s *ScoreScreen) drawCenteredText(grid *gruid.Grid, y int, text string, style gruid.Style) {
+	x := (s.width - len([]rune(text))) / 2
+	if x < 0 {
+		x = 0
+	}
+	s.drawText(grid, x, y, text, style)
+}
+
+// scoreClassName returns the class shown in the detail view ("-" for old entries)
+func scoreClassName(class string) string {
+	if class == "" {
+		return "-"
+	}
+	return i18n.Named("class", class)
+}
+
+// truncateText shortens text to at most max runes
+func truncateText(text string, max int) string {
+	if max < 0 {
+		max = 0
+	}
+	runes := []rune(text)
+	if len(runes) <= max {
+		return text
+	}
+	return string(runes[:max])
+}
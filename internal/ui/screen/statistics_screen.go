@@ -0,0 +1,138 @@
+// Package screen 通算統計画面のUI実装
+// 全プレイを集計した通算統計を表示する
+package screen
+
+import (
+	"fmt"
+
+	"github.com/anaseto/gruid"
+	"github.com/yuru-sha/gorogue/internal/core/state"
+	"github.com/yuru-sha/gorogue/internal/game/save"
+	"github.com/yuru-sha/gorogue/internal/game/score"
+	"github.com/yuru-sha/gorogue/internal/i18n"
+	"github.com/yuru-sha/gorogue/internal/utils/logger"
+)
+
+// statisticsTopEntries is the number of entries shown in each ranking
+const statisticsTopEntries = 5
+
+// StatisticsScreen displays the lifetime statistics
+type StatisticsScreen struct {
+	width, height int
+	manager       *save.LifetimeStatsManager
+	stats         *save.LifetimeStats
+	loaded        bool
+	errMessage    string
+}
+
+// NewStatisticsScreen creates a new statistics screen
+func NewStatisticsScreen(width, height int, manager *save.LifetimeStatsManager) *StatisticsScreen {
+	return &StatisticsScreen{
+		width:   width,
+		height:  height,
+		manager: manager,
+	}
+}
+
+// Refresh reloads the lifetime statistics file
+func (s *StatisticsScreen) Refresh() {
+	s.loaded = true
+	s.errMessage = ""
+
+	stats, err := s.manager.Load()
+	if err != nil {
+		s.stats = nil
+		s.errMessage = i18n.T("stats.read_failed", err)
+		logger.Warn("Failed to read lifetime stats", "error", err)
+		return
+	}
+	s.stats = stats
+}
+
+// HandleInput returns to the title menu on any key
+func (s *StatisticsScreen) HandleInput(msg gruid.Msg) state.GameState {
+	if _, ok := msg.(gruid.MsgKeyDown); ok {
+		// 次に開いたときに最新の統計を読み直す
+		s.loaded = false
+		return state.StateMenu
+	}
+	return state.StateStatistics
+}
+
+// Draw draws the lifetime statistics
+func (s *StatisticsScreen) Draw(grid *gruid.Grid) {
+	grid.Fill(gruid.Cell{Rune: ' '})
+
+	if !s.loaded {
+		s.Refresh()
+	}
+
+	s.drawCenteredText(grid, 2, i18n.T("stats.title"), colorYellow)
+	s.drawCenteredText(grid, s.height-3, i18n.T("gameover.press_any_key"), colorDarkGray)
+
+	switch {
+	case s.errMessage != "":
+		s.drawCenteredText(grid, 6, s.errMessage, colorRed)
+		return
+	case s.stats.TotalGames == 0:
+		s.drawCenteredText(grid, 6, i18n.T("stats.none"), colorGray)
+		return
+	}
+
+	st := s.stats
+	x := 6
+	y := 5
+	summary := []string{
+		i18n.T("stats.games_played", st.TotalGames),
+		i18n.T("stats.victories", st.Victories, st.WinRate()*100),
+		i18n.T("stats.deaths", st.Deaths),
+		i18n.T("stats.deepest_floor", st.DeepestFloor),
+		i18n.T("stats.highest_level", st.HighestLevel),
+		i18n.T("stats.monsters_killed", st.MonstersKilled),
+		i18n.T("stats.gold_collected", st.GoldCollected),
+		i18n.T("stats.turns_per_floor", st.AverageTurnsPerFloor()),
+		i18n.T("stats.play_time", score.FormatPlayTime(st.TotalPlayTime)),
+	}
+	for i, line := range summary {
+		s.drawText(grid, x, y+i, line, colorGray)
+	}
+
+	y += len(summary) + 2
+	columnWidth := (s.width - x*2) / 3
+	s.drawRanking(grid, x, y, i18n.T("stats.death_causes"), save.DeathCauseNames(st.TopDeathCauses(statisticsTopEntries)), columnWidth)
+	s.drawRanking(grid, x+columnWidth, y, i18n.T("stats.most_killed"), st.TopMonsters(statisticsTopEntries), columnWidth)
+	s.drawRanking(grid, x+columnWidth*2, y, i18n.T("stats.favorite_items"), st.FavoriteItems(statisticsTopEntries), columnWidth)
+}
+
+// drawRanking draws a titled list of counts
+func (s *StatisticsScreen) drawRanking(grid *gruid.Grid, x, y int, title string, entries []save.NamedCount, width int) {
+	s.drawText(grid, x, y, title, colorWhite)
+	if len(entries) == 0 {
+		s.drawText(grid, x, y+1, "-", colorDarkGray)
+		return
+	}
+	for i, entry := range entries {
+		count := fmt.Sprintf("%d", entry.Count)
+		name := truncateText(entry.Name, width-len(count)-3)
+		s.drawText(grid, x, y+1+i, fmt.Sprintf("%s %s", name, count), colorGray)
+	}
+}
+
+// drawText draws text at the specified position with the given style
+func (s *StatisticsScreen) drawText(grid *gruid.Grid, x, y int, text string, style gruid.Style) {
+	for i, r := range []rune(text) {
+		if x+i < 0 || x+i >= s.width || y >= s.height {
+			continue
+		}
+		grid.Set(gruid.Point{X: x + i, Y: y}, gruid.Cell{Rune: r, Style: style})
+	}
+}
+
+// drawCenteredText draws centered text
+func (s *StatisticsScreen) drawCenteredText(grid *gruid.Grid, y int, text string, style gruid.Style) {
+	x := (s.width - len([]rune(text))) / 2
+	if x < 0 {
+		x = 0
+	}
+	s.drawText(grid, x, y, text, style)
+}